@@ -3,7 +3,7 @@ module github.com/coinexchain/cet-sdk
 go 1.13
 
 require (
-	github.com/DataDog/zstd v1.4.0 // indirect
+	github.com/DataDog/zstd v1.4.0
 	github.com/Shopify/sarama v1.23.1
 	github.com/coinexchain/cosmos-utils v0.0.0-20200109031554-f15ba3b1d6a7
 	github.com/coinexchain/shorthanzi v0.1.0
@@ -11,10 +11,14 @@ require (
 	github.com/emirpasic/gods v1.12.0
 	github.com/facebookgo/ensure v0.0.0-20200202191622-63f1cf65ac4c // indirect
 	github.com/facebookgo/subset v0.0.0-20200203212716-c811ad88dec4 // indirect
+	github.com/go-kit/kit v0.9.0
+	github.com/go-kit/kit v0.9.0
 	github.com/gorilla/mux v1.7.3
 	github.com/pelletier/go-toml v1.4.0
 	github.com/pierrec/lz4 v2.0.5+incompatible // indirect
 	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v0.9.3
+	github.com/prometheus/client_golang v0.9.3
 	github.com/rakyll/statik v0.1.6 // indirect
 	github.com/spf13/cobra v0.0.5
 	github.com/spf13/viper v1.6.1
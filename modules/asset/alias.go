@@ -12,12 +12,35 @@ const (
 	QuerierRoute              = types.QuerierRoute
 	RouterKey                 = types.RouterKey
 	QueryToken                = types.QueryToken
+	QueryTokenDisplay         = types.QueryTokenDisplay
 	QueryTokenList            = types.QueryTokenList
 	QueryWhitelist            = types.QueryWhitelist
 	QueryForbiddenAddr        = types.QueryForbiddenAddr
 	QueryParameters           = types.QueryParameters
 	QueryReservedSymbols      = types.QueryReservedSymbols
+	QueryOwnerGroup           = types.QueryOwnerGroup
+	QueryProposals            = types.QueryProposals
+	MaxOwnerGroupMembers      = types.MaxOwnerGroupMembers
+	QueryTokenRoles           = types.QueryTokenRoles
+	QueryTokenHolders         = types.QueryTokenHolders
+	DefaultTokenHoldersLimit  = types.DefaultTokenHoldersLimit
+	MaxTokenHoldersLimit      = types.MaxTokenHoldersLimit
+	ForbidExpiredInfoKey      = types.ForbidExpiredInfoKey
+	SymbolAuctionResultKey    = types.SymbolAuctionResultKey
+	SymbolReservationPeriod   = types.SymbolReservationPeriod
+	QuerySymbolAuctions       = types.QuerySymbolAuctions
+	QueryReservations         = types.QueryReservations
+	QueryTokenInfoHistory     = types.QueryTokenInfoHistory
+	QueryAttestations         = types.QueryAttestations
+	MaxAttestationClaimLength = types.MaxAttestationClaimLength
+	RoleMinter                = types.RoleMinter
+	RoleAddrForbidder         = types.RoleAddrForbidder
+	RoleInfoEditor            = types.RoleInfoEditor
 	MaxTokenAmount            = types.MaxTokenAmount
+	DefaultTokenDecimals      = types.DefaultTokenDecimals
+	MaxTokenDecimals          = types.MaxTokenDecimals
+	MaxAirdropRecipients      = types.MaxAirdropRecipients
+	AirdropInfoKey            = types.AirdropInfoKey
 	DefaultIssueTokenFee      = types.DefaultIssueLongTokenFee
 	DefaultIssue2CharTokenFee = types.DefaultIssue2CharTokenFee
 	DefaultIssue3CharTokenFee = types.DefaultIssue3CharTokenFee
@@ -36,6 +59,14 @@ var (
 	DefaultGenesisState        = types.DefaultGenesisState
 	NewGenesisState            = types.NewGenesisState
 	NewQueryAssetParams        = types.NewQueryAssetParams
+	NewQueryTokenHoldersParams = types.NewQueryTokenHoldersParams
+	NewForbidExpiry            = types.NewForbidExpiry
+	NewMsgClawback             = types.NewMsgClawback
+	NewMsgBidSymbol            = types.NewMsgBidSymbol
+	NewMsgRevealSymbolBid      = types.NewMsgRevealSymbolBid
+	SealSymbolBid              = types.SealSymbolBid
+	IsAuctionSymbol            = types.IsAuctionSymbol
+	NewMsgAttestToken          = types.NewMsgAttestToken
 	NewToken                   = types.NewToken
 	NewMsgIssueToken           = types.NewMsgIssueToken
 	NewMsgTransferOwnership    = types.NewMsgTransferOwnership
@@ -48,8 +79,22 @@ var (
 	NewMsgForbidAddr           = types.NewMsgForbidAddr
 	NewMsgUnForbidAddr         = types.NewMsgUnForbidAddr
 	NewMsgModifyTokenInfo      = types.NewMsgModifyTokenInfo
+	NewMsgSetOwnerGroup        = types.NewMsgSetOwnerGroup
+	NewMsgProposeTokenAction   = types.NewMsgProposeTokenAction
+	NewMsgApproveTokenAction   = types.NewMsgApproveTokenAction
+	NewOwnerGroup              = types.NewOwnerGroup
+	NewMsgGrantTokenRole       = types.NewMsgGrantTokenRole
+	NewMsgRevokeTokenRole      = types.NewMsgRevokeTokenRole
+	NewTokenRole               = types.NewTokenRole
+	NewMsgSetMintPolicy        = types.NewMsgSetMintPolicy
+	NewMintPolicy              = types.NewMintPolicy
+	ParseMintSchedule          = types.ParseMintSchedule
+	NewMsgAirdrop              = types.NewMsgAirdrop
 	TestIdentityString         = types.TestIdentityString
 	ValidateTokenSymbol        = types.ValidateTokenSymbol
+	ParseDisplayUnits          = types.ParseDisplayUnits
+	FormatAmount               = types.FormatAmount
+	NewTokenDisplay            = types.NewTokenDisplay
 
 	DefaultParams = types.DefaultParams
 
@@ -66,6 +111,8 @@ type (
 	GenesisState            = types.GenesisState
 	Token                   = types.Token
 	BaseToken               = types.BaseToken
+	DisplayUnit             = types.DisplayUnit
+	TokenDisplay            = types.TokenDisplay
 	MsgForbidToken          = types.MsgForbidToken
 	MsgForbidAddr           = types.MsgForbidAddr
 	MsgIssueToken           = types.MsgIssueToken
@@ -77,4 +124,31 @@ type (
 	MsgRemoveTokenWhitelist = types.MsgRemoveTokenWhitelist
 	MsgUnForbidAddr         = types.MsgUnForbidAddr
 	MsgModifyTokenInfo      = types.MsgModifyTokenInfo
+	MsgSetOwnerGroup        = types.MsgSetOwnerGroup
+	MsgProposeTokenAction   = types.MsgProposeTokenAction
+	MsgApproveTokenAction   = types.MsgApproveTokenAction
+	OwnerGroup              = types.OwnerGroup
+	TokenActionProposal     = types.TokenActionProposal
+	MsgGrantTokenRole       = types.MsgGrantTokenRole
+	MsgRevokeTokenRole      = types.MsgRevokeTokenRole
+	TokenRole               = types.TokenRole
+	MsgSetMintPolicy        = types.MsgSetMintPolicy
+	MintPolicy              = types.MintPolicy
+	MintScheduleStep        = types.MintScheduleStep
+	MsgAirdrop              = types.MsgAirdrop
+	AirdropInfo             = types.AirdropInfo
+	TokenHolder             = types.TokenHolder
+	TokenHolders            = types.TokenHolders
+	ForbidExpiry            = types.ForbidExpiry
+	ForbidExpiredInfo       = types.ForbidExpiredInfo
+	MsgClawback             = types.MsgClawback
+	MsgBidSymbol            = types.MsgBidSymbol
+	MsgRevealSymbolBid      = types.MsgRevealSymbolBid
+	SymbolAuction           = types.SymbolAuction
+	SymbolBid               = types.SymbolBid
+	SymbolReservation       = types.SymbolReservation
+	SymbolAuctionResult     = types.SymbolAuctionResult
+	MsgAttestToken          = types.MsgAttestToken
+	TokenInfoVersion        = types.TokenInfoVersion
+	TokenAttestation        = types.TokenAttestation
 )
@@ -11,6 +11,8 @@ const (
 	flagTokenURL         = "url"
 	flagTokenDescription = "description"
 	flagTokenIdentity    = "identity"
+	flagDecimals         = "decimals"
+	flagDisplayUnits     = "display-units"
 
 	flagClientHome  = "home-client"
 	flagOwner       = "owner"
@@ -22,4 +24,32 @@ const (
 	flagAmount    = "amount"
 	flagWhitelist = "whitelist"
 	flagAddresses = "addresses"
+
+	flagMembers    = "members"
+	flagThreshold  = "threshold"
+	flagProposalID = "proposal-id"
+
+	flagGrantee   = "grantee"
+	flagRole      = "role"
+	flagAllowance = "allowance"
+
+	flagMintMaxPerPeriod = "mint-max-per-period"
+	flagMintPeriod       = "mint-period"
+	flagMintSupplyCap    = "mint-supply-cap"
+	flagMintSchedule     = "mint-schedule"
+
+	flagExpireTime = "expire-time"
+
+	flagClawbackable      = "clawbackable"
+	flagClawbackRecipient = "clawback-recipient"
+	flagFrom              = "from-addr"
+	flagMemo              = "clawback-memo"
+
+	flagAfter = "after"
+	flagLimit = "limit"
+
+	flagSalt    = "salt"
+	flagDeposit = "deposit"
+
+	flagClaim = "claim"
 )
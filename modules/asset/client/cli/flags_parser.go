@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
@@ -30,6 +32,30 @@ func parseIssueFlags(owner sdk.AccAddress) (*types.MsgIssueToken, error) {
 		return nil, types.ErrInvalidTokenSupply(flagTotalSupply)
 	}
 	msg := newMsgIssueToken(amt, owner)
+	msg.Decimals = viper.GetString(flagDecimals)
+	units, err := types.ParseDisplayUnits(viper.GetString(flagDisplayUnits))
+	if err != nil {
+		return nil, err
+	}
+	msg.DisplayUnits = units
+	msg.Clawbackable = viper.GetBool(flagClawbackable)
+	if recipient := viper.GetString(flagClawbackRecipient); recipient != "" {
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return nil, err
+		}
+		msg.ClawbackRecipient = addr
+	}
+	for _, flag := range mintPolicyFlags {
+		if viper.GetString(flag) != "" {
+			policy, err := parseMintPolicy()
+			if err != nil {
+				return nil, err
+			}
+			msg.MintPolicy = &policy
+			break
+		}
+	}
 	return &msg, nil
 }
 
@@ -198,6 +224,7 @@ func parseForbidAddrFlags(owner sdk.AccAddress) (*types.MsgForbidAddr, error) {
 		owner,
 		addresses,
 	)
+	msg.ExpireTime = viper.GetInt64(flagExpireTime)
 
 	return &msg, nil
 }
@@ -242,6 +269,299 @@ func parseModifyTokenInfoFlags(owner sdk.AccAddress) (*types.MsgModifyTokenInfo,
 		viper.GetString(flagAddrForbiddable),
 		viper.GetString(flagTokenForbiddable),
 	)
+	msg.Decimals = viper.GetString(flagDecimals)
+	msg.DisplayUnits = viper.GetString(flagDisplayUnits)
+
+	return &msg, nil
+}
+
+func parseSetOwnerGroupFlags(owner sdk.AccAddress) (*types.MsgSetOwnerGroup, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-owner-group -h"); err != nil {
+		return nil, err
+	}
+
+	members := make([]sdk.AccAddress, 0)
+	if str := viper.GetString(flagMembers); len(str) != 0 {
+		for _, s := range strings.Split(str, ",") {
+			addr, err := sdk.AccAddressFromBech32(s)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, addr)
+		}
+	}
+
+	msg := types.NewMsgSetOwnerGroup(
+		viper.GetString(flagSymbol),
+		owner,
+		members,
+		viper.GetUint32(flagThreshold),
+	)
+
+	return &msg, nil
+}
+
+func parseProposeTokenActionFlags(cdc *codec.Codec, proposer sdk.AccAddress, actionFile string) (*types.MsgProposeTokenAction, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset propose-token-action -h"); err != nil {
+		return nil, err
+	}
+
+	bz, err := ioutil.ReadFile(actionFile)
+	if err != nil {
+		return nil, err
+	}
+	var action sdk.Msg
+	if err := cdc.UnmarshalJSON(bz, &action); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgProposeTokenAction(
+		viper.GetString(flagSymbol),
+		proposer,
+		action,
+	)
+
+	return &msg, nil
+}
+
+func parseApproveTokenActionFlags(approver sdk.AccAddress) (*types.MsgApproveTokenAction, error) {
+	if err := checkFlags(approveTokenActionFlags, "$ cetcli tx asset approve-token-action -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgApproveTokenAction(
+		viper.GetString(flagSymbol),
+		viper.GetUint64(flagProposalID),
+		approver,
+	)
+
+	return &msg, nil
+}
+
+func parseGrantTokenRoleFlags(owner sdk.AccAddress) (*types.MsgGrantTokenRole, error) {
+	if err := checkFlags(tokenRoleFlags, "$ cetcli tx asset grant-token-role -h"); err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+	if err != nil {
+		return nil, err
+	}
+	allowance, ok := sdk.NewIntFromString(viper.GetString(flagAllowance))
+	if !ok {
+		return nil, types.ErrInvalidTokenRole(viper.GetString(flagRole) + " with allowance " + viper.GetString(flagAllowance))
+	}
+
+	msg := types.NewMsgGrantTokenRole(
+		viper.GetString(flagSymbol),
+		owner,
+		grantee,
+		viper.GetString(flagRole),
+		allowance,
+	)
+
+	return &msg, nil
+}
+
+func parseRevokeTokenRoleFlags(owner sdk.AccAddress) (*types.MsgRevokeTokenRole, error) {
+	if err := checkFlags(tokenRoleFlags, "$ cetcli tx asset revoke-token-role -h"); err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(viper.GetString(flagGrantee))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgRevokeTokenRole(
+		viper.GetString(flagSymbol),
+		owner,
+		grantee,
+		viper.GetString(flagRole),
+	)
+
+	return &msg, nil
+}
+
+func parseSetMintPolicyFlags(owner sdk.AccAddress) (*types.MsgSetMintPolicy, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset set-mint-policy -h"); err != nil {
+		return nil, err
+	}
+
+	policy, err := parseMintPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgSetMintPolicy(
+		viper.GetString(flagSymbol),
+		owner,
+		policy,
+	)
+
+	return &msg, nil
+}
+
+// parseMintPolicy - the limits not specified are zero, which means no limit
+func parseMintPolicy() (types.MintPolicy, error) {
+	var limits [2]sdk.Int
+	for i, flag := range []string{flagMintMaxPerPeriod, flagMintSupplyCap} {
+		limits[i] = sdk.ZeroInt()
+		if str := viper.GetString(flag); str != "" {
+			amt, ok := sdk.NewIntFromString(str)
+			if !ok {
+				return types.MintPolicy{}, types.ErrInvalidMintPolicy("invalid " + flag + " " + str)
+			}
+			limits[i] = amt
+		}
+	}
+	schedule, err := types.ParseMintSchedule(viper.GetString(flagMintSchedule))
+	if err != nil {
+		return types.MintPolicy{}, err
+	}
+	return types.NewMintPolicy(limits[0], viper.GetInt64(flagMintPeriod), limits[1], schedule), nil
+}
+
+func parseAirdropFlags(owner sdk.AccAddress, recipientsFile string) (*types.MsgAirdrop, error) {
+	if err := checkFlags(symbolFlags, "$ cetcli tx asset airdrop -h"); err != nil {
+		return nil, err
+	}
+
+	bz, err := ioutil.ReadFile(recipientsFile)
+	if err != nil {
+		return nil, err
+	}
+	recipients, amounts, err := parseAirdropRecipients(string(bz), viper.GetString(flagAmount))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAirdrop(
+		viper.GetString(flagSymbol),
+		owner,
+		recipients,
+		amounts,
+	)
+
+	return &msg, nil
+}
+
+// parseAirdropRecipients - each line is "address amount", or only "address" if all the recipients get the same amount
+func parseAirdropRecipients(list, sameAmount string) (recipients []sdk.AccAddress, amounts []sdk.Int, err error) {
+	if sameAmount != "" {
+		amt, ok := sdk.NewIntFromString(sameAmount)
+		if !ok {
+			return nil, nil, types.ErrInvalidAirdrop("invalid amount " + sameAmount)
+		}
+		amounts = append(amounts, amt)
+	}
+	for _, line := range strings.Split(list, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if (sameAmount != "" && len(fields) != 1) || (sameAmount == "" && len(fields) != 2) {
+			return nil, nil, types.ErrInvalidAirdrop("invalid line " + line)
+		}
+		addr, err := sdk.AccAddressFromBech32(fields[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		recipients = append(recipients, addr)
+		if sameAmount == "" {
+			amt, ok := sdk.NewIntFromString(fields[1])
+			if !ok {
+				return nil, nil, types.ErrInvalidAirdrop("invalid line " + line)
+			}
+			amounts = append(amounts, amt)
+		}
+	}
+	return recipients, amounts, nil
+}
+
+func parseClawbackFlags(owner sdk.AccAddress) (*types.MsgClawback, error) {
+	if err := checkFlags(clawbackFlags, "$ cetcli tx asset clawback -h"); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(viper.GetString(flagFrom))
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidClawback("invalid amount " + viper.GetString(flagAmount))
+	}
+
+	msg := types.NewMsgClawback(
+		viper.GetString(flagSymbol),
+		owner,
+		from,
+		amount,
+		viper.GetString(flagMemo),
+	)
+
+	return &msg, nil
+}
+
+func parseBidSymbolFlags(bidder sdk.AccAddress) (*types.MsgBidSymbol, error) {
+	if err := checkFlags(bidSymbolFlags, "$ cetcli tx asset bid-symbol -h"); err != nil {
+		return nil, err
+	}
+
+	amount, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount " + viper.GetString(flagAmount))
+	}
+	deposit, ok := sdk.NewIntFromString(viper.GetString(flagDeposit))
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid deposit " + viper.GetString(flagDeposit))
+	}
+	if amount.GT(deposit) {
+		return nil, types.ErrInvalidSymbolAuction("deposit must cover the bid")
+	}
+
+	symbol := viper.GetString(flagSymbol)
+	msg := types.NewMsgBidSymbol(
+		symbol,
+		bidder,
+		types.SealSymbolBid(symbol, bidder, amount, viper.GetString(flagSalt)),
+		deposit,
+	)
+
+	return &msg, nil
+}
+
+func parseRevealSymbolBidFlags(bidder sdk.AccAddress) (*types.MsgRevealSymbolBid, error) {
+	if err := checkFlags(revealSymbolBidFlags, "$ cetcli tx asset reveal-symbol-bid -h"); err != nil {
+		return nil, err
+	}
+
+	amount, ok := sdk.NewIntFromString(viper.GetString(flagAmount))
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount " + viper.GetString(flagAmount))
+	}
+
+	msg := types.NewMsgRevealSymbolBid(
+		viper.GetString(flagSymbol),
+		bidder,
+		amount,
+		viper.GetString(flagSalt),
+	)
+
+	return &msg, nil
+}
+
+func parseAttestTokenFlags(verifier sdk.AccAddress) (*types.MsgAttestToken, error) {
+	if err := checkFlags([]string{flagSymbol}, "$ cetcli tx asset attest-token -h"); err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgAttestToken(
+		viper.GetString(flagSymbol),
+		verifier,
+		viper.GetString(flagClaim),
+	)
 
 	return &msg, nil
 }
@@ -57,7 +57,8 @@ $ cetd add-genesis-token --name="CoinEx Chain Native Token" \
 	--is-forbidden=false \
 	--url="www.coinex.org" \
 	--description="A public chain built for the decentralized exchange" \ 
-	--identity="552A83BA62F9B1F8"
+	--identity="552A83BA62F9B1F8" \
+	--decimals=8
 `),
 		RunE: func(_ *cobra.Command, args []string) error {
 			config := ctx.Config
@@ -116,6 +117,8 @@ $ cetd add-genesis-token --name="CoinEx Chain Native Token" \
 	cmd.Flags().String(flagTokenURL, "", "url of token website")
 	cmd.Flags().String(flagTokenDescription, "", "description of token info")
 	cmd.Flags().String(flagTokenIdentity, "", "identity of token")
+	cmd.Flags().String(flagDecimals, "", "decimal places of the display amount")
+	cmd.Flags().String(flagDisplayUnits, "", "units used to display amounts, e.g. \"mcet:5,cet:8\"")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range tokenFlags {
@@ -178,6 +181,23 @@ func parseTokenInfo() (types.Token, error) {
 		return nil, err
 	}
 
+	if decimals := viper.GetString(flagDecimals); decimals != "" {
+		d, err := types.ParseDecimals(decimals)
+		if err != nil {
+			return nil, err
+		}
+		if err = token.SetDecimals(d); err != nil {
+			return nil, err
+		}
+	}
+	units, err := types.ParseDisplayUnits(viper.GetString(flagDisplayUnits))
+	if err != nil {
+		return nil, err
+	}
+	if err = token.SetDisplayUnits(units); err != nil {
+		return nil, err
+	}
+
 	token.SetMintable(viper.GetBool(flagMintable))
 	token.SetBurnable(viper.GetBool(flagBurnable))
 	token.SetAddrForbiddable(viper.GetBool(flagAddrForbiddable))
@@ -6,7 +6,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
@@ -23,10 +25,19 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	assQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryParams(types.QuerierRoute, cdc),
 		GetCmdQueryToken(types.QuerierRoute, cdc),
+		GetCmdQueryTokenDisplay(types.QuerierRoute, cdc),
 		GetCmdQueryTokenList(types.QuerierRoute, cdc),
 		GetCmdQueryTokenWhitelist(types.QuerierRoute, cdc),
 		GetCmdQueryTokenForbiddenAddr(types.QuerierRoute, cdc),
 		GetCmdQueryTokenReservedSymbols(types.QuerierRoute, cdc),
+		GetCmdQueryOwnerGroup(types.QuerierRoute, cdc),
+		GetCmdQueryTokenActionProposals(types.QuerierRoute, cdc),
+		GetCmdQueryTokenRoles(types.QuerierRoute, cdc),
+		GetCmdQueryTokenHolders(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolAuctions(types.QuerierRoute, cdc),
+		GetCmdQuerySymbolReservations(types.QuerierRoute, cdc),
+		GetCmdQueryTokenInfoHistory(types.QuerierRoute, cdc),
+		GetCmdQueryTokenAttestations(types.QuerierRoute, cdc),
 	)...)
 
 	return assQueryCmd
@@ -72,6 +83,35 @@ $ cetcli query asset token abc
 	return cmd
 }
 
+// GetCmdQueryTokenDisplay returns a query that will display the amounts of
+// the token formatted with its decimals
+func GetCmdQueryTokenDisplay(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-display [symbol]",
+		Short: "Query token amounts formatted with its decimals",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply, send lock, total burn and total mint of a token,
+formatted with its decimals. The decimals of CET is assumed if the token has not set it.
+
+Example:
+$ cetcli query asset token-display abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenDisplay)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
 // GetCmdQueryTokenList returns all token that will display
 func GetCmdQueryTokenList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,3 +207,221 @@ $ cetcli query asset reserved-symbols
 	}
 	return cmd
 }
+
+// GetCmdQueryOwnerGroup queries the owner group of a token
+func GetCmdQueryOwnerGroup(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-group [symbol]",
+		Short: "Query the owner group of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members and the threshold of the owner group of a token.
+
+Example:
+$ cetcli query asset owner-group abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOwnerGroup)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenActionProposals queries the pending proposals of a token
+func GetCmdQueryTokenActionProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-action-proposals [symbol]",
+		Short: "Query the pending proposals of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the owner operations waiting for the approvals of the owner group.
+
+Example:
+$ cetcli query asset token-action-proposals abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposals)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenRoles queries the roles granted by the owner of a token
+func GetCmdQueryTokenRoles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-roles [symbol]",
+		Short: "Query the roles granted by the owner of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minters, address forbidders and info editors of a token.
+
+Example:
+$ cetcli query asset token-roles abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenRoles)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenHolders queries a page of the holders of a token
+func GetCmdQueryTokenHolders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-holders [symbol]",
+		Short: "Query the holders of a token with their liquid, frozen and locked balances",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a page of the holders of a token ordered by address.
+Use --after with the last address of the previous page to query the next page,
+and --height to take the snapshot at a historical block height.
+
+Example:
+$ cetcli query asset token-holders abc --after=coinex1paehyhx9sxdfwc3rjf85vwn6kjnmzjemtedpnl --limit=100 --height=100000
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenHolders)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			var after sdk.AccAddress
+			if str := viper.GetString(flagAfter); len(str) != 0 {
+				var err error
+				if after, err = sdk.AccAddressFromBech32(str); err != nil {
+					return err
+				}
+			}
+			params := types.NewQueryTokenHoldersParams(symbol, after, viper.GetInt(flagLimit))
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	cmd.Flags().String(flagAfter, "", "the last holder address of the previous page, empty for the first page")
+	cmd.Flags().Int(flagLimit, types.DefaultTokenHoldersLimit, "number of holders in a page")
+	return cmd
+}
+
+// GetCmdQuerySymbolAuctions queries the open symbol auctions
+func GetCmdQuerySymbolAuctions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-auctions",
+		Short: "Query the open symbol auctions",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open auctions of the short symbols, the bids are sealed until revealed.
+
+Example:
+$ cetcli query asset symbol-auctions
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySymbolAuctions)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySymbolReservations queries the symbols won in auctions but not issued yet
+func GetCmdQuerySymbolReservations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "symbol-reservations",
+		Short: "Query the symbols won in auctions",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the symbols won in auctions, which can only be issued by the winners.
+
+Example:
+$ cetcli query asset symbol-reservations
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryReservations)
+			return cliutil.CliQuery(cdc, route, nil)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenInfoHistory queries the info history of a token
+func GetCmdQueryTokenInfoHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-info-history [symbol]",
+		Short: "Query the info history of a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the versions of the name, url, description and identity of a token,
+with the heights and times when they were set.
+
+Example:
+$ cetcli query asset token-info-history abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenInfoHistory)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryTokenAttestations queries the attestations on a token
+func GetCmdQueryTokenAttestations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-attestations [symbol]",
+		Short: "Query the attestations on a token",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the claims attached to a token by the registered verifiers.
+
+Example:
+$ cetcli query asset token-attestations abc
+`,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAttestations)
+			symbol := args[0]
+			if err := types.ValidateTokenSymbol(symbol); err != nil {
+				return err
+			}
+			params := types.NewQueryAssetParams(symbol)
+			return cliutil.CliQuery(cdc, route, params)
+		},
+	}
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
@@ -44,6 +45,17 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdForbidAddr(cdc),
 		GetCmdUnForbidAddr(cdc),
 		GetCmdModifyTokenInfo(cdc),
+		GetCmdSetOwnerGroup(cdc),
+		GetCmdProposeTokenAction(cdc),
+		GetCmdApproveTokenAction(cdc),
+		GetCmdGrantTokenRole(cdc),
+		GetCmdRevokeTokenRole(cdc),
+		GetCmdSetMintPolicy(cdc),
+		GetCmdAirdrop(cdc),
+		GetCmdClawback(cdc),
+		GetCmdBidSymbol(cdc),
+		GetCmdRevealSymbolBid(cdc),
+		GetCmdAttestToken(cdc),
 	)...)
 
 	return assTxCmd
@@ -68,6 +80,9 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	--url="www.abc.org" \
 	--description="token abc is a example token" \
 	--identity="552A83BA62F9B1F8" \
+	--decimals=8 \
+	--display-units="mabc:5,abc:8" \
+	--clawbackable=false \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -89,6 +104,11 @@ $ cetcli tx asset issue-token --name="ABC Token" \
 	cmd.Flags().String(flagTokenURL, "", "url of token website")
 	cmd.Flags().String(flagTokenDescription, "", "description of token info")
 	cmd.Flags().String(flagTokenIdentity, "", "identity of token")
+	cmd.Flags().String(flagDecimals, "", "decimal places of the display amount, it can only be set once")
+	cmd.Flags().String(flagDisplayUnits, "", "units used to display amounts, e.g. \"mabc:5,abc:8\", they can only be set once")
+	cmd.Flags().Bool(flagClawbackable, false, "whether the token owner can take back tokens from forbidden addresses, it can't be changed after issuance")
+	cmd.Flags().String(flagClawbackRecipient, "", "the recovery address of the clawed back tokens, required by a clawbackable token and can't be changed after issuance")
+	addMintPolicyFlags(cmd)
 
 	for _, flag := range issueTokenFlags {
 		_ = cmd.MarkFlagRequired(flag)
@@ -363,10 +383,12 @@ func GetCmdForbidAddr(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			`Create and sign a forbid-addr tx, broadcast to nodes.
 				Multiple addresses separated by commas.
+				The addresses are unforbidden automatically at expire-time (unix seconds) if it's set.
 
 Example:
 $ cetcli tx asset forbid-addr --symbol="abc" \
 	--addresses=key,key,key \
+	--expire-time=1609459200 \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -380,6 +402,7 @@ $ cetcli tx asset forbid-addr --symbol="abc" \
 
 	cmd.Flags().String(flagSymbol, "", "which token address be forbidden")
 	cmd.Flags().String(flagAddresses, "", "forbid addresses")
+	cmd.Flags().Int64(flagExpireTime, 0, "unix time to unforbid the addresses automatically, 0 means never")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 	for _, flag := range addressesFlags {
@@ -449,6 +472,8 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	--url="www.abc.com" \
 	--description="abc example description" \
 	--identity="552A83BA62F9B1F8" \
+	--decimals=8 \
+	--display-units="mabc:5,abc:8" \
 	--from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -470,8 +495,454 @@ $ cetcli tx asset modify-token-info --symbol="abc" \
 	cmd.Flags().String(flagBurnable, types.DoNotModifyTokenInfo, "whether the token could be burned")
 	cmd.Flags().String(flagAddrForbiddable, types.DoNotModifyTokenInfo, "whether the token holder address can be forbidden by token owner")
 	cmd.Flags().String(flagTokenForbiddable, types.DoNotModifyTokenInfo, "whether the token can be forbidden")
+	cmd.Flags().String(flagDecimals, types.DoNotModifyTokenInfo, "decimal places of the display amount, only if not set yet")
+	cmd.Flags().String(flagDisplayUnits, types.DoNotModifyTokenInfo, "units used to display amounts, only if not set yet")
 
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 
 	return cmd
 }
+
+// GetCmdSetOwnerGroup will create a set owner group tx and sign.
+func GetCmdSetOwnerGroup(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-owner-group",
+		Short: "Create and sign a set-owner-group tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set-owner-group tx, broadcast to nodes.
+Once a token has an owner group, the owner operations must be proposed by a member
+with propose-token-action and approved by threshold members. Multiple members
+separated by commas, the group is removed if no member is given.
+
+Example:
+$ cetcli tx asset set-owner-group --symbol="abc" \
+	--members=addr,addr,addr \
+	--threshold=2 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetOwnerGroupFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token owner group be set")
+	cmd.Flags().String(flagMembers, "", "the member addresses of the group")
+	cmd.Flags().Uint32(flagThreshold, 0, "how many members must approve an operation")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdProposeTokenAction will create a propose token action tx and sign.
+func GetCmdProposeTokenAction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-token-action [action-file]",
+		Short: "Create and sign a propose-token-action tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign a propose-token-action tx, broadcast to nodes.
+The action file contains an owner operation in JSON, which can be generated
+by the command of the operation with --generate-only, e.g.
+{"type":"asset/MsgMintToken","value":{"symbol":"abc","amount":"1000","owner_address":"coinex1..."}}
+
+Example:
+$ cetcli tx asset propose-token-action mint.json --symbol="abc" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseProposeTokenActionFlags(cdc, nil, args[0])
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the action belongs to")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+var approveTokenActionFlags = []string{
+	flagSymbol,
+	flagProposalID,
+}
+
+// GetCmdApproveTokenAction will create an approve token action tx and sign.
+func GetCmdApproveTokenAction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-token-action",
+		Short: "Create and sign an approve-token-action tx",
+		Long: strings.TrimSpace(
+			`Create and sign an approve-token-action tx, broadcast to nodes.
+The action is executed once the proposal is approved by threshold members.
+
+Example:
+$ cetcli tx asset approve-token-action --symbol="abc" \
+	--proposal-id=1 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseApproveTokenActionFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token the proposal belongs to")
+	cmd.Flags().Uint64(flagProposalID, 0, "the id of the proposal")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range approveTokenActionFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var tokenRoleFlags = []string{
+	flagSymbol,
+	flagGrantee,
+	flagRole,
+}
+
+// GetCmdGrantTokenRole will create a grant token role tx and sign.
+func GetCmdGrantTokenRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-token-role",
+		Short: "Create and sign a grant-token-role tx",
+		Long: strings.TrimSpace(
+			`Create and sign a grant-token-role tx, broadcast to nodes.
+The roles are minter, addr_forbidder and info_editor. A minter can only mint
+within its allowance if the allowance is positive, the info editor can only
+modify url, description, identity and name of the token.
+
+Example:
+$ cetcli tx asset grant-token-role --symbol="abc" \
+	--grantee=addr \
+	--role=minter \
+	--allowance=100000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseGrantTokenRoleFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token role be granted")
+	cmd.Flags().String(flagGrantee, "", "who will be granted the role")
+	cmd.Flags().String(flagRole, "", "minter, addr_forbidder or info_editor")
+	cmd.Flags().String(flagAllowance, "0", "the mint allowance of minter, 0 means no limit")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range tokenRoleFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdRevokeTokenRole will create a revoke token role tx and sign.
+func GetCmdRevokeTokenRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-token-role",
+		Short: "Create and sign a revoke-token-role tx",
+		Long: strings.TrimSpace(
+			`Create and sign a revoke-token-role tx, broadcast to nodes.
+
+Example:
+$ cetcli tx asset revoke-token-role --symbol="abc" \
+	--grantee=addr \
+	--role=minter \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseRevokeTokenRoleFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token role be revoked")
+	cmd.Flags().String(flagGrantee, "", "whose role will be revoked")
+	cmd.Flags().String(flagRole, "", "minter, addr_forbidder or info_editor")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range tokenRoleFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var mintPolicyFlags = []string{
+	flagMintMaxPerPeriod,
+	flagMintPeriod,
+	flagMintSupplyCap,
+	flagMintSchedule,
+}
+
+func addMintPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagMintMaxPerPeriod, "", "the max amount minted in a period, empty or 0 means no limit")
+	cmd.Flags().String(flagMintPeriod, "", "the length of the period in seconds")
+	cmd.Flags().String(flagMintSupplyCap, "", "the hard cap of total supply, empty or 0 means no limit")
+	cmd.Flags().String(flagMintSchedule, "", "the cumulative amounts allowed to mint over time, e.g. \"1577836800:1000,1609459200:2000\"")
+}
+
+// GetCmdSetMintPolicy will create a set mint policy tx and sign.
+func GetCmdSetMintPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-policy",
+		Short: "Create and sign a set-mint-policy tx",
+		Long: strings.TrimSpace(
+			`Create and sign a set-mint-policy tx, broadcast to nodes.
+Once set, the mint policy can only be replaced by a tighter one.
+
+Example:
+$ cetcli tx asset set-mint-policy --symbol="abc" \
+	--mint-max-per-period=100000000 \
+	--mint-period=86400 \
+	--mint-supply-cap=2100000000000000 \
+	--mint-schedule="1577836800:1000000000,1609459200:2000000000" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseSetMintPolicyFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token mint policy be set")
+	addMintPolicyFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+// GetCmdAirdrop will create an airdrop tx and sign.
+func GetCmdAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop [recipients-file]",
+		Short: "Create and sign an airdrop tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			`Create and sign an airdrop tx, broadcast to nodes.
+The recipients file has one recipient per line, which is an address followed by
+the amount. If --amount is given, every recipient gets that amount and the lines
+contain only the addresses. The activation fees of the fresh accounts are paid
+by the token owner.
+
+Example:
+$ cetcli tx asset airdrop recipients.txt --symbol="abc" \
+	--amount=100000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseAirdropFlags(nil, args[0])
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token be airdropped")
+	cmd.Flags().String(flagAmount, "", "the amount every recipient gets")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
+
+var clawbackFlags = []string{
+	flagSymbol,
+	flagFrom,
+	flagAmount,
+	flagMemo,
+}
+
+// GetCmdClawback will create a clawback tx and sign.
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback",
+		Short: "Create and sign a clawback tx",
+		Long: strings.TrimSpace(
+			`Create and sign a clawback tx, broadcast to nodes.
+Move the token of a forbidden address to the recovery address set by --clawback-recipient
+at issuance, only for the token issued with --clawbackable. The memo records the legal
+reason of the clawback.
+
+Example:
+$ cetcli tx asset clawback --symbol="abc" \
+	--from-addr=coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke \
+	--amount=100000000 \
+	--clawback-memo="court order 2019-123" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseClawbackFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token be taken back")
+	cmd.Flags().String(flagFrom, "", "the forbidden address to take back the token from")
+	cmd.Flags().String(flagAmount, "", "the amount of the token")
+	cmd.Flags().String(flagMemo, "", "the legal reason of the clawback")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range clawbackFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var bidSymbolFlags = []string{
+	flagSymbol,
+	flagAmount,
+	flagSalt,
+	flagDeposit,
+}
+
+// GetCmdBidSymbol will create a bid-symbol tx and sign.
+func GetCmdBidSymbol(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-symbol",
+		Short: "Create and sign a bid-symbol tx",
+		Long: strings.TrimSpace(
+			`Create and sign a bid-symbol tx, broadcast to nodes.
+Place a sealed bid in the auction of a short symbol, the first bid opens the auction.
+Only the hash of the amount and the salt is sent, keep them to reveal the bid in the
+reveal phase, the deposit is forfeited if the bid is not revealed.
+
+Example:
+$ cetcli tx asset bid-symbol --symbol="abc" \
+	--amount=1000000000000 \
+	--salt="my secret salt" \
+	--deposit=2000000000000 \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bidder := context.NewCLIContext().GetFromAddress()
+			msg, err := parseBidSymbolFlags(bidder)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which symbol to bid for")
+	cmd.Flags().String(flagAmount, "", "the amount of CET to bid, which is sealed until revealed")
+	cmd.Flags().String(flagSalt, "", "the secret salt to seal the bid")
+	cmd.Flags().String(flagDeposit, "", "the amount of CET to lock, which must cover the bid")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range bidSymbolFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+var revealSymbolBidFlags = []string{
+	flagSymbol,
+	flagAmount,
+	flagSalt,
+}
+
+// GetCmdRevealSymbolBid will create a reveal-symbol-bid tx and sign.
+func GetCmdRevealSymbolBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-symbol-bid",
+		Short: "Create and sign a reveal-symbol-bid tx",
+		Long: strings.TrimSpace(
+			`Create and sign a reveal-symbol-bid tx, broadcast to nodes.
+Reveal the sealed bid with the same amount and salt after the bidding phase.
+
+Example:
+$ cetcli tx asset reveal-symbol-bid --symbol="abc" \
+	--amount=1000000000000 \
+	--salt="my secret salt" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseRevealSymbolBidFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which symbol the bid is for")
+	cmd.Flags().String(flagAmount, "", "the amount of CET in the sealed bid")
+	cmd.Flags().String(flagSalt, "", "the secret salt used to seal the bid")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	for _, flag := range revealSymbolBidFlags {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+
+	return cmd
+}
+
+// GetCmdAttestToken will create an attest-token tx and sign.
+func GetCmdAttestToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-token",
+		Short: "Create and sign an attest-token tx",
+		Long: strings.TrimSpace(
+			`Create and sign an attest-token tx, broadcast to nodes.
+Attach a claim to a token, only for the registered token verifiers. The previous
+claim of the verifier is replaced, and an empty claim revokes it.
+
+Example:
+$ cetcli tx asset attest-token --symbol="abc" \
+	--claim="identity verified" \
+	--from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg, err := parseAttestTokenFlags(nil)
+			if err != nil {
+				return err
+			}
+			return cliutil.CliRunCommand(cdc, msg)
+		},
+	}
+
+	cmd.Flags().String(flagSymbol, "", "which token to attest")
+	cmd.Flags().String(flagClaim, "", "the claim about the token, empty to revoke")
+
+	_ = cmd.MarkFlagRequired(client.FlagFrom)
+	_ = cmd.MarkFlagRequired(flagSymbol)
+
+	return cmd
+}
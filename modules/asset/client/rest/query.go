@@ -3,9 +3,11 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
@@ -20,11 +22,20 @@ var (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, storeName string) {
 	r.HandleFunc("/asset/tokens/{symbol}", QueryTokenRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/display", QueryTokenDisplayRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens", QueryTokensRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/whitelist", QueryWhitelistRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", QueryForbiddenAddrRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/owner-group", QueryOwnerGroupRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals", QueryTokenActionProposalsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/roles", QueryTokenRolesRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/holders", QueryTokenHoldersRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/info-history", QueryTokenInfoHistoryRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/tokens/{symbol}/attestations", QueryTokenAttestationsRequestHandlerFn(storeName, cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/tokens/reserved/symbols", QueryReservedSymbolsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 	r.HandleFunc("/asset/parameters", QueryParamsHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/symbol-auctions", QuerySymbolAuctionsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
+	r.HandleFunc("/asset/symbol-reservations", QuerySymbolReservationsRequestHandlerFn(storeName, cliCtx)).Methods("GET")
 }
 
 // QueryTokenRequestHandlerFn - query assetREST Handler
@@ -43,6 +54,109 @@ func QueryTokenRequestHandlerFn(
 	}
 }
 
+// QueryTokenDisplayRequestHandlerFn - query assetREST Handler
+func QueryTokenDisplayRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenDisplay)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryOwnerGroupRequestHandlerFn - query assetREST Handler
+func QueryOwnerGroupRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryOwnerGroup)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryTokenActionProposalsRequestHandlerFn - query assetREST Handler
+func QueryTokenActionProposalsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryProposals)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryTokenRolesRequestHandlerFn - query assetREST Handler
+func QueryTokenRolesRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenRoles)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// QueryTokenHoldersRequestHandlerFn - query assetREST Handler, after (the last address
+// of the previous page) and limit are optional, and the snapshot can be taken at a historical height
+func QueryTokenHoldersRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenHolders)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var after sdk.AccAddress
+		if str := r.URL.Query().Get("after"); len(str) != 0 {
+			var err error
+			if after, err = sdk.AccAddressFromBech32(str); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid after address")
+				return
+			}
+		}
+		limit, err := parseIntQueryParam(r, "limit")
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		params := types.NewQueryTokenHoldersParams(symbol, after, limit)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONObj)
+	}
+}
+
+// parseIntQueryParam - returns 0 if the param is absent, which means the default value
+func parseIntQueryParam(r *http.Request, name string) (int, error) {
+	str := r.URL.Query().Get(name)
+	if len(str) == 0 {
+		return 0, nil
+	}
+	return strconv.Atoi(str)
+}
+
 // QueryTokensRequestHandlerFn - query assetREST Handler
 func QueryTokensRequestHandlerFn(
 	storeName string, cliCtx context.CLIContext,
@@ -102,3 +216,55 @@ func QueryParamsHandlerFn(storeName string, cliCtx context.CLIContext) http.Hand
 		restutil.RestQuery(nil, cliCtx, w, r, route, nil, nil)
 	}
 }
+
+// QuerySymbolAuctionsRequestHandlerFn - query the open symbol auctions
+func QuerySymbolAuctionsRequestHandlerFn(
+	storeName string, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySymbolAuctions)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+	}
+}
+
+// QuerySymbolReservationsRequestHandlerFn - query the symbols won in auctions
+func QuerySymbolReservationsRequestHandlerFn(
+	storeName string, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryReservations)
+		restutil.RestQuery(nil, cliCtx, w, r, route, nil, emptyJSONArr)
+	}
+}
+
+// QueryTokenInfoHistoryRequestHandlerFn - query the info history of a token
+func QueryTokenInfoHistoryRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenInfoHistory)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
+
+// QueryTokenAttestationsRequestHandlerFn - query the attestations on a token
+func QueryTokenAttestationsRequestHandlerFn(
+	storeName string, cdc *codec.Codec, cliCtx context.CLIContext,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryAttestations)
+		symbol := mux.Vars(r)["symbol"]
+		if err := types.ValidateTokenSymbol(symbol); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.NewQueryAssetParams(symbol)
+		restutil.RestQuery(cdc, cliCtx, w, r, route, params, emptyJSONArr)
+	}
+}
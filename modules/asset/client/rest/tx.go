@@ -15,7 +15,8 @@ import (
 )
 
 const (
-	symbol = "symbol"
+	symbol     = "symbol"
+	proposalID = "proposal_id"
 )
 
 // registerTXRoutes -
@@ -31,6 +32,17 @@ func registerTXRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 	r.HandleFunc("/asset/tokens/{symbol}/forbidden/addresses", forbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/unforbidden/addresses", unForbidAddrHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc("/asset/tokens/{symbol}/infos", modifyTokenInfoHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/owner-group", setOwnerGroupHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals", proposeTokenActionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/proposals/{proposal_id}/approvals", approveTokenActionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/roles/grants", grantTokenRoleHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/roles/revokes", revokeTokenRoleHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/mint-policy", setMintPolicyHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/airdrops", airdropHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/clawbacks", clawbackHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/tokens/{symbol}/attestations", attestTokenHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/symbol-auctions/{symbol}/bids", bidSymbolHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/asset/symbol-auctions/{symbol}/reveals", revealSymbolBidHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 // issueRequestHandlerFn - http request handler to issue new token.
@@ -99,3 +111,58 @@ func unForbidAddrHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 func modifyTokenInfoHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandler(cdc, cliCtx, new(modifyTokenInfoReq))
 }
+
+// setOwnerGroupHandlerFn - http request handler to set owner group.
+func setOwnerGroupHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setOwnerGroupReq))
+}
+
+// proposeTokenActionHandlerFn - http request handler to propose an owner operation.
+func proposeTokenActionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(proposeTokenActionReq))
+}
+
+// approveTokenActionHandlerFn - http request handler to approve a proposal.
+func approveTokenActionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(approveTokenActionReq))
+}
+
+// grantTokenRoleHandlerFn - http request handler to grant a token role.
+func grantTokenRoleHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(grantTokenRoleReq))
+}
+
+// revokeTokenRoleHandlerFn - http request handler to revoke a token role.
+func revokeTokenRoleHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(revokeTokenRoleReq))
+}
+
+// setMintPolicyHandlerFn - http request handler to set the mint policy of token.
+func setMintPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setMintPolicyReq))
+}
+
+// airdropHandlerFn - http request handler to airdrop token.
+func airdropHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(airdropReq))
+}
+
+// clawbackHandlerFn - http request handler to take back token from a forbidden address.
+func clawbackHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(clawbackReq))
+}
+
+// bidSymbolHandlerFn - http request handler to place a sealed bid in a symbol auction.
+func bidSymbolHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(bidSymbolReq))
+}
+
+// revealSymbolBidHandlerFn - http request handler to reveal a sealed bid in a symbol auction.
+func revealSymbolBidHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(revealSymbolBidReq))
+}
+
+// attestTokenHandlerFn - http request handler to attach an attestation to a token.
+func attestTokenHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(attestTokenReq))
+}
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -26,6 +27,12 @@ type (
 		URL              string       `json:"url" yaml:"url"`
 		Description      string       `json:"description" yaml:"description"`
 		Identity         string       `json:"identity" yaml:"identity"`
+
+		Decimals          string              `json:"decimals,omitempty" yaml:"decimals,omitempty"`
+		DisplayUnits      []types.DisplayUnit `json:"display_units,omitempty" yaml:"display_units,omitempty"`
+		MintPolicy        *mintPolicy         `json:"mint_policy,omitempty" yaml:"mint_policy,omitempty"`
+		Clawbackable      bool                `json:"clawbackable,omitempty" yaml:"clawbackable,omitempty"`
+		ClawbackRecipient sdk.AccAddress      `json:"clawback_recipient,omitempty" yaml:"clawback_recipient,omitempty"`
 	}
 
 	// transferOwnerReq defines the properties of a transfer ownership request's body.
@@ -64,8 +71,9 @@ type (
 		Whitelist []sdk.AccAddress `json:"whitelist" yaml:"whitelist"`
 	}
 	forbidAddrReq struct {
-		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
-		Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+		BaseReq    rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Addresses  []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+		ExpireTime string           `json:"expire_time,omitempty" yaml:"expire_time,omitempty"`
 	}
 	unforbidAddrReq struct {
 		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
@@ -83,6 +91,88 @@ type (
 		Burnable         *string      `json:"burnable" yaml:"burnable"`
 		AddrForbiddable  *string      `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 		TokenForbiddable *string      `json:"token_forbiddable" yaml:"token_forbiddable"`
+		Decimals         *string      `json:"decimals" yaml:"decimals"`
+		DisplayUnits     *string      `json:"display_units" yaml:"display_units"`
+	}
+	// setOwnerGroupReq defines the properties of a set owner group request's body.
+	setOwnerGroupReq struct {
+		BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Members   []sdk.AccAddress `json:"members" yaml:"members"`
+		Threshold uint32           `json:"threshold" yaml:"threshold"`
+	}
+	// proposeTokenActionReq defines the properties of a propose token action request's body.
+	proposeTokenActionReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Action  sdk.Msg      `json:"action" yaml:"action"`
+	}
+	// approveTokenActionReq defines the properties of an approve token action request's body.
+	approveTokenActionReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+	// grantTokenRoleReq defines the properties of a grant token role request's body.
+	grantTokenRoleReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+		Role      string         `json:"role" yaml:"role"`
+		Allowance string         `json:"allowance,omitempty" yaml:"allowance,omitempty"`
+	}
+	// revokeTokenRoleReq defines the properties of a revoke token role request's body.
+	revokeTokenRoleReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+		Role    string         `json:"role" yaml:"role"`
+	}
+	// setMintPolicyReq defines the properties of a set mint policy request's body.
+	setMintPolicyReq struct {
+		BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+		MintPolicy mintPolicy   `json:"mint_policy" yaml:"mint_policy"`
+	}
+
+	// airdropReq defines the properties of an airdrop request's body, amounts has only
+	// one element if all the recipients get the same amount.
+	airdropReq struct {
+		BaseReq    rest.BaseReq     `json:"base_req" yaml:"base_req"`
+		Recipients []sdk.AccAddress `json:"recipients" yaml:"recipients"`
+		Amounts    []string         `json:"amounts" yaml:"amounts"`
+	}
+
+	// clawbackReq defines the properties of a clawback request's body.
+	clawbackReq struct {
+		BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		From    sdk.AccAddress `json:"from" yaml:"from"`
+		Amount  string         `json:"amount" yaml:"amount"`
+		Memo    string         `json:"memo" yaml:"memo"`
+	}
+
+	// bidSymbolReq defines the properties of a bid-symbol request's body,
+	// the bid is sealed with the salt before it is sent to the chain.
+	bidSymbolReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+		Salt    string       `json:"salt" yaml:"salt"`
+		Deposit string       `json:"deposit" yaml:"deposit"`
+	}
+
+	// revealSymbolBidReq defines the properties of a reveal-symbol-bid request's body.
+	revealSymbolBidReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  string       `json:"amount" yaml:"amount"`
+		Salt    string       `json:"salt" yaml:"salt"`
+	}
+
+	// attestTokenReq defines the properties of an attest-token request's body,
+	// an empty claim revokes the attestation.
+	attestTokenReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Claim   string       `json:"claim" yaml:"claim"`
+	}
+
+	// mintPolicy - the limits not specified are zero, which means no limit
+	mintPolicy struct {
+		MaxPerPeriod string `json:"max_per_period,omitempty" yaml:"max_per_period,omitempty"`
+		Period       int64  `json:"period,omitempty" yaml:"period,omitempty"`
+		SupplyCap    string `json:"supply_cap,omitempty" yaml:"supply_cap,omitempty"`
+		Schedule     string `json:"schedule,omitempty" yaml:"schedule,omitempty"` // e.g. "1577836800:1000,1609459200:2000"
 	}
 )
 
@@ -97,9 +187,21 @@ func (req *issueReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, err
 	if !ok {
 		return nil, types.ErrInvalidTokenSupply(req.TotalSupply)
 	}
-	return types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
+	msg := types.NewMsgIssueToken(req.Name, req.Symbol, amt, owner,
 		req.Mintable, req.Burnable, req.AddrForbiddable, req.TokenForbiddable,
-		req.URL, req.Description, req.Identity), nil
+		req.URL, req.Description, req.Identity)
+	msg.Decimals = req.Decimals
+	msg.DisplayUnits = req.DisplayUnits
+	msg.Clawbackable = req.Clawbackable
+	msg.ClawbackRecipient = req.ClawbackRecipient
+	if req.MintPolicy != nil {
+		policy, err := req.MintPolicy.parse()
+		if err != nil {
+			return nil, err
+		}
+		msg.MintPolicy = &policy
+	}
+	return msg, nil
 }
 
 func (req *transferOwnerReq) New() restutil.RestReq {
@@ -199,7 +301,15 @@ func (req *forbidAddrReq) GetBaseReq() *rest.BaseReq {
 }
 func (req *forbidAddrReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
 	symbol := getSymbol(r)
-	return types.NewMsgForbidAddr(symbol, owner, req.Addresses), nil
+	msg := types.NewMsgForbidAddr(symbol, owner, req.Addresses)
+	if len(req.ExpireTime) != 0 {
+		expireTime, err := strconv.ParseInt(req.ExpireTime, 10, 64)
+		if err != nil {
+			return nil, types.ErrInvalidForbidExpireTime(expireTime)
+		}
+		msg.ExpireTime = expireTime
+	}
+	return msg, nil
 }
 
 func (req *unforbidAddrReq) New() restutil.RestReq {
@@ -231,8 +341,77 @@ func (req *modifyTokenInfoReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sd
 	addrForbiddable := getNewTokenInfo(req.AddrForbiddable)
 	tokenForbiddable := getNewTokenInfo(req.TokenForbiddable)
 
-	return types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
-		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable), nil
+	msg := types.NewMsgModifyTokenInfo(symbol, url, description, identity, owner,
+		name, supply, mintable, burnable, addrForbiddable, tokenForbiddable)
+	msg.Decimals = getNewTokenInfo(req.Decimals)
+	msg.DisplayUnits = getNewTokenInfo(req.DisplayUnits)
+	return msg, nil
+}
+
+func (req *setOwnerGroupReq) New() restutil.RestReq {
+	return new(setOwnerGroupReq)
+}
+func (req *setOwnerGroupReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setOwnerGroupReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgSetOwnerGroup(symbol, owner, req.Members, req.Threshold), nil
+}
+
+func (req *proposeTokenActionReq) New() restutil.RestReq {
+	return new(proposeTokenActionReq)
+}
+func (req *proposeTokenActionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *proposeTokenActionReq) GetMsg(r *http.Request, proposer sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgProposeTokenAction(symbol, proposer, req.Action), nil
+}
+
+func (req *approveTokenActionReq) New() restutil.RestReq {
+	return new(approveTokenActionReq)
+}
+func (req *approveTokenActionReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *approveTokenActionReq) GetMsg(r *http.Request, approver sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	id, err := strconv.ParseUint(mux.Vars(r)[proposalID], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgApproveTokenAction(symbol, id, approver), nil
+}
+
+func (req *grantTokenRoleReq) New() restutil.RestReq {
+	return new(grantTokenRoleReq)
+}
+func (req *grantTokenRoleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *grantTokenRoleReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	allowance := sdk.ZeroInt()
+	if len(req.Allowance) != 0 {
+		var ok bool
+		if allowance, ok = sdk.NewIntFromString(req.Allowance); !ok {
+			return nil, types.ErrInvalidTokenRole(req.Role + " with allowance " + req.Allowance)
+		}
+	}
+	return types.NewMsgGrantTokenRole(symbol, owner, req.Grantee, req.Role, allowance), nil
+}
+
+func (req *revokeTokenRoleReq) New() restutil.RestReq {
+	return new(revokeTokenRoleReq)
+}
+func (req *revokeTokenRoleReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *revokeTokenRoleReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgRevokeTokenRole(symbol, owner, req.Grantee, req.Role), nil
 }
 
 func getNewTokenInfo(ptr *string) string {
@@ -246,3 +425,121 @@ func getSymbol(r *http.Request) string {
 	vars := mux.Vars(r)
 	return vars[symbol]
 }
+
+func (req *setMintPolicyReq) New() restutil.RestReq {
+	return new(setMintPolicyReq)
+}
+func (req *setMintPolicyReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setMintPolicyReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	policy, err := req.MintPolicy.parse()
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgSetMintPolicy(symbol, owner, policy), nil
+}
+
+func (req *airdropReq) New() restutil.RestReq {
+	return new(airdropReq)
+}
+func (req *airdropReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *airdropReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amounts := make([]sdk.Int, len(req.Amounts))
+	for i, str := range req.Amounts {
+		amt, ok := sdk.NewIntFromString(str)
+		if !ok {
+			return nil, types.ErrInvalidAirdrop("invalid amount " + str)
+		}
+		amounts[i] = amt
+	}
+	return types.NewMsgAirdrop(symbol, owner, req.Recipients, amounts), nil
+}
+
+func (req *clawbackReq) New() restutil.RestReq {
+	return new(clawbackReq)
+}
+func (req *clawbackReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *clawbackReq) GetMsg(r *http.Request, owner sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidClawback("invalid amount " + req.Amount)
+	}
+	return types.NewMsgClawback(symbol, owner, req.From, amount, req.Memo), nil
+}
+
+func (req *bidSymbolReq) New() restutil.RestReq {
+	return new(bidSymbolReq)
+}
+func (req *bidSymbolReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *bidSymbolReq) GetMsg(r *http.Request, bidder sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount " + req.Amount)
+	}
+	deposit, ok := sdk.NewIntFromString(req.Deposit)
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid deposit " + req.Deposit)
+	}
+	if amount.GT(deposit) {
+		return nil, types.ErrInvalidSymbolAuction("deposit must cover the bid")
+	}
+	sealedBid := types.SealSymbolBid(symbol, bidder, amount, req.Salt)
+	return types.NewMsgBidSymbol(symbol, bidder, sealedBid, deposit), nil
+}
+
+func (req *revealSymbolBidReq) New() restutil.RestReq {
+	return new(revealSymbolBidReq)
+}
+func (req *revealSymbolBidReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *revealSymbolBidReq) GetMsg(r *http.Request, bidder sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok {
+		return nil, types.ErrInvalidSymbolAuction("invalid amount " + req.Amount)
+	}
+	return types.NewMsgRevealSymbolBid(symbol, bidder, amount, req.Salt), nil
+}
+
+func (req *attestTokenReq) New() restutil.RestReq {
+	return new(attestTokenReq)
+}
+func (req *attestTokenReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *attestTokenReq) GetMsg(r *http.Request, verifier sdk.AccAddress) (sdk.Msg, error) {
+	symbol := getSymbol(r)
+	return types.NewMsgAttestToken(symbol, verifier, req.Claim), nil
+}
+
+func (p mintPolicy) parse() (types.MintPolicy, error) {
+	maxPerPeriod, supplyCap := sdk.ZeroInt(), sdk.ZeroInt()
+	var ok bool
+	if len(p.MaxPerPeriod) != 0 {
+		if maxPerPeriod, ok = sdk.NewIntFromString(p.MaxPerPeriod); !ok {
+			return types.MintPolicy{}, types.ErrInvalidMintPolicy("invalid max per period " + p.MaxPerPeriod)
+		}
+	}
+	if len(p.SupplyCap) != 0 {
+		if supplyCap, ok = sdk.NewIntFromString(p.SupplyCap); !ok {
+			return types.MintPolicy{}, types.ErrInvalidMintPolicy("invalid supply cap " + p.SupplyCap)
+		}
+	}
+	schedule, err := types.ParseMintSchedule(p.Schedule)
+	if err != nil {
+		return types.MintPolicy{}, err
+	}
+	return types.NewMintPolicy(maxPerPeriod, p.Period, supplyCap, schedule), nil
+}
@@ -0,0 +1,64 @@
+package asset
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// EndBlocker - unforbid the addresses whose forbids have expired, settle the symbol auctions
+// and remove the expired symbol reservations
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	settleSymbolAuctions(ctx, keeper)
+	expireSymbolReservations(ctx, keeper)
+
+	expired := keeper.UnForbidExpiredAddresses(ctx, ctx.BlockHeader().Time.Unix())
+	if len(expired) == 0 {
+		return
+	}
+
+	// group the expired addresses by token, keeping the order of expire time
+	var infos []types.ForbidExpiredInfo
+	index := make(map[string]int)
+	for _, expiry := range expired {
+		i, ok := index[expiry.Symbol]
+		if !ok {
+			i = len(infos)
+			index[expiry.Symbol] = i
+			infos = append(infos, types.ForbidExpiredInfo{Symbol: expiry.Symbol, Height: ctx.BlockHeight()})
+		}
+		infos[i].Addresses = append(infos[i].Addresses, expiry.Address)
+	}
+
+	for _, info := range infos {
+		var str string
+		for _, addr := range info.Addresses {
+			str = str + addr.String() + ","
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUnForbidAddr,
+			sdk.NewAttribute(types.AttributeKeySymbol, info.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+		))
+		fillMsgQueue(ctx, keeper, types.ForbidExpiredInfoKey, info)
+	}
+}
+
+func settleSymbolAuctions(ctx sdk.Context, keeper Keeper) {
+	for _, result := range keeper.SettleSymbolAuctions(ctx, ctx.BlockHeader().Time.Unix()) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSettleSymbolAuction,
+			sdk.NewAttribute(types.AttributeKeySymbol, result.Symbol),
+			sdk.NewAttribute(types.AttributeKeyWinner, result.Winner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, result.Price.String()),
+		))
+		fillMsgQueue(ctx, keeper, types.SymbolAuctionResultKey, result)
+	}
+}
+
+func expireSymbolReservations(ctx sdk.Context, keeper Keeper) {
+	for _, reservation := range keeper.ExpireSymbolReservations(ctx, ctx.BlockHeader().Time.Unix()) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExpireReservation,
+			sdk.NewAttribute(types.AttributeKeySymbol, reservation.Symbol),
+			sdk.NewAttribute(types.AttributeKeyWinner, reservation.Owner.String()),
+		))
+	}
+}
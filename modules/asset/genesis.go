@@ -28,6 +28,33 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+	for _, group := range data.OwnerGroups {
+		keeper.ImportGenesisOwnerGroup(ctx, group)
+	}
+	for _, proposal := range data.Proposals {
+		keeper.ImportGenesisTokenActionProposal(ctx, proposal)
+	}
+	for _, role := range data.Roles {
+		keeper.ImportGenesisTokenRole(ctx, role)
+	}
+	for _, expiry := range data.ForbidExpiries {
+		keeper.ImportGenesisForbidExpiry(ctx, expiry)
+	}
+	for _, auction := range data.SymbolAuctions {
+		keeper.ImportGenesisSymbolAuction(ctx, auction)
+	}
+	for _, reservation := range data.SymbolReservations {
+		keeper.ImportGenesisSymbolReservation(ctx, reservation)
+	}
+	for _, version := range data.TokenInfoHistory {
+		keeper.ImportGenesisTokenInfoVersion(ctx, version)
+	}
+	for _, token := range data.Tokens {
+		keeper.SeedGenesisTokenInfo(ctx, token)
+	}
+	for _, attestation := range data.Attestations {
+		keeper.ImportGenesisTokenAttestation(ctx, attestation)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -36,7 +63,15 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		keeper.GetParams(ctx),
 		keeper.GetAllTokens(ctx),
 		keeper.ExportGenesisAddrKeys(ctx, types.WhitelistKey),
-		keeper.ExportGenesisAddrKeys(ctx, types.ForbiddenAddrKey))
+		keeper.ExportGenesisAddrKeys(ctx, types.ForbiddenAddrKey),
+		keeper.GetAllOwnerGroups(ctx),
+		keeper.GetTokenActionProposals(ctx, ""),
+		keeper.GetTokenRoles(ctx, ""),
+		keeper.GetForbidExpiries(ctx),
+		keeper.GetSymbolAuctions(ctx),
+		keeper.GetSymbolReservations(ctx),
+		keeper.GetTokenInfoHistory(ctx, ""),
+		keeper.GetTokenAttestations(ctx, ""))
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -91,5 +126,80 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, group := range data.OwnerGroups {
+		if _, exists := tokenSymbols[group.Symbol]; !exists {
+			return types.ErrTokenNotFound(group.Symbol)
+		}
+		if err := group.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, proposal := range data.Proposals {
+		if symbol, ok := types.GetOwnerGroupActionSymbol(proposal.Action); !ok || symbol != proposal.Symbol {
+			return errors.New("invalid token action proposal found in GenesisState")
+		}
+	}
+
+	for _, role := range data.Roles {
+		token, exists := tokenSymbols[role.Symbol]
+		if !exists {
+			return types.ErrTokenNotFound(role.Symbol)
+		}
+		if err := role.Validate(); err != nil {
+			return err
+		}
+		if role.Grantee.Equals(token.GetOwner()) {
+			return types.ErrTokenRoleGrantedToOwner(role.Role)
+		}
+	}
+
+	for _, expiry := range data.ForbidExpiries {
+		if _, exists := tokenSymbols[expiry.Symbol]; !exists {
+			return types.ErrTokenNotFound(expiry.Symbol)
+		}
+		if expiry.Address.Empty() || expiry.ExpireTime <= 0 {
+			return types.ErrInvalidForbidExpireTime(expiry.ExpireTime)
+		}
+	}
+
+	auctionSymbols := make(map[string]bool)
+	for _, auction := range data.SymbolAuctions {
+		if _, exists := tokenSymbols[auction.Symbol]; exists || auctionSymbols[auction.Symbol] {
+			return types.ErrInvalidSymbolAuction("duplicate symbol " + auction.Symbol)
+		}
+		auctionSymbols[auction.Symbol] = true
+		if !types.IsAuctionSymbol(auction.Symbol) || auction.BidEndTime > auction.RevealEndTime {
+			return types.ErrInvalidSymbolAuction(auction.Symbol)
+		}
+	}
+
+	for _, reservation := range data.SymbolReservations {
+		if _, exists := tokenSymbols[reservation.Symbol]; exists || auctionSymbols[reservation.Symbol] {
+			return types.ErrInvalidSymbolAuction("duplicate symbol " + reservation.Symbol)
+		}
+		if reservation.Owner.Empty() || reservation.Price.IsNegative() || reservation.ExpireTime <= 0 {
+			return types.ErrInvalidSymbolAuction(reservation.Symbol)
+		}
+	}
+
+	for _, version := range data.TokenInfoHistory {
+		if _, exists := tokenSymbols[version.Symbol]; !exists {
+			return types.ErrTokenNotFound(version.Symbol)
+		}
+		if version.Version == 0 {
+			return errors.New("invalid token info version found in GenesisState")
+		}
+	}
+
+	for _, attestation := range data.Attestations {
+		if _, exists := tokenSymbols[attestation.Symbol]; !exists {
+			return types.ErrTokenNotFound(attestation.Symbol)
+		}
+		if err := attestation.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
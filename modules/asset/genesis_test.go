@@ -86,9 +86,38 @@ func TestGenesis(t *testing.T) {
 	forbiddenList := []string{"abc:coinex1p9ek7d3r9z4l288v4lrkwwrnh9k5htezk2q68g"}
 	state.ForbiddenAddresses = append(state.ForbiddenAddresses, forbiddenList...)
 
+	member, _ := sdk.AccAddressFromBech32("coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke")
+	state.OwnerGroups = append(state.OwnerGroups, asset.NewOwnerGroup("abc", []sdk.AccAddress{owner, member}, 2))
+	state.Proposals = append(state.Proposals, asset.TokenActionProposal{
+		ID:        3,
+		Symbol:    "abc",
+		Proposer:  member,
+		Action:    asset.NewMsgMintToken("abc", sdk.NewInt(100), owner),
+		Approvals: []sdk.AccAddress{member},
+	})
+	state.TokenInfoHistory = append(state.TokenInfoHistory, asset.TokenInfoVersion{
+		Symbol:   "abc",
+		Version:  1,
+		Name:     abc.Name,
+		Identity: abc.Identity,
+	})
+
+	// proposals carry the actions as sdk.Msg
+	bz := asset.ModuleCdc.MustMarshalJSON(state)
+	var decoded asset.GenesisState
+	asset.ModuleCdc.MustUnmarshalJSON(bz, &decoded)
+	require.Equal(t, state.Proposals, decoded.Proposals)
+
 	require.NoError(t, asset.ValidateGenesis(state))
 	asset.InitGenesis(input.ctx, input.tk, state)
 
+	// the tokens without history are seeded with their current info
+	history := input.tk.GetTokenInfoHistory(input.ctx, "cet")
+	require.Equal(t, 1, len(history))
+	require.Equal(t, uint64(1), history[0].Version)
+	require.Equal(t, cet.Name, history[0].Name)
+	require.Equal(t, 1, len(input.tk.GetTokenInfoHistory(input.ctx, "abc")))
+
 	res := input.tk.GetWhitelist(input.ctx, "cet")
 	require.Equal(t, 1, len(res))
 	require.Equal(t, "coinex1y5kdxnzn2tfwayyntf2n28q8q2s80mcul852ke", res[0].String())
@@ -107,6 +136,8 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, 2, len(export.Tokens))
 	require.Equal(t, whitelist, export.Whitelist)
 	require.Equal(t, forbiddenList, export.ForbiddenAddresses)
+	require.Equal(t, state.OwnerGroups, export.OwnerGroups)
+	require.Equal(t, state.Proposals, export.Proposals)
 
 	forbiddenList = []string{"abc:coinex15fvnexrvsm9ryw3nn4mcrnqyhvhazkkrd4aqvd"}
 	state.ForbiddenAddresses = append(state.ForbiddenAddresses, forbiddenList...)
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -18,38 +20,120 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgIssueToken:
 			return handleMsgIssueToken(ctx, keeper, msg)
-		case types.MsgTransferOwnership:
-			return handleMsgTransferOwnership(ctx, keeper, msg)
-		case types.MsgMintToken:
-			return handleMsgMintToken(ctx, keeper, msg)
-		case types.MsgBurnToken:
-			return handleMsgBurnToken(ctx, keeper, msg)
-		case types.MsgForbidToken:
-			return handleMsgForbidToken(ctx, keeper, msg)
-		case types.MsgUnForbidToken:
-			return handleMsgUnForbidToken(ctx, keeper, msg)
-		case types.MsgAddTokenWhitelist:
-			return handleMsgAddTokenWhitelist(ctx, keeper, msg)
-		case types.MsgRemoveTokenWhitelist:
-			return handleMsgRemoveTokenWhitelist(ctx, keeper, msg)
-		case types.MsgForbidAddr:
-			return handleMsgForbidAddr(ctx, keeper, msg)
-		case types.MsgUnForbidAddr:
-			return handleMsgUnForbidAddr(ctx, keeper, msg)
-		case types.MsgModifyTokenInfo:
-			return handleMsgModifyTokenInfo(ctx, keeper, msg)
+		case types.MsgProposeTokenAction:
+			return handleMsgProposeTokenAction(ctx, keeper, msg)
+		case types.MsgApproveTokenAction:
+			return handleMsgApproveTokenAction(ctx, keeper, msg)
+		case types.MsgAirdrop:
+			return handleMsgAirdrop(ctx, keeper, msg)
+		case types.MsgBidSymbol:
+			return handleMsgBidSymbol(ctx, keeper, msg)
+		case types.MsgRevealSymbolBid:
+			return handleMsgRevealSymbolBid(ctx, keeper, msg)
+		case types.MsgAttestToken:
+			return handleMsgAttestToken(ctx, keeper, msg)
 		default:
-			return dex.ErrUnknownRequest(ModuleName, msg)
+			// the owner can't act alone once the token has an owner group,
+			// while the granted roles are still effective
+			if symbol, ok := types.GetOwnerGroupActionSymbol(msg); ok && !isActingByRole(ctx, keeper, symbol, msg) {
+				if _, found := keeper.GetOwnerGroup(ctx, symbol); found {
+					return types.ErrNeedOwnerGroupApproval(symbol).Result()
+				}
+			}
+			return handleTokenAction(ctx, keeper, msg)
 		}
 	}
 }
 
+// handleTokenAction - Handle the owner-only operations, which are signed by the owner or approved by the owner group
+func handleTokenAction(ctx sdk.Context, keeper Keeper, msg sdk.Msg) sdk.Result {
+	switch msg := msg.(type) {
+	case types.MsgTransferOwnership:
+		return handleMsgTransferOwnership(ctx, keeper, msg)
+	case types.MsgMintToken:
+		return handleMsgMintToken(ctx, keeper, msg)
+	case types.MsgBurnToken:
+		return handleMsgBurnToken(ctx, keeper, msg)
+	case types.MsgForbidToken:
+		return handleMsgForbidToken(ctx, keeper, msg)
+	case types.MsgUnForbidToken:
+		return handleMsgUnForbidToken(ctx, keeper, msg)
+	case types.MsgAddTokenWhitelist:
+		return handleMsgAddTokenWhitelist(ctx, keeper, msg)
+	case types.MsgRemoveTokenWhitelist:
+		return handleMsgRemoveTokenWhitelist(ctx, keeper, msg)
+	case types.MsgForbidAddr:
+		return handleMsgForbidAddr(ctx, keeper, msg)
+	case types.MsgUnForbidAddr:
+		return handleMsgUnForbidAddr(ctx, keeper, msg)
+	case types.MsgModifyTokenInfo:
+		return handleMsgModifyTokenInfo(ctx, keeper, msg)
+	case types.MsgSetOwnerGroup:
+		return handleMsgSetOwnerGroup(ctx, keeper, msg)
+	case types.MsgGrantTokenRole:
+		return handleMsgGrantTokenRole(ctx, keeper, msg)
+	case types.MsgRevokeTokenRole:
+		return handleMsgRevokeTokenRole(ctx, keeper, msg)
+	case types.MsgSetMintPolicy:
+		return handleMsgSetMintPolicy(ctx, keeper, msg)
+	case types.MsgClawback:
+		return handleMsgClawback(ctx, keeper, msg)
+	default:
+		return dex.ErrUnknownRequest(ModuleName, msg)
+	}
+}
+
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register(types.AirdropInfoKey, 1, types.AirdropInfo{})
+	reg.Register(types.ForbidExpiredInfoKey, 1, types.ForbidExpiredInfo{})
+	reg.Register(types.SymbolAuctionResultKey, 1, types.SymbolAuctionResult{})
+}
+
+func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
+	if keeper.IsSubscribed(types.Topic) {
+		msgqueue.FillMsgs(ctx, key, msg)
+	}
+}
+
+// isActingByRole - returns whether a signer other than the owner has been granted the role to perform msg
+func isActingByRole(ctx sdk.Context, keeper Keeper, symbol string, msg sdk.Msg) bool {
+	role := types.GetTokenActionRole(msg)
+	if len(role) == 0 {
+		return false
+	}
+	signer := msg.GetSigners()[0]
+	if token := keeper.GetToken(ctx, symbol); token == nil || token.GetOwner().Equals(signer) {
+		return false
+	}
+	_, found := keeper.GetTokenRole(ctx, symbol, role, signer)
+	return found
+}
+
+// getRoleOwner - returns the token owner if signer is the owner or has been granted the role.
+// The keeper methods check the owner, so the delegated operations are performed in the name of owner.
+func getRoleOwner(ctx sdk.Context, keeper Keeper, symbol string, signer sdk.AccAddress, role string) (sdk.AccAddress, sdk.Error) {
+	token := keeper.GetToken(ctx, symbol)
+	if token == nil {
+		return nil, types.ErrTokenNotFound(symbol)
+	}
+	if token.GetOwner().Equals(signer) {
+		return signer, nil
+	}
+	if _, found := keeper.GetTokenRole(ctx, symbol, role, signer); !found {
+		return nil, types.ErrNeedTokenOwner(token.GetOwner())
+	}
+	return token.GetOwner(), nil
+}
+
 // handleMsgIssueToken - Handle MsgIssueToken
 func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken) sdk.Result {
-	issueFee := keeper.GetParams(ctx).GetIssueTokenFee(msg.Symbol)
-
-	if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
-		return err.Result()
+	// the auction winner has paid the price instead of the issue fee
+	if !keeper.IsSymbolReservedFor(ctx, msg.Symbol, msg.Owner) {
+		issueFee := keeper.GetParams(ctx).GetIssueTokenFee(msg.Symbol)
+		if err := keeper.DeductIssueFee(ctx, msg.Owner, issueFee); err != nil {
+			return err.Result()
+		}
 	}
 
 	err := keeper.IssueToken(ctx, msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
@@ -59,6 +143,31 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken
 		return err.Result()
 	}
 
+	if msg.Decimals != "" {
+		if err := setTokenDecimals(ctx, keeper, msg.Symbol, msg.Owner, msg.Decimals); err != nil {
+			return err.Result()
+		}
+	}
+	if len(msg.DisplayUnits) != 0 {
+		if err := keeper.SetTokenDisplayUnits(ctx, msg.Symbol, msg.Owner, msg.DisplayUnits); err != nil {
+			return err.Result()
+		}
+	}
+	if msg.MintPolicy != nil {
+		if err := keeper.SetMintPolicy(ctx, msg.Symbol, msg.Owner, *msg.MintPolicy); err != nil {
+			return err.Result()
+		}
+	}
+	// clawbackable can only be set at issuance
+	if msg.Clawbackable {
+		token := keeper.GetToken(ctx, msg.Symbol)
+		token.SetClawbackable(true)
+		token.SetClawbackRecipient(msg.ClawbackRecipient)
+		if err := keeper.SetToken(ctx, token); err != nil {
+			return err.Result()
+		}
+	}
+
 	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, msg.Owner, types.NewTokenCoins(msg.Symbol, msg.TotalSupply)); err != nil {
 		return err.Result()
 	}
@@ -81,6 +190,34 @@ func handleMsgIssueToken(ctx sdk.Context, keeper Keeper, msg types.MsgIssueToken
 	}
 }
 
+// handleMsgAirdrop - Handle MsgAirdrop, a single summary is sent to msgqueue for all the recipients
+func handleMsgAirdrop(ctx sdk.Context, keeper Keeper, msg types.MsgAirdrop) sdk.Result {
+	info, err := keeper.Airdrop(ctx, msg.Symbol, msg.OwnerAddress, msg.GetOutputs())
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, keeper, types.AirdropInfoKey, info)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeAirdrop,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, info.TotalAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipients, strconv.Itoa(info.RecipientCount)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 // handleMsgTransferOwnership - Handle MsgTransferOwnership
 func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferOwnership) sdk.Result {
 	if err := keeper.TransferOwnership(ctx, msg.Symbol, msg.OriginalOwner, msg.NewOwner); err != nil {
@@ -107,7 +244,17 @@ func handleMsgTransferOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTra
 
 // handleMsgMintToken - Handle MsgMintToken
 func handleMsgMintToken(ctx sdk.Context, keeper Keeper, msg types.MsgMintToken) sdk.Result {
-	if err := keeper.MintToken(ctx, msg.Symbol, msg.OwnerAddress, msg.Amount); err != nil {
+	owner, err := getRoleOwner(ctx, keeper, msg.Symbol, msg.OwnerAddress, types.RoleMinter)
+	if err != nil {
+		return err.Result()
+	}
+	if !owner.Equals(msg.OwnerAddress) {
+		if err := keeper.UseMintAllowance(ctx, msg.Symbol, msg.OwnerAddress, msg.Amount); err != nil {
+			return err.Result()
+		}
+	}
+
+	if err := keeper.MintToken(ctx, msg.Symbol, owner, msg.Amount); err != nil {
 		return err.Result()
 	}
 	if err := keeper.SendCoinsFromAssetModuleToAccount(ctx, msg.OwnerAddress, types.NewTokenCoins(msg.Symbol, msg.Amount)); err != nil {
@@ -260,7 +407,17 @@ func handleMsgRemoveTokenWhitelist(ctx sdk.Context, keeper Keeper, msg types.Msg
 
 // handleMsgForbidAddr - Handle MsgForbidAddr
 func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr) (res sdk.Result) {
-	if err := keeper.ForbidAddress(ctx, msg.Symbol, msg.OwnerAddr, msg.Addresses); err != nil {
+	owner, err := getRoleOwner(ctx, keeper, msg.Symbol, msg.OwnerAddr, types.RoleAddrForbidder)
+	if err != nil {
+		return err.Result()
+	}
+	for _, addr := range msg.Addresses {
+		if addr.Equals(owner) {
+			return types.ErrTokenOwnerSelfForbidden().Result()
+		}
+	}
+
+	if err := keeper.ForbidAddressUntil(ctx, msg.Symbol, owner, msg.Addresses, msg.ExpireTime); err != nil {
 		return err.Result()
 	}
 
@@ -278,6 +435,7 @@ func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr
 		sdk.NewEvent(types.EventTypeForbidAddr,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, strconv.FormatInt(msg.ExpireTime, 10)),
 		),
 	})
 
@@ -288,7 +446,12 @@ func handleMsgForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgForbidAddr
 
 // handleMsgUnForbidAddr - Handle MsgUnForbidAddr
 func handleMsgUnForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbidAddr) (res sdk.Result) {
-	if err := keeper.UnForbidAddress(ctx, msg.Symbol, msg.OwnerAddr, msg.Addresses); err != nil {
+	owner, err := getRoleOwner(ctx, keeper, msg.Symbol, msg.OwnerAddr, types.RoleAddrForbidder)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := keeper.UnForbidAddress(ctx, msg.Symbol, owner, msg.Addresses); err != nil {
 		return err.Result()
 	}
 
@@ -316,10 +479,20 @@ func handleMsgUnForbidAddr(ctx sdk.Context, keeper Keeper, msg types.MsgUnForbid
 
 // handleMsgModifyTokenInfo - Handle MsgModifyTokenInfo
 func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModifyTokenInfo) sdk.Result {
-	token := keeper.GetToken(ctx, msg.Symbol)
-	if token == nil {
-		return types.ErrTokenNotFound(msg.Symbol).Result()
+	owner, err := getRoleOwner(ctx, keeper, msg.Symbol, msg.OwnerAddress, types.RoleInfoEditor)
+	if err != nil {
+		return err.Result()
+	}
+	// the info editor can only modify url, description, identity and name
+	if !owner.Equals(msg.OwnerAddress) {
+		for _, val := range []string{msg.TotalSupply, msg.Mintable, msg.Burnable, msg.AddrForbiddable,
+			msg.TokenForbiddable, msg.Decimals, msg.DisplayUnits} {
+			if types.IsTokenInfoModified(val) {
+				return types.ErrNeedTokenOwner(owner).Result()
+			}
+		}
 	}
+	token := keeper.GetToken(ctx, msg.Symbol)
 
 	newURL, newDesc, newID, newName, newSupply,
 		newMintable, newBurnable, newAddrForbiddable, newTokenForbiddable,
@@ -328,13 +501,28 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 		return err.Result()
 	}
 
-	if err := keeper.ModifyTokenInfo(ctx, msg.Symbol, msg.OwnerAddress,
+	if err := keeper.ModifyTokenInfo(ctx, msg.Symbol, owner,
 		newURL, newDesc, newID, newName, newSupply,
 		newMintable, newBurnable, newAddrForbiddable, newTokenForbiddable); err != nil {
 
 		return err.Result()
 	}
 
+	if types.IsTokenInfoModified(msg.Decimals) {
+		if err := setTokenDecimals(ctx, keeper, msg.Symbol, owner, msg.Decimals); err != nil {
+			return err.Result()
+		}
+	}
+	if types.IsTokenInfoModified(msg.DisplayUnits) {
+		units, err := types.ParseDisplayUnits(msg.DisplayUnits)
+		if err != nil {
+			return err.Result()
+		}
+		if err := keeper.SetTokenDisplayUnits(ctx, msg.Symbol, owner, units); err != nil {
+			return err.Result()
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -353,6 +541,187 @@ func handleMsgModifyTokenInfo(ctx sdk.Context, keeper Keeper, msg types.MsgModif
 	}
 }
 
+// handleMsgSetOwnerGroup - Handle MsgSetOwnerGroup
+func handleMsgSetOwnerGroup(ctx sdk.Context, keeper Keeper, msg types.MsgSetOwnerGroup) sdk.Result {
+	if err := keeper.SetOwnerGroup(ctx, msg.Symbol, msg.OwnerAddress, msg.Members, msg.Threshold); err != nil {
+		return err.Result()
+	}
+
+	var str string
+	for _, addr := range msg.Members {
+		str = str + addr.String() + ","
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetOwnerGroup,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddrList, str),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgGrantTokenRole - Handle MsgGrantTokenRole
+func handleMsgGrantTokenRole(ctx sdk.Context, keeper Keeper, msg types.MsgGrantTokenRole) sdk.Result {
+	if err := keeper.GrantTokenRole(ctx, msg.Symbol, msg.OwnerAddress, msg.Grantee, msg.Role, msg.Allowance); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeGrantTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, msg.Allowance.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgRevokeTokenRole - Handle MsgRevokeTokenRole
+func handleMsgRevokeTokenRole(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeTokenRole) sdk.Result {
+	if err := keeper.RevokeTokenRole(ctx, msg.Symbol, msg.OwnerAddress, msg.Grantee, msg.Role); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevokeTokenRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSetMintPolicy - Handle MsgSetMintPolicy
+func handleMsgSetMintPolicy(ctx sdk.Context, keeper Keeper, msg types.MsgSetMintPolicy) sdk.Result {
+	if err := keeper.SetMintPolicy(ctx, msg.Symbol, msg.OwnerAddress, msg.MintPolicy); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetMintPolicy,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMintPolicy, msg.MintPolicy.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgProposeTokenAction - Handle MsgProposeTokenAction
+func handleMsgProposeTokenAction(ctx sdk.Context, keeper Keeper, msg types.MsgProposeTokenAction) sdk.Result {
+	proposal, err := keeper.ProposeTokenAction(ctx, msg.Symbol, msg.Proposer, msg.Action)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeProposeTokenAction,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionType, msg.Action.Type()),
+		),
+	})
+	return executeIfApproved(ctx, keeper, proposal)
+}
+
+// handleMsgApproveTokenAction - Handle MsgApproveTokenAction
+func handleMsgApproveTokenAction(ctx sdk.Context, keeper Keeper, msg types.MsgApproveTokenAction) sdk.Result {
+	proposal, err := keeper.ApproveTokenAction(ctx, msg.Symbol, msg.ProposalID, msg.Approver)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeApproveTokenAction,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(proposal.Approvals))),
+		),
+	})
+	return executeIfApproved(ctx, keeper, proposal)
+}
+
+// executeIfApproved - the proposal is removed and its action is executed once it has enough approvals
+func executeIfApproved(ctx sdk.Context, keeper Keeper, proposal types.TokenActionProposal) sdk.Result {
+	if !keeper.IsProposalApproved(ctx, proposal) {
+		return sdk.Result{
+			Events: ctx.EventManager().Events(),
+		}
+	}
+
+	keeper.DeleteTokenActionProposal(ctx, proposal.Symbol, proposal.ID)
+	if res := handleTokenAction(ctx, keeper, proposal.Action); !res.IsOK() {
+		return res
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteTokenAction,
+			sdk.NewAttribute(types.AttributeKeySymbol, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionType, proposal.Action.Type()),
+		),
+	)
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func setTokenDecimals(ctx sdk.Context, keeper Keeper, symbol string, owner sdk.AccAddress, decimalsStr string) sdk.Error {
+	decimals, err := types.ParseDecimals(decimalsStr)
+	if err != nil {
+		return err
+	}
+	return keeper.SetTokenDecimals(ctx, symbol, owner, decimals)
+}
+
 func CollectTokenModificationInfo(token types.Token, msg types.MsgModifyTokenInfo) (
 	newURL, newDesc, newID, newName string, newSupply sdk.Int,
 	newMintable, newBurnable, newAddrForbiddable, newTokenForbiddable bool,
@@ -410,3 +779,104 @@ func getNewIntVal(newVal string, oldVal sdk.Int) (sdk.Int, error) {
 	}
 	return n, nil
 }
+
+// handleMsgClawback - Handle MsgClawback
+func handleMsgClawback(ctx sdk.Context, keeper Keeper, msg types.MsgClawback) sdk.Result {
+	if err := keeper.Clawback(ctx, msg.Symbol, msg.OwnerAddress, msg.From, msg.Amount); err != nil {
+		return err.Result()
+	}
+	to := keeper.GetToken(ctx, msg.Symbol).GetClawbackRecipient()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyTo, to.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgBidSymbol - Handle MsgBidSymbol
+func handleMsgBidSymbol(ctx sdk.Context, keeper Keeper, msg types.MsgBidSymbol) sdk.Result {
+	auction, err := keeper.BidSymbol(ctx, msg.Symbol, msg.Bidder, msg.SealedBid, msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeBidSymbol,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, msg.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, strconv.FormatInt(auction.RevealEndTime, 10)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgRevealSymbolBid - Handle MsgRevealSymbolBid
+func handleMsgRevealSymbolBid(ctx sdk.Context, keeper Keeper, msg types.MsgRevealSymbolBid) sdk.Result {
+	if err := keeper.RevealSymbolBid(ctx, msg.Symbol, msg.Bidder, msg.Amount, msg.Salt); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevealSymbolBid,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgAttestToken - Handle MsgAttestToken
+func handleMsgAttestToken(ctx sdk.Context, keeper Keeper, msg types.MsgAttestToken) sdk.Result {
+	if err := keeper.AttestToken(ctx, msg.Symbol, msg.Verifier, msg.Claim); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Verifier.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeAttestToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyVerifier, msg.Verifier.String()),
+			sdk.NewAttribute(types.AttributeKeyClaim, msg.Claim),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -3,12 +3,16 @@ package asset_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -141,6 +145,530 @@ func Test_handleMsg(t *testing.T) {
 	}
 }
 
+func Test_TokenDecimals(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+
+	// issue without decimals, which can be set later only once
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	require.True(t, h(input.ctx, issue).IsOK())
+	require.False(t, input.tk.GetToken(input.ctx, "abc").IsDecimalsSet())
+
+	modify := asset.NewMsgModifyTokenInfo("abc", types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, testAddr,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	modify.Decimals = "6"
+	modify.DisplayUnits = "uabc:0,abc:6"
+	require.NoError(t, modify.ValidateBasic())
+	require.True(t, h(input.ctx, modify).IsOK())
+	token := input.tk.GetToken(input.ctx, "abc")
+	require.EqualValues(t, 6, token.GetDecimals())
+	require.Equal(t, []asset.DisplayUnit{{Denom: "uabc", Exponent: 0}, {Denom: "abc", Exponent: 6}}, token.GetDisplayUnits())
+
+	modify.Decimals = "8"
+	modify.DisplayUnits = types.DoNotModifyTokenInfo
+	res := h(input.ctx, modify)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeTokenInfoSealed, res.Code)
+	modify.Decimals = ""
+	modify.DisplayUnits = "mabc:3"
+	require.Equal(t, types.CodeTokenInfoSealed, h(input.ctx, modify).Code)
+
+	// issue with decimals
+	issue = asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	issue.Decimals = "2"
+	issue.DisplayUnits = []asset.DisplayUnit{{Denom: "xyz", Exponent: 2}}
+	require.True(t, h(input.ctx, issue).IsOK())
+	token = input.tk.GetToken(input.ctx, "xyz")
+	require.True(t, token.IsDecimalsSet())
+	require.Equal(t, "21", asset.NewTokenDisplay(token).TotalSupply)
+}
+
+func Test_OwnerGroup(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	require.True(t, h(input.ctx, issue).IsOK())
+
+	_, _, member1 := keyPubAddr()
+	_, _, member2 := keyPubAddr()
+	setGroup := asset.NewMsgSetOwnerGroup("abc", testAddr, []sdk.AccAddress{testAddr, member1, member2}, 2)
+	require.NoError(t, setGroup.ValidateBasic())
+	require.True(t, h(input.ctx, setGroup).IsOK())
+
+	// the owner can't mint alone any more
+	mint := asset.NewMsgMintToken("abc", sdk.NewInt(100), testAddr)
+	res := h(input.ctx, mint)
+	require.Equal(t, types.CodeNeedOwnerGroupApproval, res.Code)
+
+	// only members can propose
+	_, _, outsider := keyPubAddr()
+	propose := asset.NewMsgProposeTokenAction("abc", outsider, mint)
+	require.NoError(t, propose.ValidateBasic())
+	require.Equal(t, types.CodeNotOwnerGroupMember, h(input.ctx, propose).Code)
+
+	propose = asset.NewMsgProposeTokenAction("abc", member1, mint)
+	require.True(t, h(input.ctx, propose).IsOK())
+	proposals := input.tk.GetTokenActionProposals(input.ctx, "abc")
+	require.Equal(t, 1, len(proposals))
+	require.Equal(t, sdk.Msg(mint), proposals[0].Action)
+	require.Equal(t, "2100", input.tk.GetToken(input.ctx, "abc").GetTotalSupply().String())
+
+	approve := asset.NewMsgApproveTokenAction("abc", proposals[0].ID, member1)
+	require.Equal(t, types.CodeDuplicateApproval, h(input.ctx, approve).Code)
+
+	// the second approval executes the action
+	approve = asset.NewMsgApproveTokenAction("abc", proposals[0].ID, member2)
+	require.True(t, h(input.ctx, approve).IsOK())
+	require.Equal(t, "2200", input.tk.GetToken(input.ctx, "abc").GetTotalSupply().String())
+	require.Equal(t, 0, len(input.tk.GetTokenActionProposals(input.ctx, "abc")))
+	require.Equal(t, types.CodeProposalNotFound, h(input.ctx, approve).Code)
+
+	// the group is removed by itself
+	removeGroup := asset.NewMsgSetOwnerGroup("abc", testAddr, nil, 0)
+	require.NoError(t, removeGroup.ValidateBasic())
+	propose = asset.NewMsgProposeTokenAction("abc", testAddr, removeGroup)
+	require.True(t, h(input.ctx, propose).IsOK())
+	_, found := input.tk.GetOwnerGroup(input.ctx, "abc")
+	require.True(t, found)
+	proposals = input.tk.GetTokenActionProposals(input.ctx, "abc")
+	require.True(t, h(input.ctx, asset.NewMsgApproveTokenAction("abc", proposals[0].ID, member2)).IsOK())
+	_, found = input.tk.GetOwnerGroup(input.ctx, "abc")
+	require.False(t, found)
+	require.True(t, h(input.ctx, mint).IsOK())
+}
+
+func Test_TokenRoles(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	require.True(t, h(input.ctx, issue).IsOK())
+
+	_, _, staff := keyPubAddr()
+	_, _, holder := keyPubAddr()
+	mint := asset.NewMsgMintToken("abc", sdk.NewInt(60), staff)
+	require.Equal(t, types.CodeNeedTokenOwner, h(input.ctx, mint).Code)
+
+	// the minter mints within its allowance
+	grant := asset.NewMsgGrantTokenRole("abc", testAddr, staff, asset.RoleMinter, sdk.NewInt(100))
+	require.NoError(t, grant.ValidateBasic())
+	require.True(t, h(input.ctx, grant).IsOK())
+	require.True(t, h(input.ctx, mint).IsOK())
+	require.Equal(t, "60", input.tk.GetAccTotalToken(input.ctx, staff).AmountOf("abc").String())
+	require.Equal(t, types.CodeMintAllowanceExceeded, h(input.ctx, mint).Code)
+	mint.Amount = sdk.NewInt(40)
+	require.True(t, h(input.ctx, mint).IsOK())
+	_, found := input.tk.GetTokenRole(input.ctx, "abc", asset.RoleMinter, staff)
+	require.False(t, found)
+	require.Equal(t, "2200", input.tk.GetToken(input.ctx, "abc").GetTotalSupply().String())
+
+	// the forbidder can't forbid the owner
+	grant = asset.NewMsgGrantTokenRole("abc", testAddr, staff, asset.RoleAddrForbidder, sdk.ZeroInt())
+	require.True(t, h(input.ctx, grant).IsOK())
+	forbid := asset.NewMsgForbidAddr("abc", staff, []sdk.AccAddress{holder})
+	require.True(t, h(input.ctx, forbid).IsOK())
+	require.True(t, input.tk.IsForbiddenByTokenIssuer(input.ctx, "abc", holder))
+	forbid = asset.NewMsgForbidAddr("abc", staff, []sdk.AccAddress{testAddr})
+	require.Equal(t, types.CodeTokenOwnerSelfForbidden, h(input.ctx, forbid).Code)
+	require.True(t, h(input.ctx, asset.NewMsgUnForbidAddr("abc", staff, []sdk.AccAddress{holder})).IsOK())
+
+	// the info editor can't modify the supply
+	grant = asset.NewMsgGrantTokenRole("abc", testAddr, staff, asset.RoleInfoEditor, sdk.ZeroInt())
+	require.True(t, h(input.ctx, grant).IsOK())
+	modify := asset.NewMsgModifyTokenInfo("abc", "www.abc.org", types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, staff,
+		types.DoNotModifyTokenInfo, "3000", types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	require.Equal(t, types.CodeNeedTokenOwner, h(input.ctx, modify).Code)
+	modify.TotalSupply = types.DoNotModifyTokenInfo
+	require.True(t, h(input.ctx, modify).IsOK())
+	require.Equal(t, "www.abc.org", input.tk.GetToken(input.ctx, "abc").GetURL())
+
+	// the owner can't be granted a role
+	grant = asset.NewMsgGrantTokenRole("abc", testAddr, testAddr, asset.RoleMinter, sdk.ZeroInt())
+	require.Equal(t, types.CodeInvalidTokenRole, h(input.ctx, grant).Code)
+
+	// the roles granted by the owner alone are revoked with the first owner group
+	setGroup := asset.NewMsgSetOwnerGroup("abc", testAddr, []sdk.AccAddress{testAddr, holder}, 2)
+	require.True(t, h(input.ctx, setGroup).IsOK())
+	require.Equal(t, 0, len(input.tk.GetTokenRoles(input.ctx, "abc")))
+	modify.URL = "www.abc.com"
+	require.Equal(t, types.CodeNeedOwnerGroupApproval, h(input.ctx, modify).Code)
+
+	// the roles approved by the group are effective
+	grant = asset.NewMsgGrantTokenRole("abc", testAddr, staff, asset.RoleInfoEditor, sdk.ZeroInt())
+	require.Equal(t, types.CodeNeedOwnerGroupApproval, h(input.ctx, grant).Code)
+	require.True(t, h(input.ctx, asset.NewMsgProposeTokenAction("abc", testAddr, grant)).IsOK())
+	proposals := input.tk.GetTokenActionProposals(input.ctx, "abc")
+	require.True(t, h(input.ctx, asset.NewMsgApproveTokenAction("abc", proposals[0].ID, holder)).IsOK())
+	require.True(t, h(input.ctx, modify).IsOK())
+	revoke := asset.NewMsgRevokeTokenRole("abc", testAddr, staff, asset.RoleInfoEditor)
+	require.Equal(t, types.CodeNeedOwnerGroupApproval, h(input.ctx, revoke).Code)
+	require.True(t, h(input.ctx, asset.NewMsgProposeTokenAction("abc", testAddr, revoke)).IsOK())
+	proposals = input.tk.GetTokenActionProposals(input.ctx, "abc")
+	require.True(t, h(input.ctx, asset.NewMsgApproveTokenAction("abc", proposals[0].ID, holder)).IsOK())
+	require.Equal(t, types.CodeNeedOwnerGroupApproval, h(input.ctx, modify).Code)
+}
+
+func Test_MintPolicy(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// the policy is set at issuance
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, true, true, true, "", "", types.TestIdentityString)
+	policy := asset.NewMintPolicy(sdk.NewInt(100), 3600, sdk.NewInt(3000), nil)
+	issue.MintPolicy = &policy
+	require.Equal(t, types.CodeTokenMintNotSupported, issue.ValidateBasic().Code())
+	issue.Mintable = true
+	require.NoError(t, issue.ValidateBasic())
+	require.True(t, h(ctx, issue).IsOK())
+	require.Equal(t, policy.String(), input.tk.GetToken(ctx, "abc").GetMintPolicy().String())
+
+	mint := asset.NewMsgMintToken("abc", sdk.NewInt(101), testAddr)
+	require.Equal(t, types.CodeMintPolicyViolated, h(ctx, mint).Code)
+	mint.Amount = sdk.NewInt(100)
+	require.True(t, h(ctx, mint).IsOK())
+
+	// the supply can't be modified beyond the cap
+	modify := asset.NewMsgModifyTokenInfo("abc", types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, testAddr,
+		types.DoNotModifyTokenInfo, "3001", types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	require.Equal(t, types.CodeMintPolicyViolated, h(ctx, modify).Code)
+
+	// can only be tightened
+	setPolicy := asset.NewMsgSetMintPolicy("abc", testAddr, asset.NewMintPolicy(sdk.ZeroInt(), 0, sdk.NewInt(3000), nil))
+	require.NoError(t, setPolicy.ValidateBasic())
+	require.Equal(t, types.CodeInvalidMintPolicy, h(ctx, setPolicy).Code)
+	setPolicy.MintPolicy.MaxPerPeriod, setPolicy.MintPolicy.Period = sdk.NewInt(10), 7200
+	require.True(t, h(ctx, setPolicy).IsOK())
+	ctx = ctx.WithBlockTime(time.Unix(1000+3600, 0))
+	require.Equal(t, types.CodeMintPolicyViolated, h(ctx, mint).Code)
+}
+
+func Test_Airdrop(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.True(t, h(input.ctx, issue).IsOK())
+
+	_, _, existing := keyPubAddr()
+	_, _, fresh1 := keyPubAddr()
+	_, _, fresh2 := keyPubAddr()
+	require.NoError(t, input.tk.AddToken(input.ctx, existing, dex.NewCetCoins(1)))
+	recipients := []sdk.AccAddress{existing, fresh1, fresh2, fresh1}
+
+	airdrop := asset.NewMsgAirdrop("abc", existing, recipients, []sdk.Int{sdk.NewInt(100)})
+	require.Equal(t, types.CodeNeedTokenOwner, h(input.ctx, airdrop).Code)
+	airdrop = asset.NewMsgAirdrop("abc", testAddr, recipients, []sdk.Int{sdk.NewInt(1000)})
+	require.Equal(t, sdk.CodeInsufficientCoins, h(input.ctx, airdrop).Code)
+
+	cetBefore := input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf(dex.CET)
+	airdrop.Amounts = []sdk.Int{sdk.NewInt(100)}
+	res := h(input.ctx, airdrop)
+	require.True(t, res.IsOK())
+	require.Equal(t, "1700", input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf("abc").String())
+	require.Equal(t, "200", input.tk.GetAccTotalToken(input.ctx, fresh1).AmountOf("abc").String())
+	require.Equal(t, "100", input.tk.GetAccTotalToken(input.ctx, fresh2).AmountOf("abc").String())
+
+	// the owner pays the activation fees of the two fresh accounts
+	cetAfter := input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf(dex.CET)
+	require.Equal(t, sdk.NewInt(2*bankx.DefaultParams().ActivationFee), cetBefore.Sub(cetAfter))
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeAirdrop, event.Type)
+	require.Equal(t, "4", string(event.Attributes[2].Value))
+
+	// a recipient which only accepts coins from its whitelist gets nothing
+	_, err = input.bkx.SetTransferRestrictions(input.ctx, existing,
+		authx.NewTransferRestrictions([]sdk.AccAddress{fresh1}, nil, nil, 0))
+	require.Nil(t, err)
+	res = h(input.ctx, airdrop)
+	require.Equal(t, bankx.CodeTransferNotWhitelisted, res.Code)
+	require.Equal(t, "1700", input.tk.GetAccTotalToken(input.ctx, testAddr).AmountOf("abc").String())
+}
+
+func Test_ForbidAddrExpiry(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, true, false, "", "", types.TestIdentityString)
+	require.True(t, h(ctx, issue).IsOK())
+
+	_, _, addr1 := keyPubAddr()
+	_, _, addr2 := keyPubAddr()
+	forbid := asset.NewMsgForbidAddr("abc", testAddr, []sdk.AccAddress{addr1})
+	forbid.ExpireTime = -1
+	require.Equal(t, types.CodeInvalidForbidExpireTime, forbid.ValidateBasic().Code())
+	forbid.ExpireTime = 1000
+	require.Equal(t, types.CodeInvalidForbidExpireTime, h(ctx, forbid).Code)
+	forbid.ExpireTime = 2000
+	require.True(t, h(ctx, forbid).IsOK())
+	forbid = asset.NewMsgForbidAddr("abc", testAddr, []sdk.AccAddress{addr2})
+	forbid.ExpireTime = 3000
+	require.True(t, h(ctx, forbid).IsOK())
+
+	ctx = ctx.WithBlockTime(time.Unix(1999, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.IsForbiddenByTokenIssuer(ctx, "abc", addr1))
+
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.False(t, input.tk.IsForbiddenByTokenIssuer(ctx, "abc", addr1))
+	require.True(t, input.tk.IsForbiddenByTokenIssuer(ctx, "abc", addr2))
+	require.Equal(t, 1, len(input.tk.GetForbidExpiries(ctx)))
+
+	// a permanent forbid replaces the expiry
+	forbid.ExpireTime = 0
+	require.True(t, h(ctx, forbid).IsOK())
+	require.Equal(t, 0, len(input.tk.GetForbidExpiries(ctx)))
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.True(t, input.tk.IsForbiddenByTokenIssuer(ctx, "abc", addr2))
+}
+
+func Test_Clawback(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	err := input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18))
+	require.NoError(t, err)
+	_, _, holder := keyPubAddr()
+	_, _, recovery := keyPubAddr()
+
+	// only for addr forbiddable token
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	issue.Clawbackable = true
+	issue.ClawbackRecipient = recovery
+	require.Equal(t, types.CodeClawbackNotSupported, issue.ValidateBasic().Code())
+	issue.AddrForbiddable = true
+	// the recovery address is mandatory
+	issue.ClawbackRecipient = nil
+	require.Equal(t, types.CodeInvalidClawback, issue.ValidateBasic().Code())
+	issue.ClawbackRecipient = recovery
+	require.True(t, h(input.ctx, issue).IsOK())
+	token := input.tk.GetToken(input.ctx, "abc")
+	require.True(t, token.GetClawbackable())
+	require.Equal(t, recovery, token.GetClawbackRecipient())
+	issue = asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(2100), testAddr,
+		false, false, true, false, "", "", types.TestIdentityString)
+	issue.ClawbackRecipient = recovery
+	require.Equal(t, types.CodeInvalidClawback, issue.ValidateBasic().Code())
+	issue.ClawbackRecipient = nil
+	require.True(t, h(input.ctx, issue).IsOK())
+
+	require.NoError(t, input.tk.AddToken(input.ctx, holder, sdk.NewCoins(sdk.NewInt64Coin("abc", 500), sdk.NewInt64Coin("xyz", 500))))
+
+	clawback := asset.NewMsgClawback("abc", testAddr, holder, sdk.NewInt(300), "")
+	require.Equal(t, types.CodeInvalidClawback, clawback.ValidateBasic().Code())
+	clawback.Memo = "court order 123"
+	require.NoError(t, clawback.ValidateBasic())
+	require.Equal(t, types.CodeInvalidClawback, h(input.ctx, clawback).Code)
+
+	forbid := asset.NewMsgForbidAddr("abc", testAddr, []sdk.AccAddress{holder})
+	require.True(t, h(input.ctx, forbid).IsOK())
+	res := h(input.ctx, clawback)
+	require.True(t, res.IsOK())
+	require.Equal(t, "200", input.tk.GetAccTotalToken(input.ctx, holder).AmountOf("abc").String())
+	require.Equal(t, "300", input.tk.GetAccTotalToken(input.ctx, recovery).AmountOf("abc").String())
+	event := res.Events[len(res.Events)-1]
+	require.Equal(t, types.EventTypeClawback, event.Type)
+	require.Equal(t, recovery.String(), string(event.Attributes[2].Value))
+	require.Equal(t, "court order 123", string(event.Attributes[4].Value))
+
+	// not clawbackable
+	forbid.Symbol = "xyz"
+	require.True(t, h(input.ctx, forbid).IsOK())
+	clawback.Symbol = "xyz"
+	require.Equal(t, types.CodeClawbackNotSupported, h(input.ctx, clawback).Code)
+}
+
+func Test_SymbolAuction(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	params := input.tk.GetParams(input.ctx)
+	params.SymbolAuctionEnabled = true
+	params.AuctionBiddingPeriod = 100
+	params.AuctionRevealPeriod = 50
+	input.tk.SetParams(input.ctx, params)
+
+	_, _, a := keyPubAddr()
+	_, _, b := keyPubAddr()
+	_, _, c := keyPubAddr()
+	_, _, d := keyPubAddr()
+	for _, addr := range []sdk.AccAddress{a, b, c, d} {
+		require.NoError(t, input.tk.AddToken(input.ctx, addr, dex.NewCetCoins(1e13)))
+	}
+	bid := func(bidder sdk.AccAddress, amount, deposit int64) asset.MsgBidSymbol {
+		sealed := asset.SealSymbolBid("xyz", bidder, sdk.NewInt(amount), "salt")
+		return asset.NewMsgBidSymbol("xyz", bidder, sealed, sdk.NewInt(deposit))
+	}
+
+	// short symbols can only be issued by the auction winners
+	issue := asset.NewMsgIssueToken("XYZ Token", "xyz", sdk.NewInt(2100), d,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.Equal(t, types.CodeSymbolReserved, h(input.ctx, issue).Code)
+
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	require.Equal(t, types.CodeInvalidSymbolAuction, h(ctx, bid(a, 3e11, 5e10)).Code)
+	require.True(t, h(ctx, bid(a, 3e11, 5e11)).IsOK())
+	require.True(t, h(ctx, bid(b, 2e11, 2e11)).IsOK())
+	require.True(t, h(ctx, bid(c, 4e11, 4e11)).IsOK())
+	require.Equal(t, types.CodeInvalidSymbolAuction, h(ctx, bid(c, 4e11, 4e11)).Code)
+	require.Equal(t, types.CodeSymbolInAuction, h(ctx, issue).Code)
+
+	reveal := asset.NewMsgRevealSymbolBid("xyz", a, sdk.NewInt(3e11), "salt")
+	require.Equal(t, types.CodeInvalidSymbolAuction, h(ctx, reveal).Code)
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.Equal(t, types.CodeInvalidSymbolAuction, h(ctx, bid(c, 4e11, 4e11)).Code)
+	require.Equal(t, types.CodeInvalidSymbolAuction,
+		h(ctx, asset.NewMsgRevealSymbolBid("xyz", a, sdk.NewInt(3e11), "wrong")).Code)
+	require.True(t, h(ctx, reveal).IsOK())
+	require.Equal(t, types.CodeInvalidSymbolAuction, h(ctx, reveal).Code)
+	require.True(t, h(ctx, asset.NewMsgRevealSymbolBid("xyz", b, sdk.NewInt(2e11), "salt")).IsOK())
+
+	ctx = ctx.WithBlockTime(time.Unix(1149, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, 1, len(input.tk.GetSymbolAuctions(ctx)))
+	ctx = ctx.WithBlockTime(time.Unix(1150, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, 0, len(input.tk.GetSymbolAuctions(ctx)))
+
+	// the winner pays its bid, the revealed bid is refunded and the unrevealed deposit is forfeited
+	require.Equal(t, sdk.NewInt(1e13-3e11).String(), input.tk.GetAccTotalToken(ctx, a).AmountOf("cet").String())
+	require.Equal(t, sdk.NewInt(1e13).String(), input.tk.GetAccTotalToken(ctx, b).AmountOf("cet").String())
+	require.Equal(t, sdk.NewInt(1e13-4e11), input.tk.GetAccTotalToken(ctx, c).AmountOf("cet"))
+	reservation, found := input.tk.GetSymbolReservation(ctx, "xyz")
+	require.True(t, found)
+	require.Equal(t, a, reservation.Owner)
+	require.Equal(t, sdk.NewInt(3e11), reservation.Price)
+
+	// the winner issues the token without the issue fee
+	require.Equal(t, types.CodeSymbolReserved, h(ctx, issue).Code)
+	issue.Owner = a
+	require.True(t, h(ctx, issue).IsOK())
+	require.Equal(t, sdk.NewInt(1e13-3e11), input.tk.GetAccTotalToken(ctx, a).AmountOf("cet"))
+	_, found = input.tk.GetSymbolReservation(ctx, "xyz")
+	require.False(t, found)
+}
+
+func Test_SymbolAuctionSettlementAndReservationExpiry(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	params := input.tk.GetParams(input.ctx)
+	params.SymbolAuctionEnabled = true
+	params.AuctionBiddingPeriod = 100
+	params.AuctionRevealPeriod = 50
+	input.tk.SetParams(input.ctx, params)
+
+	_, _, a := keyPubAddr()
+	require.NoError(t, input.tk.AddToken(input.ctx, a, dex.NewCetCoins(1e13)))
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	sealed := asset.SealSymbolBid("xyz", a, sdk.NewInt(3e11), "salt")
+	require.True(t, h(ctx, asset.NewMsgBidSymbol("xyz", a, sealed, sdk.NewInt(5e11))).IsOK())
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.True(t, h(ctx, asset.NewMsgRevealSymbolBid("xyz", a, sdk.NewInt(3e11), "salt")).IsOK())
+
+	// the auction stays queued when it can not be settled
+	moduleAddr := supply.NewModuleAddress(asset.ModuleName)
+	require.NoError(t, input.bkx.SubtractCoins(ctx, moduleAddr, dex.NewCetCoins(5e11)))
+	ctx = ctx.WithBlockTime(time.Unix(1150, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, 1, len(input.tk.GetSymbolAuctions(ctx)))
+	_, found := input.tk.GetSymbolReservation(ctx, "xyz")
+	require.False(t, found)
+
+	require.NoError(t, input.bkx.AddCoins(ctx, moduleAddr, dex.NewCetCoins(5e11)))
+	ctx = ctx.WithBlockTime(time.Unix(1151, 0))
+	asset.EndBlocker(ctx, input.tk)
+	require.Equal(t, 0, len(input.tk.GetSymbolAuctions(ctx)))
+	reservation, found := input.tk.GetSymbolReservation(ctx, "xyz")
+	require.True(t, found)
+	require.Equal(t, int64(1151+asset.SymbolReservationPeriod), reservation.ExpireTime)
+
+	// the symbol can be auctioned again if the winner does not issue it in time
+	asset.EndBlocker(ctx.WithBlockTime(time.Unix(reservation.ExpireTime-1, 0)), input.tk)
+	_, found = input.tk.GetSymbolReservation(ctx, "xyz")
+	require.True(t, found)
+	ctx = ctx.WithBlockTime(time.Unix(reservation.ExpireTime, 0))
+	asset.EndBlocker(ctx, input.tk)
+	_, found = input.tk.GetSymbolReservation(ctx, "xyz")
+	require.False(t, found)
+	require.True(t, h(ctx, asset.NewMsgBidSymbol("xyz", a, sealed, sdk.NewInt(5e11))).IsOK())
+}
+
+func Test_TokenInfoHistoryAndAttestation(t *testing.T) {
+	input := createTestInput()
+	h := asset.NewHandler(input.tk)
+	require.NoError(t, input.tk.AddToken(input.ctx, testAddr, dex.NewCetCoins(1e18)))
+
+	ctx := input.ctx.WithBlockHeight(10)
+	issue := asset.NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "www.abc.org", "", types.TestIdentityString)
+	require.True(t, h(ctx, issue).IsOK())
+
+	// only the changes of the info are recorded
+	ctx = ctx.WithBlockHeight(20)
+	modify := asset.NewMsgModifyTokenInfo("abc", "www.abc.com", types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, testAddr,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	require.True(t, h(ctx, modify).IsOK())
+	ctx = ctx.WithBlockHeight(30)
+	modify = asset.NewMsgModifyTokenInfo("abc", types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, testAddr,
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, "true",
+		types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo, types.DoNotModifyTokenInfo)
+	require.True(t, h(ctx, modify).IsOK())
+
+	history := input.tk.GetTokenInfoHistory(ctx, "abc")
+	require.Equal(t, 2, len(history))
+	require.Equal(t, uint64(1), history[0].Version)
+	require.Equal(t, int64(10), history[0].Height)
+	require.Equal(t, "www.abc.org", history[0].URL)
+	require.Equal(t, uint64(2), history[1].Version)
+	require.Equal(t, int64(20), history[1].Height)
+	require.Equal(t, "www.abc.com", history[1].URL)
+
+	// only the registered verifiers can attest
+	_, _, verifier := keyPubAddr()
+	attest := asset.NewMsgAttestToken("abc", verifier, "identity verified")
+	require.Equal(t, types.CodeNotTokenVerifier, h(ctx, attest).Code)
+	params := input.tk.GetParams(ctx)
+	params.TokenVerifiers = []sdk.AccAddress{verifier}
+	input.tk.SetParams(ctx, params)
+	require.True(t, h(ctx, attest).IsOK())
+	attest.Claim = "website verified"
+	require.True(t, h(ctx, attest).IsOK())
+	attestations := input.tk.GetTokenAttestations(ctx, "abc")
+	require.Equal(t, 1, len(attestations))
+	require.Equal(t, "website verified", attestations[0].Claim)
+	require.Equal(t, int64(30), attestations[0].Height)
+
+	attest.Symbol = "xyz"
+	require.Equal(t, types.CodeTokenNotFound, h(ctx, attest).Code)
+	attest.Symbol = "abc"
+	attest.Claim = ""
+	require.True(t, h(ctx, attest).IsOK())
+	require.Equal(t, 0, len(input.tk.GetTokenAttestations(ctx, "abc")))
+	require.Equal(t, types.CodeInvalidAttestation, h(ctx, attest).Code)
+}
+
 func Test_IssueToken_DeductFee(t *testing.T) {
 	testIssueTokenDeductFee(t, "abc")
 	testIssueTokenDeductFee(t, "abcd")
@@ -0,0 +1,52 @@
+package keepers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// Airdrop - distribute the token of owner to the outputs, the activation fees of the fresh accounts are paid by owner
+func (keeper BaseKeeper) Airdrop(ctx sdk.Context, symbol string, owner sdk.AccAddress, outputs []bank.Output) (types.AirdropInfo, sdk.Error) {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return types.AirdropInfo{}, err
+	}
+
+	total := sdk.ZeroInt()
+	for _, output := range outputs {
+		if keeper.bkx.BlacklistedAddr(output.Address) {
+			return types.AirdropInfo{}, sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", output.Address))
+		}
+		total = total.Add(output.Coins.AmountOf(symbol))
+	}
+
+	// must be checked before the recipients get the coins, which creates the accounts
+	freshAddrs := keeper.bkx.PreCheckFreshAccounts(ctx, outputs)
+
+	// the recipients must accept coins from owner, as in a multi-send
+	inputs := []bank.Input{bank.NewInput(owner, types.NewTokenCoins(symbol, total))}
+	if err := keeper.bkx.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return types.AirdropInfo{}, err
+	}
+	fee, err := keeper.bkx.PayActivationFeeForFreshAccounts(ctx, owner, freshAddrs)
+	if err != nil {
+		return types.AirdropInfo{}, err
+	}
+
+	return types.AirdropInfo{
+		Symbol:            symbol,
+		Owner:             owner,
+		RecipientCount:    len(outputs),
+		TotalAmount:       total,
+		FreshAccountCount: len(freshAddrs),
+		ActivationFee:     fee,
+		Height:            ctx.BlockHeight(),
+	}, nil
+}
+
+func (keeper BaseKeeper) IsSubscribed(topic string) bool {
+	return keeper.msgProducer.IsSubscribed(topic)
+}
@@ -0,0 +1,299 @@
+package keepers
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// GetSymbolAuction - return the open auction of symbol
+func (keeper BaseKeeper) GetSymbolAuction(ctx sdk.Context, symbol string) (auction types.SymbolAuction, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetSymbolAuctionKey(symbol))
+	if bz == nil {
+		return auction, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return auction, true
+}
+
+// GetSymbolAuctions - returns all the open symbol auctions
+func (keeper BaseKeeper) GetSymbolAuctions(ctx sdk.Context) []types.SymbolAuction {
+	auctions := make([]types.SymbolAuction, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SymbolAuctionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var auction types.SymbolAuction
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// GetSymbolReservation - return the reservation of symbol won in an auction
+func (keeper BaseKeeper) GetSymbolReservation(ctx sdk.Context, symbol string) (reservation types.SymbolReservation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetSymbolReservationKey(symbol))
+	if bz == nil {
+		return reservation, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &reservation)
+	return reservation, true
+}
+
+// GetSymbolReservations - returns the symbols won in auctions but not issued yet
+func (keeper BaseKeeper) GetSymbolReservations(ctx sdk.Context) []types.SymbolReservation {
+	reservations := make([]types.SymbolReservation, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SymbolReservationKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var reservation types.SymbolReservation
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
+// BidSymbol - place a sealed bid, the first bid opens the auction of symbol.
+// The deposit is locked in the module account until the auction is settled.
+func (keeper BaseKeeper) BidSymbol(ctx sdk.Context, symbol string, bidder sdk.AccAddress, sealedBid []byte, deposit sdk.Int) (types.SymbolAuction, sdk.Error) {
+	params := keeper.GetParams(ctx)
+	if !params.SymbolAuctionEnabled {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("symbol auction is disabled")
+	}
+	if !types.IsAuctionSymbol(symbol) {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction(symbol + " is not an auction symbol")
+	}
+	if keeper.IsTokenExists(ctx, symbol) {
+		return types.SymbolAuction{}, types.ErrDuplicateTokenSymbol(symbol)
+	}
+	if _, found := keeper.GetSymbolReservation(ctx, symbol); found {
+		return types.SymbolAuction{}, types.ErrSymbolReserved(symbol)
+	}
+	if keeper.bkx.BlacklistedAddr(bidder) {
+		return types.SymbolAuction{}, types.ErrAccInBlackList(bidder)
+	}
+	if !deposit.IsInt64() {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("deposit is too large")
+	}
+	if minBid := params.GetIssueTokenFee(symbol); deposit.LT(sdk.NewInt(minBid)) {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("deposit is less than the reserve price")
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	auction, found := keeper.GetSymbolAuction(ctx, symbol)
+	if !found {
+		bidEnd := now + params.AuctionBiddingPeriod
+		auction = types.NewSymbolAuction(symbol, bidEnd, bidEnd+params.AuctionRevealPeriod)
+		store := ctx.KVStore(keeper.storeKey)
+		store.Set(types.GetSymbolAuctionQueueKey(auction.RevealEndTime, symbol), []byte{})
+	}
+	if now >= auction.BidEndTime {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("bidding phase is over")
+	}
+	if auction.GetBid(bidder) >= 0 {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("duplicate bid of " + bidder.String())
+	}
+	if len(auction.Bids) >= types.MaxSymbolAuctionBids {
+		return types.SymbolAuction{}, types.ErrInvalidSymbolAuction("too many bids")
+	}
+
+	if err := keeper.sk.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, dex.NewCetCoins(deposit.Int64())); err != nil {
+		return types.SymbolAuction{}, err
+	}
+	auction.Bids = append(auction.Bids, types.SymbolBid{
+		Bidder:    bidder,
+		SealedBid: sealedBid,
+		Deposit:   deposit,
+		Amount:    sdk.ZeroInt(),
+	})
+	keeper.setSymbolAuction(ctx, auction)
+	return auction, nil
+}
+
+// RevealSymbolBid - reveal a sealed bid in the reveal phase, the amount can't exceed the deposit
+func (keeper BaseKeeper) RevealSymbolBid(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int, salt string) sdk.Error {
+	auction, found := keeper.GetSymbolAuction(ctx, symbol)
+	if !found {
+		return types.ErrInvalidSymbolAuction("no auction of " + symbol)
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if now < auction.BidEndTime || now >= auction.RevealEndTime {
+		return types.ErrInvalidSymbolAuction("not in the reveal phase")
+	}
+	i := auction.GetBid(bidder)
+	if i < 0 {
+		return types.ErrInvalidSymbolAuction("no bid of " + bidder.String())
+	}
+	bid := auction.Bids[i]
+	if bid.Revealed {
+		return types.ErrInvalidSymbolAuction("bid already revealed")
+	}
+	if !bytes.Equal(bid.SealedBid, types.SealSymbolBid(symbol, bidder, amount, salt)) {
+		return types.ErrInvalidSymbolAuction("bid does not match the sealed bid")
+	}
+	if minBid := keeper.GetParams(ctx).GetIssueTokenFee(symbol); amount.LT(sdk.NewInt(minBid)) || amount.GT(bid.Deposit) {
+		return types.ErrInvalidSymbolAuction("bid must be between the reserve price and the deposit")
+	}
+
+	auction.Bids[i].Amount = amount
+	auction.Bids[i].Revealed = true
+	keeper.setSymbolAuction(ctx, auction)
+	return nil
+}
+
+// SettleSymbolAuctions - settle the auctions whose reveal phase ends at or before time.
+// The price goes to the community pool and the change is refunded to the winner, the other
+// revealed bids are refunded and the unrevealed deposits are forfeited to the community pool.
+func (keeper BaseKeeper) SettleSymbolAuctions(ctx sdk.Context, time int64) []types.SymbolAuctionResult {
+	store := ctx.KVStore(keeper.storeKey)
+	keys := dex.DueQueueKeys(store, types.SymbolAuctionQueueKey, sdk.PrefixEndBytes(types.GetSymbolAuctionQueueTimeKey(time)), 0)
+	results := make([]types.SymbolAuctionResult, 0, len(keys))
+	for _, key := range keys {
+		symbol := string(key[len(types.SymbolAuctionQueueKey)+8:])
+		auction, found := keeper.GetSymbolAuction(ctx, symbol)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		// the auction stays in the queue and is settled again in the next block if it fails
+		cacheCtx, write := ctx.CacheContext()
+		result, err := keeper.settleSymbolAuction(cacheCtx, auction)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to settle the auction of %s: %s", symbol, err.Error()))
+			continue
+		}
+		cacheStore := cacheCtx.KVStore(keeper.storeKey)
+		cacheStore.Delete(key)
+		cacheStore.Delete(types.GetSymbolAuctionKey(symbol))
+		write()
+		results = append(results, result)
+	}
+	return results
+}
+
+func (keeper BaseKeeper) settleSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) (types.SymbolAuctionResult, sdk.Error) {
+	result := types.SymbolAuctionResult{
+		Symbol:    auction.Symbol,
+		Price:     sdk.ZeroInt(),
+		BidCount:  len(auction.Bids),
+		Forfeited: sdk.ZeroInt(),
+		Height:    ctx.BlockHeight(),
+	}
+	winner := auction.GetWinner()
+	toCommunityPool := sdk.ZeroInt()
+	for i, bid := range auction.Bids {
+		refund := bid.Deposit
+		switch {
+		case i == winner:
+			result.Winner = bid.Bidder
+			result.Price = bid.Amount
+			refund = bid.Deposit.Sub(bid.Amount)
+			toCommunityPool = toCommunityPool.Add(bid.Amount)
+		case !bid.Revealed:
+			result.Forfeited = result.Forfeited.Add(bid.Deposit)
+			toCommunityPool = toCommunityPool.Add(bid.Deposit)
+			refund = sdk.ZeroInt()
+		}
+		if refund.IsPositive() {
+			if err := keeper.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, dex.NewCetCoins(refund.Int64())); err != nil {
+				return result, err
+			}
+		}
+	}
+	if toCommunityPool.IsPositive() {
+		if err := keeper.sxk.BurnCoins(ctx, types.ModuleName, dex.NewCetCoins(toCommunityPool.Int64())); err != nil {
+			return result, err
+		}
+	}
+	if winner >= 0 {
+		keeper.setSymbolReservation(ctx, types.SymbolReservation{
+			Symbol:     auction.Symbol,
+			Owner:      result.Winner,
+			Price:      result.Price,
+			ExpireTime: ctx.BlockHeader().Time.Unix() + types.SymbolReservationPeriod,
+		})
+	}
+	return result, nil
+}
+
+// ExpireSymbolReservations - remove the reservations which are not issued at or before time,
+// so that their symbols can be auctioned again
+func (keeper BaseKeeper) ExpireSymbolReservations(ctx sdk.Context, time int64) []types.SymbolReservation {
+	store := ctx.KVStore(keeper.storeKey)
+	keys := dex.DueQueueKeys(store, types.SymbolReservationQueueKey, sdk.PrefixEndBytes(types.GetSymbolReservationQueueTimeKey(time)), 0)
+	expired := make([]types.SymbolReservation, 0, len(keys))
+	for _, key := range keys {
+		store.Delete(key)
+		symbol := string(key[len(types.SymbolReservationQueueKey)+8:])
+		reservation, found := keeper.GetSymbolReservation(ctx, symbol)
+		if !found {
+			continue
+		}
+		keeper.removeSymbolReservation(ctx, symbol)
+		expired = append(expired, reservation)
+	}
+	return expired
+}
+
+// checkSymbolAuction - check whether owner can issue symbol, returns true if owner won its auction
+func (keeper BaseKeeper) checkSymbolAuction(ctx sdk.Context, symbol string, owner sdk.AccAddress) (bool, sdk.Error) {
+	if _, found := keeper.GetSymbolAuction(ctx, symbol); found {
+		return false, types.ErrSymbolInAuction(symbol)
+	}
+	if reservation, found := keeper.GetSymbolReservation(ctx, symbol); found {
+		if !reservation.Owner.Equals(owner) {
+			return false, types.ErrSymbolReserved(symbol)
+		}
+		return true, nil
+	}
+	if keeper.GetParams(ctx).SymbolAuctionEnabled && types.IsAuctionSymbol(symbol) {
+		return false, types.ErrSymbolReserved(symbol)
+	}
+	return false, nil
+}
+
+// IsSymbolReservedFor - check whether symbol was won by owner in an auction
+func (keeper BaseKeeper) IsSymbolReservedFor(ctx sdk.Context, symbol string, owner sdk.AccAddress) bool {
+	reservation, found := keeper.GetSymbolReservation(ctx, symbol)
+	return found && reservation.Owner.Equals(owner)
+}
+
+// ImportGenesisSymbolAuction - import an open auction from genesis.json
+func (keeper BaseKeeper) ImportGenesisSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) {
+	keeper.setSymbolAuction(ctx, auction)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolAuctionQueueKey(auction.RevealEndTime, auction.Symbol), []byte{})
+}
+
+// ImportGenesisSymbolReservation - import a reservation from genesis.json
+func (keeper BaseKeeper) ImportGenesisSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) {
+	keeper.setSymbolReservation(ctx, reservation)
+}
+
+func (keeper BaseKeeper) setSymbolAuction(ctx sdk.Context, auction types.SymbolAuction) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolAuctionKey(auction.Symbol), keeper.cdc.MustMarshalBinaryBare(auction))
+}
+
+func (keeper BaseKeeper) setSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetSymbolReservationKey(reservation.Symbol), keeper.cdc.MustMarshalBinaryBare(reservation))
+	store.Set(types.GetSymbolReservationQueueKey(reservation.ExpireTime, reservation.Symbol), []byte{})
+}
+
+func (keeper BaseKeeper) removeSymbolReservation(ctx sdk.Context, symbol string) {
+	reservation, found := keeper.GetSymbolReservation(ctx, symbol)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetSymbolReservationKey(symbol))
+	store.Delete(types.GetSymbolReservationQueueKey(reservation.ExpireTime, symbol))
+}
@@ -0,0 +1,45 @@
+package keepers
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// Clawback - move the token of a forbidden address to the recovery address set at issuance,
+// the activation fee is paid by owner if the recovery address is fresh
+func (keeper BaseKeeper) Clawback(ctx sdk.Context, symbol string, owner, from sdk.AccAddress, amount sdk.Int) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if !token.GetClawbackable() {
+		return types.ErrClawbackNotSupported(symbol)
+	}
+	to := token.GetClawbackRecipient()
+	if from.Equals(to) {
+		return types.ErrInvalidClawback("from is the recovery address")
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	if !store.Has(types.GetForbiddenAddrStoreKey(symbol, from)) {
+		return types.ErrInvalidClawback(fmt.Sprintf("%s is not forbidden", from))
+	}
+	if keeper.bkx.BlacklistedAddr(to) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", to))
+	}
+
+	coins := types.NewTokenCoins(symbol, amount)
+	freshAddrs := keeper.bkx.PreCheckFreshAccounts(ctx, []bank.Output{bank.NewOutput(to, coins)})
+	if err := keeper.bkx.SubtractCoins(ctx, from, coins); err != nil {
+		return err
+	}
+	if err := keeper.bkx.AddCoins(ctx, to, coins); err != nil {
+		return err
+	}
+	_, err = keeper.bkx.PayActivationFeeForFreshAccounts(ctx, owner, freshAddrs)
+	return err
+}
@@ -0,0 +1,63 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// GetForbidExpiries - returns all the forbids with expiry in the order of expire time
+func (keeper BaseKeeper) GetForbidExpiries(ctx sdk.Context) []types.ForbidExpiry {
+	expiries := make([]types.ForbidExpiry, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ForbidExpiryQueueKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var expiry types.ForbidExpiry
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &expiry)
+		expiries = append(expiries, expiry)
+	}
+	return expiries
+}
+
+// UnForbidExpiredAddresses - unforbid the addresses whose forbids expire at or before time,
+// returns the expired forbids in the order of expire time
+func (keeper BaseKeeper) UnForbidExpiredAddresses(ctx sdk.Context, time int64) []types.ForbidExpiry {
+	store := ctx.KVStore(keeper.storeKey)
+	keys := dex.DueQueueKeys(store, types.ForbidExpiryQueueKey, sdk.PrefixEndBytes(types.GetForbidExpiryQueueTimeKey(time)), 0)
+	expired := make([]types.ForbidExpiry, 0, len(keys))
+	for _, key := range keys {
+		var expiry types.ForbidExpiry
+		keeper.cdc.MustUnmarshalBinaryBare(store.Get(key), &expiry)
+		_ = keeper.removeForbiddenAddress(ctx, expiry.Symbol, []sdk.AccAddress{expiry.Address})
+		expired = append(expired, expiry)
+	}
+	return expired
+}
+
+// ImportGenesisForbidExpiry - import a forbid expiry from genesis.json
+func (keeper BaseKeeper) ImportGenesisForbidExpiry(ctx sdk.Context, expiry types.ForbidExpiry) {
+	keeper.setForbidExpiry(ctx, expiry)
+}
+
+func (keeper BaseKeeper) setForbidExpiry(ctx sdk.Context, expiry types.ForbidExpiry) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetForbidExpiryKey(expiry.Symbol, expiry.Address), sdk.Uint64ToBigEndian(uint64(expiry.ExpireTime)))
+	store.Set(types.GetForbidExpiryQueueKey(expiry.ExpireTime, expiry.Symbol, expiry.Address),
+		keeper.cdc.MustMarshalBinaryBare(expiry))
+}
+
+func (keeper BaseKeeper) removeForbidExpiry(ctx sdk.Context, symbol string, addr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.GetForbidExpiryKey(symbol, addr)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+	expireTime := int64(binary.BigEndian.Uint64(bz))
+	store.Delete(types.GetForbidExpiryQueueKey(expireTime, symbol, addr))
+	store.Delete(key)
+}
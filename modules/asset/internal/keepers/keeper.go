@@ -9,10 +9,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -33,10 +35,61 @@ type Keeper interface {
 	AddTokenWhitelist(ctx sdk.Context, symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) sdk.Error
 	RemoveTokenWhitelist(ctx sdk.Context, symbol string, owner sdk.AccAddress, whitelist []sdk.AccAddress) sdk.Error
 	ForbidAddress(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error
+	ForbidAddressUntil(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress, expireTime int64) sdk.Error
 	UnForbidAddress(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error
 	ModifyTokenInfo(ctx sdk.Context, symbol string, owner sdk.AccAddress,
 		url, description, identity, name string, totalSupply sdk.Int,
 		mintable, burnable, addrForbiddable, tokenForbiddable bool) sdk.Error
+	SetTokenDecimals(ctx sdk.Context, symbol string, owner sdk.AccAddress, decimals uint8) sdk.Error
+	SetTokenDisplayUnits(ctx sdk.Context, symbol string, owner sdk.AccAddress, units []types.DisplayUnit) sdk.Error
+	SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error
+
+	GetOwnerGroup(ctx sdk.Context, symbol string) (types.OwnerGroup, bool)
+	GetAllOwnerGroups(ctx sdk.Context) []types.OwnerGroup
+	SetOwnerGroup(ctx sdk.Context, symbol string, owner sdk.AccAddress, members []sdk.AccAddress, threshold uint32) sdk.Error
+	GetTokenActionProposal(ctx sdk.Context, symbol string, id uint64) (types.TokenActionProposal, bool)
+	GetTokenActionProposals(ctx sdk.Context, symbol string) []types.TokenActionProposal
+	ProposeTokenAction(ctx sdk.Context, symbol string, proposer sdk.AccAddress, action sdk.Msg) (types.TokenActionProposal, sdk.Error)
+	ApproveTokenAction(ctx sdk.Context, symbol string, id uint64, approver sdk.AccAddress) (types.TokenActionProposal, sdk.Error)
+	IsProposalApproved(ctx sdk.Context, proposal types.TokenActionProposal) bool
+	DeleteTokenActionProposal(ctx sdk.Context, symbol string, id uint64)
+	ImportGenesisOwnerGroup(ctx sdk.Context, group types.OwnerGroup)
+	ImportGenesisTokenActionProposal(ctx sdk.Context, proposal types.TokenActionProposal)
+
+	GetTokenRole(ctx sdk.Context, symbol, role string, grantee sdk.AccAddress) (types.TokenRole, bool)
+	GetTokenRoles(ctx sdk.Context, symbol string) []types.TokenRole
+	GrantTokenRole(ctx sdk.Context, symbol string, owner, grantee sdk.AccAddress, role string, allowance sdk.Int) sdk.Error
+	RevokeTokenRole(ctx sdk.Context, symbol string, owner, grantee sdk.AccAddress, role string) sdk.Error
+	UseMintAllowance(ctx sdk.Context, symbol string, minter sdk.AccAddress, amount sdk.Int) sdk.Error
+	ImportGenesisTokenRole(ctx sdk.Context, tokenRole types.TokenRole)
+
+	Airdrop(ctx sdk.Context, symbol string, owner sdk.AccAddress, outputs []bank.Output) (types.AirdropInfo, sdk.Error)
+	Clawback(ctx sdk.Context, symbol string, owner, from sdk.AccAddress, amount sdk.Int) sdk.Error
+	IsSubscribed(topic string) bool
+	GetTokenHolders(ctx sdk.Context, symbol string, after sdk.AccAddress, limit int) types.TokenHolders
+
+	GetForbidExpiries(ctx sdk.Context) []types.ForbidExpiry
+	UnForbidExpiredAddresses(ctx sdk.Context, time int64) []types.ForbidExpiry
+	ImportGenesisForbidExpiry(ctx sdk.Context, expiry types.ForbidExpiry)
+
+	BidSymbol(ctx sdk.Context, symbol string, bidder sdk.AccAddress, sealedBid []byte, deposit sdk.Int) (types.SymbolAuction, sdk.Error)
+	RevealSymbolBid(ctx sdk.Context, symbol string, bidder sdk.AccAddress, amount sdk.Int, salt string) sdk.Error
+	SettleSymbolAuctions(ctx sdk.Context, time int64) []types.SymbolAuctionResult
+	GetSymbolAuction(ctx sdk.Context, symbol string) (types.SymbolAuction, bool)
+	GetSymbolAuctions(ctx sdk.Context) []types.SymbolAuction
+	GetSymbolReservation(ctx sdk.Context, symbol string) (types.SymbolReservation, bool)
+	GetSymbolReservations(ctx sdk.Context) []types.SymbolReservation
+	IsSymbolReservedFor(ctx sdk.Context, symbol string, owner sdk.AccAddress) bool
+	ImportGenesisSymbolAuction(ctx sdk.Context, auction types.SymbolAuction)
+	ImportGenesisSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation)
+	ExpireSymbolReservations(ctx sdk.Context, time int64) []types.SymbolReservation
+
+	GetTokenInfoHistory(ctx sdk.Context, symbol string) []types.TokenInfoVersion
+	GetTokenAttestations(ctx sdk.Context, symbol string) []types.TokenAttestation
+	AttestToken(ctx sdk.Context, symbol string, verifier sdk.AccAddress, claim string) sdk.Error
+	ImportGenesisTokenInfoVersion(ctx sdk.Context, version types.TokenInfoVersion)
+	SeedGenesisTokenInfo(ctx sdk.Context, token types.Token)
+	ImportGenesisTokenAttestation(ctx sdk.Context, attestation types.TokenAttestation)
 
 	SetParams(ctx sdk.Context, params types.Params)
 	GetParams(ctx sdk.Context) (params types.Params)
@@ -57,11 +110,14 @@ type BaseKeeper struct {
 
 	bkx types.ExpectedBankxKeeper
 	sk  types.ExpectedSupplyKeeper
+	sxk types.ExpectedSupplyxKeeper
+
+	msgProducer msgqueue.MsgSender
 }
 
 // NewBaseKeeper returns a new BaseKeeper that uses go-amino to (binary) encode and decode concrete Token.
 func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
-	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper) BaseKeeper {
+	paramStore params.Subspace, bkx types.ExpectedBankxKeeper, sk supply.Keeper, sxk types.ExpectedSupplyxKeeper, msgProducer msgqueue.MsgSender) BaseKeeper {
 	return BaseKeeper{
 		BaseTokenKeeper: NewBaseTokenKeeper(cdc, key),
 
@@ -70,6 +126,8 @@ func NewBaseKeeper(cdc *codec.Codec, key sdk.StoreKey,
 		paramSubspace: paramStore.WithKeyTable(ParamKeyTable()),
 		bkx:           bkx,
 		sk:            sk,
+		sxk:           sxk,
+		msgProducer:   msgProducer,
 	}
 }
 
@@ -102,6 +160,12 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 		}
 	}
 
+	// only the auction winner can issue the short symbols
+	reserved, err := keeper.checkSymbolAuction(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
 	token, err := types.NewToken(
 		name,
 		symbol,
@@ -123,6 +187,10 @@ func (keeper BaseKeeper) IssueToken(ctx sdk.Context, name string, symbol string,
 	if err := keeper.SetToken(ctx, token); err != nil {
 		return err
 	}
+	if reserved {
+		keeper.removeSymbolReservation(ctx, symbol)
+	}
+	keeper.recordTokenInfo(ctx, token)
 
 	return keeper.sk.MintCoins(ctx, types.ModuleName, types.NewTokenCoins(symbol, totalSupply))
 }
@@ -140,6 +208,8 @@ func (keeper BaseKeeper) TransferOwnership(ctx sdk.Context, symbol string, origi
 	if err := token.SetOwner(newOwner); err != nil {
 		return err
 	}
+	// the owner can't hold a role
+	keeper.revokeTokenRoles(ctx, symbol, newOwner)
 
 	return keeper.SetToken(ctx, token)
 }
@@ -155,6 +225,12 @@ func (keeper BaseKeeper) MintToken(ctx sdk.Context, symbol string, owner sdk.Acc
 		return types.ErrTokenMintNotSupported(symbol)
 	}
 
+	if policy := token.GetMintPolicy(); policy != nil {
+		if err := policy.Mint(ctx.BlockHeader().Time.Unix(), token.GetTotalSupply(), amount); err != nil {
+			return err
+		}
+	}
+
 	if err := token.SetTotalMint(token.GetTotalMint().Add(amount)); err != nil {
 		return err
 	}
@@ -267,6 +343,12 @@ func (keeper BaseKeeper) RemoveTokenWhitelist(ctx sdk.Context, symbol string, ow
 
 // ForbidAddress - add forbidden addresses
 func (keeper BaseKeeper) ForbidAddress(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) sdk.Error {
+	return keeper.ForbidAddressUntil(ctx, symbol, owner, addresses, 0)
+}
+
+// ForbidAddressUntil - add forbidden addresses which are unforbidden in EndBlocker at expireTime,
+// they are forbidden permanently if expireTime is 0. The previous expiries of the addresses are replaced.
+func (keeper BaseKeeper) ForbidAddressUntil(ctx sdk.Context, symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress, expireTime int64) sdk.Error {
 	token, err := keeper.checkPrecondition(ctx, symbol, owner)
 	if err != nil {
 		return err
@@ -275,9 +357,21 @@ func (keeper BaseKeeper) ForbidAddress(ctx sdk.Context, symbol string, owner sdk
 	if !token.GetAddrForbiddable() {
 		return types.ErrAddressForbiddenNotSupported(symbol)
 	}
+	if expireTime != 0 && expireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidForbidExpireTime(expireTime)
+	}
 	if err = keeper.addForbiddenAddress(ctx, symbol, addresses); err != nil {
 		return types.ErrInvalidForbiddenAddress()
 	}
+	for _, addr := range addresses {
+		if addr.Empty() {
+			continue
+		}
+		keeper.removeForbidExpiry(ctx, symbol, addr)
+		if expireTime != 0 {
+			keeper.setForbidExpiry(ctx, types.NewForbidExpiry(symbol, addr, expireTime))
+		}
+	}
 	return nil
 }
 
@@ -340,6 +434,9 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		if distributed {
 			return types.ErrCodeTokenInfoSealed("TotalSupply")
 		}
+		if policy := token.GetMintPolicy(); policy != nil && policy.SupplyCap.IsPositive() && totalSupply.GT(policy.SupplyCap) {
+			return types.ErrMintPolicyViolated("total supply would exceed the cap " + policy.SupplyCap.String())
+		}
 		if err := token.SetTotalSupply(totalSupply); err != nil {
 			return err
 		}
@@ -409,6 +506,73 @@ func (keeper BaseKeeper) ModifyTokenInfo(ctx sdk.Context, symbol string, owner s
 		}
 	}
 
+	keeper.recordTokenInfo(ctx, token)
+	return keeper.SetToken(ctx, token)
+}
+
+// SetTokenDecimals - set token decimals, which is modifiable only while unset
+func (keeper BaseKeeper) SetTokenDecimals(ctx sdk.Context, symbol string, owner sdk.AccAddress, decimals uint8) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if token.IsDecimalsSet() {
+		return types.ErrCodeTokenInfoSealed("Decimals")
+	}
+	if err := token.SetDecimals(decimals); err != nil {
+		return err
+	}
+
+	return keeper.SetToken(ctx, token)
+}
+
+// SetTokenDisplayUnits - set token display units, which are modifiable only while unset
+func (keeper BaseKeeper) SetTokenDisplayUnits(ctx sdk.Context, symbol string, owner sdk.AccAddress, units []types.DisplayUnit) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if len(token.GetDisplayUnits()) != 0 {
+		return types.ErrCodeTokenInfoSealed("DisplayUnits")
+	}
+	if len(units) == 0 {
+		return types.ErrInvalidTokenDisplayUnits("")
+	}
+	if err := token.SetDisplayUnits(units); err != nil {
+		return err
+	}
+
+	return keeper.SetToken(ctx, token)
+}
+
+// SetMintPolicy - set the mint policy of token, a policy already set can only be replaced by a tighter one
+func (keeper BaseKeeper) SetMintPolicy(ctx sdk.Context, symbol string, owner sdk.AccAddress, policy types.MintPolicy) sdk.Error {
+	token, err := keeper.checkPrecondition(ctx, symbol, owner)
+	if err != nil {
+		return err
+	}
+
+	if !token.GetMintable() {
+		return types.ErrTokenMintNotSupported(symbol)
+	}
+
+	// the minting records are kept by the new policy
+	policy = policy.ResetRecords()
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	if old := token.GetMintPolicy(); old != nil {
+		if !policy.IsTighterThan(*old) {
+			return types.ErrInvalidMintPolicy("mint policy can only be tightened")
+		}
+		policy.PeriodStart, policy.PeriodMinted, policy.TotalMinted = old.PeriodStart, old.PeriodMinted, old.TotalMinted
+	}
+	if err := token.SetMintPolicy(&policy); err != nil {
+		return err
+	}
+
 	return keeper.SetToken(ctx, token)
 }
 
@@ -430,6 +594,27 @@ func (keeper BaseKeeper) AddToken(ctx sdk.Context, addr sdk.AccAddress, amt sdk.
 	return keeper.bkx.AddCoins(ctx, addr, amt)
 }
 
+// GetTokenHolders - returns a page of at most limit holders of token, which starts after the address after,
+// the last holder of the previous page, or from the first holder if after is empty
+func (keeper BaseKeeper) GetTokenHolders(ctx sdk.Context, symbol string, after sdk.AccAddress, limit int) types.TokenHolders {
+	holders := types.TokenHolders{
+		Symbol:  symbol,
+		After:   after,
+		Limit:   limit,
+		Holders: make([]types.TokenHolder, 0, limit),
+	}
+	keeper.bkx.IterateTokenHolders(ctx, symbol, after, func(addr sdk.AccAddress, liquid, frozen, locked sdk.Int) bool {
+		holders.Holders = append(holders.Holders, types.TokenHolder{
+			Address: addr,
+			Liquid:  liquid,
+			Frozen:  frozen,
+			Locked:  locked,
+		})
+		return len(holders.Holders) >= limit
+	})
+	return holders
+}
+
 // GetAccTotalToken - used for unit test
 func (keeper BaseKeeper) GetAccTotalToken(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return keeper.bkx.GetTotalCoins(ctx, addr)
@@ -497,6 +682,7 @@ func (keeper BaseKeeper) removeForbiddenAddress(ctx sdk.Context, symbol string,
 			continue
 		}
 		store.Delete(types.GetForbiddenAddrStoreKey(symbol, addr))
+		keeper.removeForbidExpiry(ctx, symbol, addr)
 	}
 
 	return nil
@@ -508,6 +694,7 @@ func (keeper BaseKeeper) removeForbiddenAddress(ctx sdk.Context, symbol string,
 type TokenKeeper interface {
 	GetToken(ctx sdk.Context, symbol string) types.Token
 	GetAllTokens(ctx sdk.Context) []types.Token
+	IterateTokenSendLocks(ctx sdk.Context, process func(symbol string, sendLock sdk.Int) (stop bool))
 	GetWhitelist(ctx sdk.Context, symbol string) []sdk.AccAddress
 	GetForbiddenAddresses(ctx sdk.Context, symbol string) []sdk.AccAddress
 
@@ -560,6 +747,20 @@ func (keeper BaseTokenKeeper) GetAllTokens(ctx sdk.Context) []types.Token {
 	return tokens
 }
 
+// IterateTokenSendLocks - iterates the symbol and send lock of all tokens.
+func (keeper BaseTokenKeeper) IterateTokenSendLocks(ctx sdk.Context, process func(symbol string, sendLock sdk.Int) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		token := types.MustUnmarshalToken(keeper.cdc, iterator.Value())
+		if process(token.GetSymbol(), token.GetSendLock()) {
+			return
+		}
+	}
+}
+
 // GetWhitelist - returns whitelist.
 func (keeper BaseTokenKeeper) GetWhitelist(ctx sdk.Context, symbol string) []sdk.AccAddress {
 	whitelist := make([]sdk.AccAddress, 0)
@@ -3,6 +3,7 @@ package keepers_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -560,6 +561,41 @@ func TestTokenKeeper_ForbidAddress(t *testing.T) {
 	input.tk.RemoveToken(input.ctx, token)
 }
 
+func TestTokenKeeper_ForbidAddressUntil(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	mock := mockAddrList()
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	err := input.tk.IssueToken(ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, true, true, true, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+
+	err = input.tk.ForbidAddressUntil(ctx, symbol, testAddr, mock, 999)
+	require.Error(t, err)
+	err = input.tk.ForbidAddressUntil(ctx, symbol, testAddr, mock[:2], 3000)
+	require.NoError(t, err)
+	err = input.tk.ForbidAddressUntil(ctx, symbol, testAddr, mock[1:], 2000)
+	require.NoError(t, err)
+	expiries := input.tk.GetForbidExpiries(ctx)
+	require.ElementsMatch(t, []types.ForbidExpiry{
+		types.NewForbidExpiry(symbol, mock[1], 2000),
+		types.NewForbidExpiry(symbol, mock[2], 2000),
+	}, expiries[:2])
+	require.Equal(t, types.NewForbidExpiry(symbol, mock[0], 3000), expiries[2])
+
+	// the expiry is removed with the forbid
+	err = input.tk.UnForbidAddress(ctx, symbol, testAddr, []sdk.AccAddress{mock[2]})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(input.tk.GetForbidExpiries(ctx)))
+
+	expired := input.tk.UnForbidExpiredAddresses(ctx, 2000)
+	require.Equal(t, []types.ForbidExpiry{types.NewForbidExpiry(symbol, mock[1], 2000)}, expired)
+	require.False(t, input.tk.IsForbiddenByTokenIssuer(ctx, symbol, mock[1]))
+	require.True(t, input.tk.IsForbiddenByTokenIssuer(ctx, symbol, mock[0]))
+	require.Equal(t, 1, len(input.tk.GetForbidExpiries(ctx)))
+}
+
 func TestTokenKeeper_UnForbidAddress(t *testing.T) {
 	input := createTestInput()
 	symbol := "abc"
@@ -850,3 +886,155 @@ func checkTokensEqual(t *testing.T, token, newToken types.Token) {
 	require.Equal(t, token.GetAddrForbiddable(), newToken.GetAddrForbiddable())
 	require.Equal(t, token.GetTokenForbiddable(), newToken.GetTokenForbiddable())
 }
+
+func TestTokenKeeper_SetTokenDecimals(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "www.abc.org", "abc example description", types.TestIdentityString)
+	require.NoError(t, err)
+
+	// only token owner can set decimals
+	var _, _, addr = keyPubAddr()
+	require.Error(t, input.tk.SetTokenDecimals(input.ctx, symbol, addr, 6))
+	require.Error(t, input.tk.SetTokenDecimals(input.ctx, symbol, testAddr, types.MaxTokenDecimals+1))
+
+	require.NoError(t, input.tk.SetTokenDecimals(input.ctx, symbol, testAddr, 6))
+	require.EqualValues(t, 6, input.tk.GetToken(input.ctx, symbol).GetDecimals())
+	err = input.tk.SetTokenDecimals(input.ctx, symbol, testAddr, 8)
+	require.Equal(t, types.CodeTokenInfoSealed, err.Code())
+
+	// exponents can't exceed the decimals
+	require.Error(t, input.tk.SetTokenDisplayUnits(input.ctx, symbol, testAddr, []types.DisplayUnit{{Denom: "abc", Exponent: 8}}))
+	require.NoError(t, input.tk.SetTokenDisplayUnits(input.ctx, symbol, testAddr, []types.DisplayUnit{{Denom: "abc", Exponent: 6}}))
+	err = input.tk.SetTokenDisplayUnits(input.ctx, symbol, testAddr, []types.DisplayUnit{{Denom: "kabc", Exponent: 3}})
+	require.Equal(t, types.CodeTokenInfoSealed, err.Code())
+}
+
+func TestTokenKeeper_OwnerGroup(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "www.abc.org", "abc example description", types.TestIdentityString)
+	require.NoError(t, err)
+
+	var _, _, member = keyPubAddr()
+	members := []sdk.AccAddress{testAddr, member}
+	require.Error(t, input.tk.SetOwnerGroup(input.ctx, symbol, member, members, 2))
+	require.Error(t, input.tk.SetOwnerGroup(input.ctx, symbol, testAddr, members, 3))
+	require.NoError(t, input.tk.SetOwnerGroup(input.ctx, symbol, testAddr, members, 2))
+	group, found := input.tk.GetOwnerGroup(input.ctx, symbol)
+	require.True(t, found)
+	require.EqualValues(t, 2, group.Threshold)
+	require.Equal(t, []types.OwnerGroup{group}, input.tk.GetAllOwnerGroups(input.ctx))
+
+	action := types.NewMsgForbidToken(symbol, testAddr)
+	proposal, err := input.tk.ProposeTokenAction(input.ctx, symbol, testAddr, action)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, proposal.ID)
+	require.False(t, input.tk.IsProposalApproved(input.ctx, proposal))
+	proposal, err = input.tk.ApproveTokenAction(input.ctx, symbol, proposal.ID, member)
+	require.NoError(t, err)
+	require.True(t, input.tk.IsProposalApproved(input.ctx, proposal))
+	stored, found := input.tk.GetTokenActionProposal(input.ctx, symbol, proposal.ID)
+	require.True(t, found)
+	require.Equal(t, proposal, stored)
+
+	// pending proposals are dropped with the previous group
+	proposal, err = input.tk.ProposeTokenAction(input.ctx, symbol, member, action)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, proposal.ID)
+	require.NoError(t, input.tk.SetOwnerGroup(input.ctx, symbol, testAddr, nil, 0))
+	_, found = input.tk.GetOwnerGroup(input.ctx, symbol)
+	require.False(t, found)
+	require.Equal(t, 0, len(input.tk.GetTokenActionProposals(input.ctx, "")))
+	_, err = input.tk.ProposeTokenAction(input.ctx, symbol, testAddr, action)
+	require.Equal(t, types.CodeOwnerGroupNotFound, err.Code())
+
+	// the ids go on after genesis import
+	proposal.ID = 10
+	input.tk.ImportGenesisTokenActionProposal(input.ctx, proposal)
+	require.NoError(t, input.tk.SetOwnerGroup(input.ctx, symbol, testAddr, members, 1))
+	proposal, err = input.tk.ProposeTokenAction(input.ctx, symbol, member, action)
+	require.NoError(t, err)
+	require.EqualValues(t, 11, proposal.ID)
+}
+
+func TestTokenKeeper_TokenRole(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "www.abc.org", "abc example description", types.TestIdentityString)
+	require.NoError(t, err)
+
+	var _, _, grantee = keyPubAddr()
+	require.Error(t, input.tk.GrantTokenRole(input.ctx, symbol, grantee, grantee, types.RoleMinter, sdk.ZeroInt()))
+	require.Error(t, input.tk.GrantTokenRole(input.ctx, symbol, testAddr, grantee, "burner", sdk.ZeroInt()))
+	require.Error(t, input.tk.GrantTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleInfoEditor, sdk.NewInt(10)))
+	err = input.tk.GrantTokenRole(input.ctx, symbol, testAddr, testAddr, types.RoleMinter, sdk.ZeroInt())
+	require.Equal(t, types.CodeInvalidTokenRole, err.Code())
+	require.NoError(t, input.tk.GrantTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleInfoEditor, sdk.ZeroInt()))
+	require.NoError(t, input.tk.GrantTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleMinter, sdk.NewInt(10)))
+	require.Equal(t, 2, len(input.tk.GetTokenRoles(input.ctx, symbol)))
+
+	err = input.tk.UseMintAllowance(input.ctx, symbol, grantee, sdk.NewInt(11))
+	require.Equal(t, types.CodeMintAllowanceExceeded, err.Code())
+	require.NoError(t, input.tk.UseMintAllowance(input.ctx, symbol, grantee, sdk.NewInt(4)))
+	role, found := input.tk.GetTokenRole(input.ctx, symbol, types.RoleMinter, grantee)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(6), role.Allowance)
+
+	require.NoError(t, input.tk.RevokeTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleMinter))
+	err = input.tk.RevokeTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleMinter)
+	require.Equal(t, types.CodeTokenRoleNotFound, err.Code())
+	err = input.tk.UseMintAllowance(input.ctx, symbol, grantee, sdk.NewInt(1))
+	require.Equal(t, types.CodeTokenRoleNotFound, err.Code())
+
+	// an unlimited minter
+	require.NoError(t, input.tk.GrantTokenRole(input.ctx, symbol, testAddr, grantee, types.RoleMinter, sdk.ZeroInt()))
+	require.NoError(t, input.tk.UseMintAllowance(input.ctx, symbol, grantee, sdk.NewInt(1e10)))
+	_, found = input.tk.GetTokenRole(input.ctx, symbol, types.RoleMinter, grantee)
+	require.True(t, found)
+
+	// the new owner loses its roles
+	require.NoError(t, input.tk.TransferOwnership(input.ctx, symbol, testAddr, grantee))
+	require.Equal(t, 0, len(input.tk.GetTokenRoles(input.ctx, symbol)))
+}
+
+func TestTokenKeeper_MintPolicy(t *testing.T) {
+	input := createTestInput()
+	symbol := "abc"
+	err := input.tk.IssueToken(input.ctx, "ABC token", symbol, sdk.NewInt(2100), testAddr,
+		true, false, false, false, "www.abc.org", "abc example description", types.TestIdentityString)
+	require.NoError(t, err)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	var _, _, other = keyPubAddr()
+	policy := types.NewMintPolicy(sdk.NewInt(100), 3600, sdk.NewInt(2500), nil)
+	require.Error(t, input.tk.SetMintPolicy(ctx, symbol, other, policy))
+	require.NoError(t, input.tk.SetMintPolicy(ctx, symbol, testAddr, policy))
+
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(100)))
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(1))
+	require.Equal(t, types.CodeMintPolicyViolated, err.Code())
+	ctx = ctx.WithBlockTime(time.Unix(1000+3600, 0))
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(50)))
+
+	// can only be tightened, the minting records are kept
+	policy = types.NewMintPolicy(sdk.NewInt(200), 3600, sdk.NewInt(2500), nil)
+	err = input.tk.SetMintPolicy(ctx, symbol, testAddr, policy)
+	require.Equal(t, types.CodeInvalidMintPolicy, err.Code())
+	policy = types.NewMintPolicy(sdk.NewInt(80), 3600, sdk.NewInt(2300), nil)
+	require.NoError(t, input.tk.SetMintPolicy(ctx, symbol, testAddr, policy))
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(31))
+	require.Equal(t, types.CodeMintPolicyViolated, err.Code())
+	require.NoError(t, input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(30)))
+	token := input.tk.GetToken(ctx, symbol)
+	require.Equal(t, sdk.NewInt(180), token.GetMintPolicy().TotalMinted)
+	require.Equal(t, sdk.NewInt(2280), token.GetTotalSupply())
+
+	// the supply cap
+	ctx = ctx.WithBlockTime(time.Unix(1000+7200, 0))
+	err = input.tk.MintToken(ctx, symbol, testAddr, sdk.NewInt(21))
+	require.Equal(t, types.CodeMintPolicyViolated, err.Code())
+}
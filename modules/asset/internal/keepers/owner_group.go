@@ -0,0 +1,199 @@
+package keepers
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// GetOwnerGroup - return the owner group of token
+func (keeper BaseTokenKeeper) GetOwnerGroup(ctx sdk.Context, symbol string) (group types.OwnerGroup, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetOwnerGroupKey(symbol))
+	if bz == nil {
+		return group, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, true
+}
+
+// GetAllOwnerGroups - returns the owner groups of all tokens
+func (keeper BaseTokenKeeper) GetAllOwnerGroups(ctx sdk.Context) []types.OwnerGroup {
+	groups := make([]types.OwnerGroup, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OwnerGroupKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var group types.OwnerGroup
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &group)
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// GetTokenActionProposal - return the proposal by id
+func (keeper BaseTokenKeeper) GetTokenActionProposal(ctx sdk.Context, symbol string, id uint64) (proposal types.TokenActionProposal, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetProposalKey(symbol, id))
+	if bz == nil {
+		return proposal, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// GetTokenActionProposals - returns the pending proposals of token, all tokens if symbol is empty
+func (keeper BaseTokenKeeper) GetTokenActionProposals(ctx sdk.Context, symbol string) []types.TokenActionProposal {
+	prefix := types.ProposalKey
+	if len(symbol) != 0 {
+		prefix = types.GetProposalKeyPrefix(symbol)
+	}
+	proposals := make([]types.TokenActionProposal, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var proposal types.TokenActionProposal
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// SetOwnerGroup - set the owner group of token, the group is removed if members is empty.
+// The pending proposals are dropped since they were approved by the previous group, and
+// the roles granted by the owner alone are revoked when the token gets its first group.
+func (keeper BaseKeeper) SetOwnerGroup(ctx sdk.Context, symbol string, owner sdk.AccAddress, members []sdk.AccAddress, threshold uint32) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	_, hadGroup := keeper.GetOwnerGroup(ctx, symbol)
+
+	for _, proposal := range keeper.GetTokenActionProposals(ctx, symbol) {
+		keeper.DeleteTokenActionProposal(ctx, symbol, proposal.ID)
+	}
+
+	if len(members) == 0 {
+		store := ctx.KVStore(keeper.storeKey)
+		store.Delete(types.GetOwnerGroupKey(symbol))
+		return nil
+	}
+
+	group := types.NewOwnerGroup(symbol, members, threshold)
+	if err := group.Validate(); err != nil {
+		return err
+	}
+	if !hadGroup {
+		keeper.revokeTokenRoles(ctx, symbol, nil)
+	}
+	keeper.setOwnerGroup(ctx, group)
+	return nil
+}
+
+// ProposeTokenAction - the proposer is the first approval of the new proposal
+func (keeper BaseKeeper) ProposeTokenAction(ctx sdk.Context, symbol string, proposer sdk.AccAddress, action sdk.Msg) (types.TokenActionProposal, sdk.Error) {
+	group, found := keeper.GetOwnerGroup(ctx, symbol)
+	if !found {
+		return types.TokenActionProposal{}, types.ErrOwnerGroupNotFound(symbol)
+	}
+	if !group.IsMember(proposer) {
+		return types.TokenActionProposal{}, types.ErrNotOwnerGroupMember(proposer)
+	}
+
+	proposal := types.TokenActionProposal{
+		ID:        keeper.nextProposalID(ctx),
+		Symbol:    symbol,
+		Proposer:  proposer,
+		Action:    action,
+		Approvals: []sdk.AccAddress{proposer},
+	}
+	keeper.setTokenActionProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// ApproveTokenAction - add an approval to the proposal, returns the updated proposal
+func (keeper BaseKeeper) ApproveTokenAction(ctx sdk.Context, symbol string, id uint64, approver sdk.AccAddress) (types.TokenActionProposal, sdk.Error) {
+	group, found := keeper.GetOwnerGroup(ctx, symbol)
+	if !found {
+		return types.TokenActionProposal{}, types.ErrOwnerGroupNotFound(symbol)
+	}
+	if !group.IsMember(approver) {
+		return types.TokenActionProposal{}, types.ErrNotOwnerGroupMember(approver)
+	}
+	proposal, found := keeper.GetTokenActionProposal(ctx, symbol, id)
+	if !found {
+		return types.TokenActionProposal{}, types.ErrProposalNotFound(symbol, id)
+	}
+	if proposal.HasApproved(approver) {
+		return types.TokenActionProposal{}, types.ErrDuplicateApproval(approver)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, approver)
+	keeper.setTokenActionProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// IsProposalApproved - check whether the proposal has got enough approvals from the current group
+func (keeper BaseKeeper) IsProposalApproved(ctx sdk.Context, proposal types.TokenActionProposal) bool {
+	group, found := keeper.GetOwnerGroup(ctx, proposal.Symbol)
+	if !found {
+		return false
+	}
+	var count uint32
+	for _, approval := range proposal.Approvals {
+		if group.IsMember(approval) {
+			count++
+		}
+	}
+	return count >= group.Threshold
+}
+
+// DeleteTokenActionProposal - delete the proposal from store
+func (keeper BaseKeeper) DeleteTokenActionProposal(ctx sdk.Context, symbol string, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetProposalKey(symbol, id))
+}
+
+// ImportGenesisOwnerGroup - import an owner group from genesis.json
+func (keeper BaseKeeper) ImportGenesisOwnerGroup(ctx sdk.Context, group types.OwnerGroup) {
+	keeper.setOwnerGroup(ctx, group)
+}
+
+// ImportGenesisTokenActionProposal - import a pending proposal from genesis.json,
+// the ids of the following proposals are greater than it.
+func (keeper BaseKeeper) ImportGenesisTokenActionProposal(ctx sdk.Context, proposal types.TokenActionProposal) {
+	keeper.setTokenActionProposal(ctx, proposal)
+	store := ctx.KVStore(keeper.storeKey)
+	if proposal.ID >= keeper.peekProposalID(ctx) {
+		store.Set(types.ProposalIDKey, sdk.Uint64ToBigEndian(proposal.ID+1))
+	}
+}
+
+func (keeper BaseKeeper) setOwnerGroup(ctx sdk.Context, group types.OwnerGroup) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetOwnerGroupKey(group.Symbol), keeper.cdc.MustMarshalBinaryBare(group))
+}
+
+func (keeper BaseKeeper) setTokenActionProposal(ctx sdk.Context, proposal types.TokenActionProposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetProposalKey(proposal.Symbol, proposal.ID), keeper.cdc.MustMarshalBinaryBare(proposal))
+}
+
+// the proposal ids start from 1
+func (keeper BaseKeeper) peekProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (keeper BaseKeeper) nextProposalID(ctx sdk.Context) uint64 {
+	id := keeper.peekProposalID(ctx)
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ProposalIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
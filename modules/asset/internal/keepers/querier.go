@@ -19,14 +19,32 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParameters(ctx, keeper)
 		case types.QueryToken:
 			return queryToken(ctx, req, keeper)
+		case types.QueryTokenDisplay:
+			return queryTokenDisplay(ctx, req, keeper)
 		case types.QueryTokenList:
 			return queryAllTokenList(ctx, req, keeper)
 		case types.QueryWhitelist:
 			return queryWhitelist(ctx, req, keeper)
 		case types.QueryForbiddenAddr:
 			return queryForbiddenAddr(ctx, req, keeper)
+		case types.QueryOwnerGroup:
+			return queryOwnerGroup(ctx, req, keeper)
+		case types.QueryProposals:
+			return queryTokenActionProposals(ctx, req, keeper)
+		case types.QueryTokenRoles:
+			return queryTokenRoles(ctx, req, keeper)
+		case types.QueryTokenHolders:
+			return queryTokenHolders(ctx, req, keeper)
 		case types.QueryReservedSymbols:
 			return queryReservedSymbols()
+		case types.QuerySymbolAuctions:
+			return querySymbolAuctions(ctx, keeper)
+		case types.QueryReservations:
+			return querySymbolReservations(ctx, keeper)
+		case types.QueryTokenInfoHistory:
+			return queryTokenInfoHistory(ctx, req, keeper)
+		case types.QueryAttestations:
+			return queryTokenAttestations(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown asset query endpoint")
 		}
@@ -63,6 +81,25 @@ func queryToken(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]b
 	return bz, nil
 }
 
+func queryTokenDisplay(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	token := keeper.GetToken(ctx, params.Symbol)
+	if token == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewTokenDisplay(token))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryAllTokenList(ctx sdk.Context, req abci.RequestQuery, keeper TokenKeeper) ([]byte, sdk.Error) {
 	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetAllTokens(ctx))
 	if err != nil {
@@ -114,3 +151,128 @@ func queryReservedSymbols() ([]byte, sdk.Error) {
 
 	return bz, nil
 }
+
+func queryOwnerGroup(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	group, found := keeper.GetOwnerGroup(ctx, params.Symbol)
+	if !found {
+		return nil, types.ErrOwnerGroupNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, group)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenActionProposals(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	proposals := keeper.GetTokenActionProposals(ctx, params.Symbol)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, proposals)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenRoles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	roles := keeper.GetTokenRoles(ctx, params.Symbol)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, roles)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenHolders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenHoldersParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if !keeper.IsTokenExists(ctx, params.Symbol) {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+	if params.Limit <= 0 {
+		params.Limit = types.DefaultTokenHoldersLimit
+	}
+	if params.Limit > types.MaxTokenHoldersLimit {
+		params.Limit = types.MaxTokenHoldersLimit
+	}
+
+	holders := keeper.GetTokenHolders(ctx, params.Symbol, params.After, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, holders)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolAuctions(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetSymbolAuctions(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySymbolReservations(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetSymbolReservations(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenInfoHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetTokenInfoHistory(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryTokenAttestations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if keeper.GetToken(ctx, params.Symbol) == nil {
+		return nil, types.ErrTokenNotFound(params.Symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetTokenAttestations(ctx, params.Symbol))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -1,6 +1,7 @@
 package keepers_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/keepers"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 func Test_queryParams(t *testing.T) {
@@ -77,6 +79,35 @@ func Test_queryToken(t *testing.T) {
 
 }
 
+func Test_queryTokenDisplay(t *testing.T) {
+	input := createTestInput()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTokenDisplay),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryAssetParams("abc")),
+	}
+	path0 := []string{types.QueryTokenDisplay}
+	query := keepers.NewQuerier(input.tk)
+
+	// no token
+	res, err := query(input.ctx, path0, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	token, err := types.NewToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	require.NoError(t, token.SetDecimals(2))
+	require.NoError(t, token.SetDisplayUnits([]types.DisplayUnit{{Denom: "ABC", Exponent: 2}}))
+	require.NoError(t, input.tk.SetToken(input.ctx, token))
+
+	res, err = query(input.ctx, path0, req)
+	require.NoError(t, err)
+	var display types.TokenDisplay
+	input.cdc.MustUnmarshalJSON(res, &display)
+	require.Equal(t, "ABC", display.DisplayDenom)
+	require.Equal(t, "21", display.TotalSupply)
+}
+
 func Test_queryAllTokenList(t *testing.T) {
 	input := createTestInput()
 	req := abci.RequestQuery{
@@ -213,6 +244,82 @@ func Test_queryReservedSymbols(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func Test_queryTokenHolders(t *testing.T) {
+	input := createTestInput()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTokenHolders),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryTokenHoldersParams("abc", nil, 2)),
+	}
+	path0 := []string{types.QueryTokenHolders}
+	query := keepers.NewQuerier(input.tk)
+
+	// no token
+	res, err := query(input.ctx, path0, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	token, err := types.NewToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", types.TestIdentityString)
+	require.NoError(t, err)
+	err = input.tk.SetToken(input.ctx, token)
+	require.NoError(t, err)
+
+	addrs := mockAddrList()
+	for _, addr := range addrs {
+		err = input.bkx.AddCoins(input.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("abc", 300)))
+		require.NoError(t, err)
+	}
+	err = input.bkx.FreezeCoins(input.ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("abc", 100)))
+	require.NoError(t, err)
+	input.bkx.MockAddLockedCoins(input.ctx, addrs[0], authx.LockedCoins{authx.NewLockedCoin("abc", sdk.NewInt(50), 1)})
+
+	var holders types.TokenHolders
+	res, err = query(input.ctx, path0, req)
+	require.NoError(t, err)
+	input.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, 2, len(holders.Holders))
+	firstPage := holders.Holders
+	last := firstPage[1].Address
+
+	// the next page starts after the last holder of the previous page
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenHoldersParams("abc", last, 2))
+	res, err = query(input.ctx, path0, req)
+	require.NoError(t, err)
+	holders = types.TokenHolders{}
+	input.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, last, holders.After)
+	require.Equal(t, 1, len(holders.Holders))
+	require.True(t, bytes.Compare(last, holders.Holders[0].Address) < 0)
+	for _, holder := range firstPage {
+		require.False(t, holder.Address.Equals(holders.Holders[0].Address))
+	}
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenHoldersParams("abc", holders.Holders[0].Address, 2))
+	res, err = query(input.ctx, path0, req)
+	require.NoError(t, err)
+	holders = types.TokenHolders{}
+	input.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, 0, len(holders.Holders))
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryTokenHoldersParams("abc", nil, 0))
+	res, err = query(input.ctx, path0, req)
+	require.NoError(t, err)
+	holders = types.TokenHolders{}
+	input.cdc.MustUnmarshalJSON(res, &holders)
+	require.Equal(t, types.DefaultTokenHoldersLimit, holders.Limit)
+	require.Equal(t, 3, len(holders.Holders))
+	for _, holder := range holders.Holders {
+		if holder.Address.Equals(addrs[0]) {
+			require.Equal(t, sdk.NewInt(200), holder.Liquid)
+			require.Equal(t, sdk.NewInt(100), holder.Frozen)
+			require.Equal(t, sdk.NewInt(50), holder.Locked)
+		} else {
+			require.Equal(t, sdk.NewInt(300), holder.Liquid)
+			require.True(t, holder.Frozen.IsZero())
+		}
+	}
+}
+
 func Test_queryDefault(t *testing.T) {
 	input := createTestInput()
 	req := abci.RequestQuery{
@@ -0,0 +1,114 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// GetTokenRole - return the role granted to grantee
+func (keeper BaseTokenKeeper) GetTokenRole(ctx sdk.Context, symbol, role string, grantee sdk.AccAddress) (tokenRole types.TokenRole, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GetTokenRoleKey(symbol, role, grantee))
+	if bz == nil {
+		return tokenRole, false
+	}
+	keeper.cdc.MustUnmarshalBinaryBare(bz, &tokenRole)
+	return tokenRole, true
+}
+
+// GetTokenRoles - returns the roles granted by token owner, all tokens if symbol is empty
+func (keeper BaseTokenKeeper) GetTokenRoles(ctx sdk.Context, symbol string) []types.TokenRole {
+	prefix := types.TokenRoleKey
+	if len(symbol) != 0 {
+		prefix = types.GetTokenRoleKeyPrefix(symbol)
+	}
+	roles := make([]types.TokenRole, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tokenRole types.TokenRole
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &tokenRole)
+		roles = append(roles, tokenRole)
+	}
+	return roles
+}
+
+// GrantTokenRole - grant a role to grantee, the previous grant of the same role is replaced.
+// The owner itself can't be granted a role, which would let it act alone with an owner group.
+func (keeper BaseKeeper) GrantTokenRole(ctx sdk.Context, symbol string, owner, grantee sdk.AccAddress, role string, allowance sdk.Int) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+	if grantee.Equals(owner) {
+		return types.ErrTokenRoleGrantedToOwner(role)
+	}
+
+	tokenRole := types.NewTokenRole(symbol, role, grantee, allowance)
+	if err := tokenRole.Validate(); err != nil {
+		return err
+	}
+	keeper.setTokenRole(ctx, tokenRole)
+	return nil
+}
+
+// RevokeTokenRole - revoke a role granted to grantee
+func (keeper BaseKeeper) RevokeTokenRole(ctx sdk.Context, symbol string, owner, grantee sdk.AccAddress, role string) sdk.Error {
+	if _, err := keeper.checkPrecondition(ctx, symbol, owner); err != nil {
+		return err
+	}
+
+	if _, found := keeper.GetTokenRole(ctx, symbol, role, grantee); !found {
+		return types.ErrTokenRoleNotFound(role, grantee)
+	}
+	keeper.removeTokenRole(ctx, symbol, role, grantee)
+	return nil
+}
+
+// revokeTokenRoles - revoke the roles of token, only those granted to grantee if it is not empty
+func (keeper BaseKeeper) revokeTokenRoles(ctx sdk.Context, symbol string, grantee sdk.AccAddress) {
+	for _, tokenRole := range keeper.GetTokenRoles(ctx, symbol) {
+		if grantee.Empty() || tokenRole.Grantee.Equals(grantee) {
+			keeper.removeTokenRole(ctx, symbol, tokenRole.Role, tokenRole.Grantee)
+		}
+	}
+}
+
+// UseMintAllowance - deduct amount from the allowance of minter, the role is removed once the allowance is used up
+func (keeper BaseKeeper) UseMintAllowance(ctx sdk.Context, symbol string, minter sdk.AccAddress, amount sdk.Int) sdk.Error {
+	tokenRole, found := keeper.GetTokenRole(ctx, symbol, types.RoleMinter, minter)
+	if !found {
+		return types.ErrTokenRoleNotFound(types.RoleMinter, minter)
+	}
+	if tokenRole.IsUnlimited() {
+		return nil
+	}
+	if amount.GT(tokenRole.Allowance) {
+		return types.ErrMintAllowanceExceeded(tokenRole.Allowance, amount)
+	}
+
+	tokenRole.Allowance = tokenRole.Allowance.Sub(amount)
+	if tokenRole.Allowance.IsZero() {
+		keeper.removeTokenRole(ctx, symbol, types.RoleMinter, minter)
+		return nil
+	}
+	keeper.setTokenRole(ctx, tokenRole)
+	return nil
+}
+
+// ImportGenesisTokenRole - import a granted role from genesis.json
+func (keeper BaseKeeper) ImportGenesisTokenRole(ctx sdk.Context, tokenRole types.TokenRole) {
+	keeper.setTokenRole(ctx, tokenRole)
+}
+
+func (keeper BaseKeeper) setTokenRole(ctx sdk.Context, tokenRole types.TokenRole) {
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.GetTokenRoleKey(tokenRole.Symbol, tokenRole.Role, tokenRole.Grantee)
+	store.Set(key, keeper.cdc.MustMarshalBinaryBare(tokenRole))
+}
+
+func (keeper BaseKeeper) removeTokenRole(ctx sdk.Context, symbol, role string, grantee sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetTokenRoleKey(symbol, role, grantee))
+}
@@ -79,8 +79,8 @@ func createTestInput() testInput {
 	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
 	axk := authx.NewKeeper(cdc, keyAuthx, pk.Subspace(authx.DefaultParamspace), sk, ak, bk, "")
 	ask := keepers.NewBaseTokenKeeper(cdc, keyAsset)
-	bkx := bankx.NewKeeper(pk.Subspace(bankx.DefaultParamspace), axk, bk, ak, ask, sk, msgqueue.NewProducer(nil))
-	tk := keepers.NewBaseKeeper(cdc, keyAsset, pk.Subspace(types.DefaultParamspace), bkx, sk)
+	bkx := bankx.NewKeeper(cdc, sdk.NewKVStoreKey(bankx.StoreKey), pk.Subspace(bankx.DefaultParamspace), axk, bk, ak, keyAuth, ask, sk, msgqueue.NewProducer(nil))
+	tk := keepers.NewBaseKeeper(cdc, keyAsset, pk.Subspace(types.DefaultParamspace), bkx, sk, sk, msgqueue.NewProducer(nil))
 
 	tk.SetParams(ctx, types.DefaultParams())
 
@@ -105,6 +105,7 @@ func makeTestCodec() *codec.Codec {
 	cdc.RegisterInterface((*exported.SupplyI)(nil), nil)
 	cdc.RegisterConcrete(&supply.Supply{}, "test/supply/supply", nil)
 	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
 
 	return cdc
 }
@@ -0,0 +1,127 @@
+package keepers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+)
+
+// GetTokenInfoHistory - returns the info versions of token in version order, all tokens if symbol is empty
+func (keeper BaseTokenKeeper) GetTokenInfoHistory(ctx sdk.Context, symbol string) []types.TokenInfoVersion {
+	prefix := types.TokenInfoHistoryKey
+	if len(symbol) != 0 {
+		prefix = types.GetTokenInfoHistoryKeyPrefix(symbol)
+	}
+	history := make([]types.TokenInfoVersion, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var version types.TokenInfoVersion
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &version)
+		history = append(history, version)
+	}
+	return history
+}
+
+// GetTokenAttestations - returns the attestations on token, all tokens if symbol is empty
+func (keeper BaseTokenKeeper) GetTokenAttestations(ctx sdk.Context, symbol string) []types.TokenAttestation {
+	prefix := types.AttestationKey
+	if len(symbol) != 0 {
+		prefix = types.GetAttestationKeyPrefix(symbol)
+	}
+	attestations := make([]types.TokenAttestation, 0)
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var attestation types.TokenAttestation
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+	return attestations
+}
+
+// AttestToken - attach the claim of a registered verifier to token, an empty claim revokes the attestation
+func (keeper BaseKeeper) AttestToken(ctx sdk.Context, symbol string, verifier sdk.AccAddress, claim string) sdk.Error {
+	if !keeper.GetParams(ctx).IsTokenVerifier(verifier) {
+		return types.ErrNotTokenVerifier(verifier)
+	}
+	if !keeper.IsTokenExists(ctx, symbol) {
+		return types.ErrTokenNotFound(symbol)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	key := types.GetAttestationKey(symbol, verifier)
+	if len(claim) == 0 {
+		if !store.Has(key) {
+			return types.ErrInvalidAttestation("no attestation to revoke")
+		}
+		store.Delete(key)
+		return nil
+	}
+
+	attestation := types.TokenAttestation{
+		Symbol:   symbol,
+		Verifier: verifier,
+		Claim:    claim,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockHeader().Time.Unix(),
+	}
+	if err := attestation.Validate(); err != nil {
+		return err
+	}
+	keeper.setTokenAttestation(ctx, attestation)
+	return nil
+}
+
+// ImportGenesisTokenInfoVersion - import an info version from genesis.json
+func (keeper BaseKeeper) ImportGenesisTokenInfoVersion(ctx sdk.Context, version types.TokenInfoVersion) {
+	keeper.setTokenInfoVersion(ctx, version)
+}
+
+// SeedGenesisTokenInfo - record the current info of a token imported from genesis.json,
+// so the original info of a token without history is kept when it is modified later
+func (keeper BaseKeeper) SeedGenesisTokenInfo(ctx sdk.Context, token types.Token) {
+	keeper.recordTokenInfo(ctx, token)
+}
+
+// ImportGenesisTokenAttestation - import an attestation from genesis.json
+func (keeper BaseKeeper) ImportGenesisTokenAttestation(ctx sdk.Context, attestation types.TokenAttestation) {
+	keeper.setTokenAttestation(ctx, attestation)
+}
+
+// recordTokenInfo - record a new version if the info of token differs from the latest version
+func (keeper BaseKeeper) recordTokenInfo(ctx sdk.Context, token types.Token) {
+	var latest types.TokenInfoVersion
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.GetTokenInfoHistoryKeyPrefix(token.GetSymbol()))
+	if iter.Valid() {
+		keeper.cdc.MustUnmarshalBinaryBare(iter.Value(), &latest)
+	}
+	iter.Close()
+	if latest.Version != 0 && latest.IsSameInfo(token) {
+		return
+	}
+
+	keeper.setTokenInfoVersion(ctx, types.TokenInfoVersion{
+		Symbol:      token.GetSymbol(),
+		Version:     latest.Version + 1,
+		Height:      ctx.BlockHeight(),
+		Time:        ctx.BlockHeader().Time.Unix(),
+		Name:        token.GetName(),
+		URL:         token.GetURL(),
+		Description: token.GetDescription(),
+		Identity:    token.GetIdentity(),
+	})
+}
+
+func (keeper BaseKeeper) setTokenInfoVersion(ctx sdk.Context, version types.TokenInfoVersion) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetTokenInfoVersionKey(version.Symbol, version.Version), keeper.cdc.MustMarshalBinaryBare(version))
+}
+
+func (keeper BaseKeeper) setTokenAttestation(ctx sdk.Context, attestation types.TokenAttestation) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetAttestationKey(attestation.Symbol, attestation.Verifier), keeper.cdc.MustMarshalBinaryBare(attestation))
+}
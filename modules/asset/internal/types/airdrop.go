@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxAirdropRecipients = 5000
+
+	// AirdropInfoKey is the msgqueue key of the airdrop summary
+	AirdropInfoKey = "airdrop"
+)
+
+// AirdropInfo - the summary of an airdrop sent to msgqueue, the recipients are not included
+// since they can be thousands, which can be found in the tx.
+type AirdropInfo struct {
+	Symbol            string         `json:"symbol"`
+	Owner             sdk.AccAddress `json:"owner"`
+	RecipientCount    int            `json:"recipient_count"`
+	TotalAmount       sdk.Int        `json:"total_amount"`
+	FreshAccountCount int            `json:"fresh_account_count"`
+	ActivationFee     sdk.Coins      `json:"activation_fee"`
+	Height            int64          `json:"height"`
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MinAuctionSymbolLength = 2
+	MaxAuctionSymbolLength = 4
+	MaxSymbolAuctionBids   = 100
+	MaxSymbolBidSaltLength = 64
+
+	// SymbolReservationPeriod - seconds the winner of an auction has to issue the symbol
+	SymbolReservationPeriod = 30 * 24 * 3600
+
+	// SymbolAuctionResultKey is the msgqueue key of the settled symbol auctions
+	SymbolAuctionResultKey = "symbol_auction_settled"
+)
+
+// IsAuctionSymbol - the short symbols which can only be issued by the winners of
+// the symbol auctions when the auction is enabled, reserved and suffix symbols are excluded
+func IsAuctionSymbol(symbol string) bool {
+	return len(symbol) >= MinAuctionSymbolLength && len(symbol) <= MaxAuctionSymbolLength &&
+		!IsReservedSymbol(symbol) && !IsSuffixSymbol(symbol)
+}
+
+// SealSymbolBid - the sealed bid is the hash of the bid, which is revealed after the bidding phase
+func SealSymbolBid(symbol string, bidder sdk.AccAddress, amount sdk.Int, salt string) []byte {
+	return tmhash.Sum([]byte(fmt.Sprintf("%s:%s:%s:%s", symbol, bidder, amount, salt)))
+}
+
+// SymbolBid - a sealed bid of the symbol auction, the deposit in CET must cover the bid
+type SymbolBid struct {
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	SealedBid []byte         `json:"sealed_bid" yaml:"sealed_bid"`
+	Deposit   sdk.Int        `json:"deposit" yaml:"deposit"`
+	Amount    sdk.Int        `json:"amount" yaml:"amount"` // Zero until revealed
+	Revealed  bool           `json:"revealed" yaml:"revealed"`
+}
+
+// SymbolAuction - the auction is opened by the first bid, bids are accepted before BidEndTime,
+// revealed before RevealEndTime and then settled in EndBlocker.
+type SymbolAuction struct {
+	Symbol        string      `json:"symbol" yaml:"symbol"`
+	BidEndTime    int64       `json:"bid_end_time" yaml:"bid_end_time"`
+	RevealEndTime int64       `json:"reveal_end_time" yaml:"reveal_end_time"`
+	Bids          []SymbolBid `json:"bids" yaml:"bids"`
+}
+
+func NewSymbolAuction(symbol string, bidEndTime, revealEndTime int64) SymbolAuction {
+	return SymbolAuction{
+		Symbol:        symbol,
+		BidEndTime:    bidEndTime,
+		RevealEndTime: revealEndTime,
+		Bids:          []SymbolBid{},
+	}
+}
+
+// GetBid - returns the index of the bid of bidder, -1 if not found
+func (a SymbolAuction) GetBid(bidder sdk.AccAddress) int {
+	for i, bid := range a.Bids {
+		if bid.Bidder.Equals(bidder) {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetWinner - returns the index of the highest revealed bid, the earlier bid wins a tie,
+// -1 if no bid is revealed
+func (a SymbolAuction) GetWinner() int {
+	winner := -1
+	for i, bid := range a.Bids {
+		if bid.Revealed && (winner < 0 || bid.Amount.GT(a.Bids[winner].Amount)) {
+			winner = i
+		}
+	}
+	return winner
+}
+
+// SymbolReservation - the symbol won in an auction, which can only be issued by Owner without the issue fee
+// before ExpireTime, after which the symbol can be auctioned again
+type SymbolReservation struct {
+	Symbol     string         `json:"symbol" yaml:"symbol"`
+	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
+	Price      sdk.Int        `json:"price" yaml:"price"`
+	ExpireTime int64          `json:"expire_time" yaml:"expire_time"`
+}
+
+// SymbolAuctionResult - sent to msgqueue when a symbol auction is settled, Winner is empty if no bid is revealed
+type SymbolAuctionResult struct {
+	Symbol    string         `json:"symbol"`
+	Winner    sdk.AccAddress `json:"winner"`
+	Price     sdk.Int        `json:"price"`
+	BidCount  int            `json:"bid_count"`
+	Forfeited sdk.Int        `json:"forfeited"` // Deposits of the unrevealed bids
+	Height    int64          `json:"height"`
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ModuleCdc wide codec
@@ -9,6 +10,8 @@ var ModuleCdc *codec.Codec
 
 func init() {
 	ModuleCdc = codec.New()
+	// proposals of the owner groups carry the actions as sdk.Msg
+	ModuleCdc.RegisterInterface((*sdk.Msg)(nil), nil)
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
@@ -30,4 +33,15 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgForbidAddr{}, "asset/MsgForbidAddr", nil)
 	cdc.RegisterConcrete(MsgUnForbidAddr{}, "asset/MsgUnForbidAddr", nil)
 	cdc.RegisterConcrete(MsgModifyTokenInfo{}, "asset/MsgModifyTokenInfo", nil)
+	cdc.RegisterConcrete(MsgSetOwnerGroup{}, "asset/MsgSetOwnerGroup", nil)
+	cdc.RegisterConcrete(MsgProposeTokenAction{}, "asset/MsgProposeTokenAction", nil)
+	cdc.RegisterConcrete(MsgApproveTokenAction{}, "asset/MsgApproveTokenAction", nil)
+	cdc.RegisterConcrete(MsgGrantTokenRole{}, "asset/MsgGrantTokenRole", nil)
+	cdc.RegisterConcrete(MsgRevokeTokenRole{}, "asset/MsgRevokeTokenRole", nil)
+	cdc.RegisterConcrete(MsgSetMintPolicy{}, "asset/MsgSetMintPolicy", nil)
+	cdc.RegisterConcrete(MsgAirdrop{}, "asset/MsgAirdrop", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "asset/MsgClawback", nil)
+	cdc.RegisterConcrete(MsgBidSymbol{}, "asset/MsgBidSymbol", nil)
+	cdc.RegisterConcrete(MsgRevealSymbolBid{}, "asset/MsgRevealSymbolBid", nil)
+	cdc.RegisterConcrete(MsgAttestToken{}, "asset/MsgAttestToken", nil)
 }
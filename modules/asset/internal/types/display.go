@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DisplayUnit - a unit used to display token amounts, an amount of the unit
+// is the raw amount divided by 10^Exponent.
+type DisplayUnit struct {
+	Denom    string `json:"denom" yaml:"denom"`
+	Exponent uint8  `json:"exponent" yaml:"exponent"`
+}
+
+//nolint
+var (
+	displayDenomRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,15}$`)
+)
+
+// ValidateDisplayUnits - denoms and exponents must be unique, exponents can't exceed the token decimals
+func ValidateDisplayUnits(units []DisplayUnit, decimals uint8) sdk.Error {
+	denoms := make(map[string]bool, len(units))
+	exponents := make(map[uint8]bool, len(units))
+	for _, unit := range units {
+		if !displayDenomRegex.MatchString(unit.Denom) || unit.Exponent > decimals ||
+			denoms[unit.Denom] || exponents[unit.Exponent] {
+			return ErrInvalidTokenDisplayUnits(DisplayUnitsString(units))
+		}
+		denoms[unit.Denom] = true
+		exponents[unit.Exponent] = true
+	}
+	return nil
+}
+
+// ParseDisplayUnits - parse units like "mabc:5,abc:8", an empty string means no unit
+func ParseDisplayUnits(s string) ([]DisplayUnit, sdk.Error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var units []DisplayUnit
+	for _, str := range strings.Split(s, ",") {
+		split := strings.Split(strings.TrimSpace(str), ":")
+		if len(split) != 2 {
+			return nil, ErrInvalidTokenDisplayUnits(s)
+		}
+		exponent, err := strconv.ParseUint(split[1], 10, 8)
+		if err != nil {
+			return nil, ErrInvalidTokenDisplayUnits(s)
+		}
+		units = append(units, DisplayUnit{Denom: split[0], Exponent: uint8(exponent)})
+	}
+	return units, nil
+}
+
+// DisplayUnitsString - the inverse of ParseDisplayUnits
+func DisplayUnitsString(units []DisplayUnit) string {
+	strs := make([]string, len(units))
+	for i, unit := range units {
+		strs[i] = fmt.Sprintf("%s:%d", unit.Denom, unit.Exponent)
+	}
+	return strings.Join(strs, ",")
+}
+
+// ParseDecimals - parse the decimals of token info
+func ParseDecimals(s string) (uint8, sdk.Error) {
+	decimals, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || decimals > MaxTokenDecimals {
+		return 0, ErrInvalidTokenDecimals(s)
+	}
+	return uint8(decimals), nil
+}
+
+// FormatAmount - format the raw amount with the decimals, trailing zeros are trimmed
+func FormatAmount(amt sdk.Int, decimals uint8) string {
+	s := sdk.NewDecFromBigIntWithPrec(amt.BigInt(), int64(decimals)).String()
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
+
+// TokenDisplay - the amounts of a token formatted with its decimals
+type TokenDisplay struct {
+	Symbol       string        `json:"symbol" yaml:"symbol"`
+	Decimals     uint8         `json:"decimals" yaml:"decimals"`
+	DisplayDenom string        `json:"display_denom" yaml:"display_denom"`
+	DisplayUnits []DisplayUnit `json:"display_units" yaml:"display_units"`
+	TotalSupply  string        `json:"total_supply" yaml:"total_supply"`
+	SendLock     string        `json:"send_lock" yaml:"send_lock"`
+	TotalBurn    string        `json:"total_burn" yaml:"total_burn"`
+	TotalMint    string        `json:"total_mint" yaml:"total_mint"`
+}
+
+// NewTokenDisplay - the display denom is the unit whose exponent equals the decimals, or the symbol
+func NewTokenDisplay(token Token) TokenDisplay {
+	decimals := token.GetDecimals()
+	denom := token.GetSymbol()
+	for _, unit := range token.GetDisplayUnits() {
+		if unit.Exponent == decimals {
+			denom = unit.Denom
+		}
+	}
+	return TokenDisplay{
+		Symbol:       token.GetSymbol(),
+		Decimals:     decimals,
+		DisplayDenom: denom,
+		DisplayUnits: token.GetDisplayUnits(),
+		TotalSupply:  FormatAmount(token.GetTotalSupply(), decimals),
+		SendLock:     FormatAmount(token.GetSendLock(), decimals),
+		TotalBurn:    FormatAmount(token.GetTotalBurn(), decimals),
+		TotalMint:    FormatAmount(token.GetTotalMint(), decimals),
+	}
+}
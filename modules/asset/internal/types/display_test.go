@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseDisplayUnits(t *testing.T) {
+	units, err := ParseDisplayUnits("mabc:5, abc:8")
+	require.NoError(t, err)
+	require.Equal(t, []DisplayUnit{{"mabc", 5}, {"abc", 8}}, units)
+	require.Equal(t, "mabc:5,abc:8", DisplayUnitsString(units))
+
+	units, err = ParseDisplayUnits("")
+	require.NoError(t, err)
+	require.Nil(t, units)
+
+	_, err = ParseDisplayUnits("abc")
+	require.Error(t, err)
+	_, err = ParseDisplayUnits("abc:256")
+	require.Error(t, err)
+
+	require.NoError(t, ValidateDisplayUnits([]DisplayUnit{{"mabc", 5}, {"abc", 8}}, 8))
+	require.Error(t, ValidateDisplayUnits([]DisplayUnit{{"mabc", 5}, {"abc", 8}}, 6))
+	require.Error(t, ValidateDisplayUnits([]DisplayUnit{{"abc", 5}, {"abc", 8}}, 8))
+	require.Error(t, ValidateDisplayUnits([]DisplayUnit{{"mabc", 8}, {"abc", 8}}, 8))
+	require.Error(t, ValidateDisplayUnits([]DisplayUnit{{"m-abc", 5}}, 8))
+}
+
+func TestFormatAmount(t *testing.T) {
+	require.Equal(t, "21000000", FormatAmount(sdk.NewInt(2100000000000000), 8))
+	require.Equal(t, "1.23456789", FormatAmount(sdk.NewInt(123456789), 8))
+	require.Equal(t, "0.5", FormatAmount(sdk.NewInt(500), 3))
+	require.Equal(t, "100", FormatAmount(sdk.NewInt(100), 0))
+	require.Equal(t, "0", FormatAmount(sdk.ZeroInt(), 18))
+}
+
+func TestNewTokenDisplay(t *testing.T) {
+	token, err := NewToken("ABC Token", "abc", sdk.NewInt(2100000), testAddr,
+		false, false, false, false, "", "", TestIdentityString)
+	require.NoError(t, err)
+	require.Equal(t, "abc", NewTokenDisplay(token).DisplayDenom)
+	require.Equal(t, "0.021", NewTokenDisplay(token).TotalSupply)
+
+	require.NoError(t, token.SetDecimals(6))
+	require.NoError(t, token.SetDisplayUnits([]DisplayUnit{{"uABC", 0}, {"ABC", 6}}))
+	display := NewTokenDisplay(token)
+	require.EqualValues(t, 6, display.Decimals)
+	require.Equal(t, "ABC", display.DisplayDenom)
+	require.Equal(t, "2.1", display.TotalSupply)
+	require.Equal(t, "0", display.TotalBurn)
+}
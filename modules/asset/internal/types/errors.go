@@ -41,6 +41,29 @@ const (
 	CodeTokenOwnerSelfForbidden      sdk.CodeType = 530
 	CodeInvalidTokenInfo             sdk.CodeType = 531
 	CodeTokenInfoSealed              sdk.CodeType = 532
+	CodeInvalidTokenDecimals         sdk.CodeType = 533
+	CodeInvalidTokenDisplayUnits     sdk.CodeType = 534
+	CodeInvalidOwnerGroup            sdk.CodeType = 535
+	CodeOwnerGroupNotFound           sdk.CodeType = 536
+	CodeNotOwnerGroupMember          sdk.CodeType = 537
+	CodeNeedOwnerGroupApproval       sdk.CodeType = 538
+	CodeInvalidTokenAction           sdk.CodeType = 539
+	CodeProposalNotFound             sdk.CodeType = 540
+	CodeDuplicateApproval            sdk.CodeType = 541
+	CodeInvalidTokenRole             sdk.CodeType = 542
+	CodeTokenRoleNotFound            sdk.CodeType = 543
+	CodeMintAllowanceExceeded        sdk.CodeType = 544
+	CodeInvalidMintPolicy            sdk.CodeType = 545
+	CodeMintPolicyViolated           sdk.CodeType = 546
+	CodeInvalidAirdrop               sdk.CodeType = 547
+	CodeInvalidForbidExpireTime      sdk.CodeType = 548
+	CodeClawbackNotSupported         sdk.CodeType = 549
+	CodeInvalidClawback              sdk.CodeType = 550
+	CodeInvalidSymbolAuction         sdk.CodeType = 551
+	CodeSymbolInAuction              sdk.CodeType = 552
+	CodeSymbolReserved               sdk.CodeType = 553
+	CodeInvalidAttestation           sdk.CodeType = 554
+	CodeNotTokenVerifier             sdk.CodeType = 555
 )
 
 func ErrInvalidTokenName(name string) sdk.Error {
@@ -182,3 +205,102 @@ func ErrCodeTokenInfoSealed(field string) sdk.Error {
 	msg := fmt.Sprintf("token %s sealed", field)
 	return sdk.NewError(CodeSpaceAsset, CodeTokenInfoSealed, msg)
 }
+func ErrInvalidTokenDecimals(decimals string) sdk.Error {
+	msg := fmt.Sprintf("invalid decimals %s : token decimals is limited to %d", decimals, MaxTokenDecimals)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenDecimals, msg)
+}
+func ErrInvalidTokenDisplayUnits(units string) sdk.Error {
+	msg := fmt.Sprintf("invalid display units %s : denoms and exponents must be unique and exponents can not exceed decimals", units)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenDisplayUnits, msg)
+}
+
+func ErrInvalidOwnerGroup(members int, threshold uint32) sdk.Error {
+	msg := fmt.Sprintf("invalid owner group : %d of %d members, members must be unique and limited to %d", threshold, members, MaxOwnerGroupMembers)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidOwnerGroup, msg)
+}
+func ErrOwnerGroupNotFound(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s has no owner group", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeOwnerGroupNotFound, msg)
+}
+func ErrNotOwnerGroupMember(addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is not a member of the owner group", addr.String())
+	return sdk.NewError(CodeSpaceAsset, CodeNotOwnerGroupMember, msg)
+}
+func ErrNeedOwnerGroupApproval(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s has an owner group, the operation must be proposed and approved by the group", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeNeedOwnerGroupApproval, msg)
+}
+func ErrInvalidTokenAction(action string) sdk.Error {
+	msg := fmt.Sprintf("invalid token action %s : only the owner operations of the token can be proposed", action)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenAction, msg)
+}
+func ErrProposalNotFound(symbol string, id uint64) sdk.Error {
+	msg := fmt.Sprintf("proposal %d of token %s is not in store", id, symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeProposalNotFound, msg)
+}
+func ErrDuplicateApproval(addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s has approved the proposal", addr.String())
+	return sdk.NewError(CodeSpaceAsset, CodeDuplicateApproval, msg)
+}
+func ErrInvalidTokenRole(role string) sdk.Error {
+	msg := fmt.Sprintf("invalid token role %s : only minter can have an allowance, roles are %s, %s and %s",
+		role, RoleMinter, RoleAddrForbidder, RoleInfoEditor)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenRole, msg)
+}
+func ErrTokenRoleGrantedToOwner(role string) sdk.Error {
+	msg := fmt.Sprintf("invalid token role %s : the token owner can not be granted a role", role)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidTokenRole, msg)
+}
+
+func ErrTokenRoleNotFound(role string, addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is not granted the role %s", addr.String(), role)
+	return sdk.NewError(CodeSpaceAsset, CodeTokenRoleNotFound, msg)
+}
+func ErrMintAllowanceExceeded(allowance, amt sdk.Int) sdk.Error {
+	msg := fmt.Sprintf("mint amount %s exceeds the allowance %s of minter", amt, allowance)
+	return sdk.NewError(CodeSpaceAsset, CodeMintAllowanceExceeded, msg)
+}
+func ErrInvalidMintPolicy(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid mint policy : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidMintPolicy, msg)
+}
+func ErrMintPolicyViolated(reason string) sdk.Error {
+	msg := fmt.Sprintf("mint is not allowed by the mint policy : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeMintPolicyViolated, msg)
+}
+func ErrInvalidAirdrop(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid airdrop : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidAirdrop, msg)
+}
+func ErrInvalidForbidExpireTime(expireTime int64) sdk.Error {
+	msg := fmt.Sprintf("forbid expire time %d must be later than the block time", expireTime)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidForbidExpireTime, msg)
+}
+func ErrClawbackNotSupported(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token %s do not support clawback", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeClawbackNotSupported, msg)
+}
+func ErrInvalidClawback(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid clawback : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidClawback, msg)
+}
+func ErrInvalidSymbolAuction(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid symbol auction : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidSymbolAuction, msg)
+}
+func ErrSymbolInAuction(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token symbol %s is in auction", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolInAuction, msg)
+}
+func ErrSymbolReserved(symbol string) sdk.Error {
+	msg := fmt.Sprintf("token symbol %s can only be issued by the winner of its auction", symbol)
+	return sdk.NewError(CodeSpaceAsset, CodeSymbolReserved, msg)
+}
+func ErrInvalidAttestation(reason string) sdk.Error {
+	msg := fmt.Sprintf("invalid attestation : %s", reason)
+	return sdk.NewError(CodeSpaceAsset, CodeInvalidAttestation, msg)
+}
+func ErrNotTokenVerifier(addr sdk.AccAddress) sdk.Error {
+	msg := fmt.Sprintf("%s is not a registered token verifier", addr)
+	return sdk.NewError(CodeSpaceAsset, CodeNotTokenVerifier, msg)
+}
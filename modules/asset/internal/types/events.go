@@ -14,6 +14,20 @@ const (
 	EventTypeForbidAddr           = "forbid_addr"
 	EventTypeUnForbidAddr         = "unforbid_addr"
 	EventTypeModifyTokenInfo      = "modify_token_info"
+	EventTypeSetOwnerGroup        = "set_owner_group"
+	EventTypeProposeTokenAction   = "propose_token_action"
+	EventTypeApproveTokenAction   = "approve_token_action"
+	EventTypeExecuteTokenAction   = "execute_token_action"
+	EventTypeGrantTokenRole       = "grant_token_role"
+	EventTypeRevokeTokenRole      = "revoke_token_role"
+	EventTypeSetMintPolicy        = "set_mint_policy"
+	EventTypeAirdrop              = "airdrop"
+	EventTypeClawback             = "clawback"
+	EventTypeBidSymbol            = "bid_symbol"
+	EventTypeRevealSymbolBid      = "reveal_symbol_bid"
+	EventTypeSettleSymbolAuction  = "settle_symbol_auction"
+	EventTypeExpireReservation    = "expire_symbol_reservation"
+	EventTypeAttestToken          = "attest_token"
 
 	AttributeKeySymbol        = "symbol"
 	AttributeKeyTokenOwner    = "owner"
@@ -23,4 +37,23 @@ const (
 	AttributeKeyURL           = "url"
 	AttributeKeyDescription   = "description"
 	AttributeKeyIdentity      = "identity"
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeyProposalID    = "proposal_id"
+	AttributeKeyActionType    = "action_type"
+	AttributeKeyApprovals     = "approvals"
+	AttributeKeyRole          = "role"
+	AttributeKeyGrantee       = "grantee"
+	AttributeKeyAllowance     = "allowance"
+	AttributeKeyMintPolicy    = "mint_policy"
+	AttributeKeyRecipients    = "recipients"
+	AttributeKeyExpireTime    = "expire_time"
+	AttributeKeyFrom          = "from"
+	AttributeKeyTo            = "to"
+	AttributeKeyMemo          = "memo"
+	AttributeKeyBidder        = "bidder"
+	AttributeKeyDeposit       = "deposit"
+	AttributeKeyWinner        = "winner"
+	AttributeKeyPrice         = "price"
+	AttributeKeyVerifier      = "verifier"
+	AttributeKeyClaim         = "claim"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 // Bankx Keeper will implement the interface
@@ -13,6 +14,16 @@ type ExpectedBankxKeeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	GetTotalCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	PreCheckFreshAccounts(ctx sdk.Context, outputs []bank.Output) []sdk.AccAddress
+	InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error
+	PayActivationFeeForFreshAccounts(ctx sdk.Context, payer sdk.AccAddress, addrs []sdk.AccAddress) (sdk.Coins, sdk.Error)
+	IterateTokenHolders(ctx sdk.Context, denom string, after sdk.AccAddress,
+		process func(addr sdk.AccAddress, liquid, frozen, locked sdk.Int) (stop bool))
+}
+
+// Supplyx Keeper will implement the interface, the burned coins go to the community pool
+type ExpectedSupplyxKeeper interface {
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
 }
 
 // Supply Keeper will implement the interface
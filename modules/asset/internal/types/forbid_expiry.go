@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ForbidExpiredInfoKey is the msgqueue key of the expired forbids
+	ForbidExpiredInfoKey = "forbid_expired"
+)
+
+// ForbidExpiry - a forbidden address which is unforbidden automatically at ExpireTime (unix seconds)
+type ForbidExpiry struct {
+	Symbol     string         `json:"symbol" yaml:"symbol"`
+	Address    sdk.AccAddress `json:"address" yaml:"address"`
+	ExpireTime int64          `json:"expire_time" yaml:"expire_time"`
+}
+
+func NewForbidExpiry(symbol string, addr sdk.AccAddress, expireTime int64) ForbidExpiry {
+	return ForbidExpiry{
+		Symbol:     symbol,
+		Address:    addr,
+		ExpireTime: expireTime,
+	}
+}
+
+// ForbidExpiredInfo - sent to msgqueue when the forbids of a token expire in EndBlocker
+type ForbidExpiredInfo struct {
+	Symbol    string           `json:"symbol"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+	Height    int64            `json:"height"`
+}
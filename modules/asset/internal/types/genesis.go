@@ -2,23 +2,45 @@ package types
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params             Params   `json:"params" yaml:"params"`
-	Tokens             []Token  `json:"tokens" yaml:"tokens"`
-	Whitelist          []string `json:"whitelist" yaml:"whitelist"`
-	ForbiddenAddresses []string `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	Params             Params                `json:"params" yaml:"params"`
+	Tokens             []Token               `json:"tokens" yaml:"tokens"`
+	Whitelist          []string              `json:"whitelist" yaml:"whitelist"`
+	ForbiddenAddresses []string              `json:"forbidden_addresses" yaml:"forbidden_addresses"`
+	OwnerGroups        []OwnerGroup          `json:"owner_groups" yaml:"owner_groups"`
+	Proposals          []TokenActionProposal `json:"proposals" yaml:"proposals"`
+	Roles              []TokenRole           `json:"roles" yaml:"roles"`
+	ForbidExpiries     []ForbidExpiry        `json:"forbid_expiries" yaml:"forbid_expiries"`
+	SymbolAuctions     []SymbolAuction       `json:"symbol_auctions" yaml:"symbol_auctions"`
+	SymbolReservations []SymbolReservation   `json:"symbol_reservations" yaml:"symbol_reservations"`
+	TokenInfoHistory   []TokenInfoVersion    `json:"token_info_history" yaml:"token_info_history"`
+	Attestations       []TokenAttestation    `json:"attestations" yaml:"attestations"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(params Params, tokens []Token, whitelist []string, forbiddenAddresses []string) GenesisState {
+func NewGenesisState(params Params, tokens []Token, whitelist []string, forbiddenAddresses []string,
+	ownerGroups []OwnerGroup, proposals []TokenActionProposal, roles []TokenRole, forbidExpiries []ForbidExpiry,
+	symbolAuctions []SymbolAuction, symbolReservations []SymbolReservation,
+	tokenInfoHistory []TokenInfoVersion, attestations []TokenAttestation) GenesisState {
 	return GenesisState{
 		Params:             params,
 		Tokens:             tokens,
 		Whitelist:          whitelist,
 		ForbiddenAddresses: forbiddenAddresses,
+		OwnerGroups:        ownerGroups,
+		Proposals:          proposals,
+		Roles:              roles,
+		ForbidExpiries:     forbidExpiries,
+		SymbolAuctions:     symbolAuctions,
+		SymbolReservations: symbolReservations,
+		TokenInfoHistory:   tokenInfoHistory,
+		Attestations:       attestations,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultParams(), []Token{}, []string{}, []string{})
+	return NewGenesisState(DefaultParams(), []Token{}, []string{}, []string{},
+		[]OwnerGroup{}, []TokenActionProposal{}, []TokenRole{}, []ForbidExpiry{},
+		[]SymbolAuction{}, []SymbolReservation{},
+		[]TokenInfoVersion{}, []TokenAttestation{})
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +20,9 @@ const (
 	QuerierRoute = ModuleName
 
 	DefaultParamspace = ModuleName
+
+	// Topic is the msgqueue topic of asset
+	Topic = ModuleName
 )
 
 var (
@@ -25,6 +30,22 @@ var (
 	TokenKey         = []byte{0x01}
 	WhitelistKey     = []byte{0x02}
 	ForbiddenAddrKey = []byte{0x03}
+	OwnerGroupKey    = []byte{0x04}
+	ProposalKey      = []byte{0x05}
+	ProposalIDKey    = []byte{0x06}
+	TokenRoleKey     = []byte{0x07}
+
+	ForbidExpiryKey      = []byte{0x08}
+	ForbidExpiryQueueKey = []byte{0x09}
+
+	SymbolAuctionKey      = []byte{0x0A}
+	SymbolAuctionQueueKey = []byte{0x0B}
+	SymbolReservationKey  = []byte{0x0C}
+
+	TokenInfoHistoryKey = []byte{0x0D}
+	AttestationKey      = []byte{0x0E}
+
+	SymbolReservationQueueKey = []byte{0x0F}
 )
 
 // GetTokenStoreKey - TokenKey | symbol
@@ -61,3 +82,99 @@ func GetForbiddenAddrKeyPrefix(symbol string) []byte {
 func GetForbiddenAddrKeyPrefixLength(symbol string) int {
 	return len(GetForbiddenAddrKeyPrefix(symbol))
 }
+
+// GetOwnerGroupKey - OwnerGroupKey | symbol
+func GetOwnerGroupKey(symbol string) []byte {
+	return append(OwnerGroupKey, symbol...)
+}
+
+// GetProposalKey - ProposalKey | Symbol | : | ProposalID
+func GetProposalKey(symbol string, id uint64) []byte {
+	return append(GetProposalKeyPrefix(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetProposalKeyPrefix - ProposalKey | Symbol | :
+func GetProposalKeyPrefix(symbol string) []byte {
+	return append(append(ProposalKey, symbol...), SeparateKey...)
+}
+
+// GetTokenRoleKey - TokenRoleKey | Symbol | : | Role | : | Grantee
+func GetTokenRoleKey(symbol, role string, grantee sdk.AccAddress) []byte {
+	return append(append(append(GetTokenRoleKeyPrefix(symbol), role...), SeparateKey...), grantee...)
+}
+
+// GetTokenRoleKeyPrefix - TokenRoleKey | Symbol | :
+func GetTokenRoleKeyPrefix(symbol string) []byte {
+	return append(append(TokenRoleKey, symbol...), SeparateKey...)
+}
+
+// GetForbidExpiryKey - ForbidExpiryKey | Symbol | : | AccAddress
+func GetForbidExpiryKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(append(append(ForbidExpiryKey, symbol...), SeparateKey...), addr...)
+}
+
+// GetForbidExpiryQueueKey - ForbidExpiryQueueKey | ExpireTime | Symbol | : | AccAddress
+func GetForbidExpiryQueueKey(expireTime int64, symbol string, addr sdk.AccAddress) []byte {
+	return append(append(append(GetForbidExpiryQueueTimeKey(expireTime), symbol...), SeparateKey...), addr...)
+}
+
+// GetForbidExpiryQueueTimeKey - ForbidExpiryQueueKey | ExpireTime
+func GetForbidExpiryQueueTimeKey(expireTime int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expireTime))
+	return append(ForbidExpiryQueueKey, bz...)
+}
+
+// GetSymbolAuctionKey - SymbolAuctionKey | Symbol
+func GetSymbolAuctionKey(symbol string) []byte {
+	return append(SymbolAuctionKey, symbol...)
+}
+
+// GetSymbolAuctionQueueKey - SymbolAuctionQueueKey | RevealEndTime | Symbol
+func GetSymbolAuctionQueueKey(revealEndTime int64, symbol string) []byte {
+	return append(GetSymbolAuctionQueueTimeKey(revealEndTime), symbol...)
+}
+
+// GetSymbolAuctionQueueTimeKey - SymbolAuctionQueueKey | RevealEndTime
+func GetSymbolAuctionQueueTimeKey(revealEndTime int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(revealEndTime))
+	return append(SymbolAuctionQueueKey, bz...)
+}
+
+// GetSymbolReservationKey - SymbolReservationKey | Symbol
+func GetSymbolReservationKey(symbol string) []byte {
+	return append(SymbolReservationKey, symbol...)
+}
+
+// GetSymbolReservationQueueKey - SymbolReservationQueueKey | ExpireTime | Symbol
+func GetSymbolReservationQueueKey(expireTime int64, symbol string) []byte {
+	return append(GetSymbolReservationQueueTimeKey(expireTime), symbol...)
+}
+
+// GetSymbolReservationQueueTimeKey - SymbolReservationQueueKey | ExpireTime
+func GetSymbolReservationQueueTimeKey(expireTime int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expireTime))
+	return append(SymbolReservationQueueKey, bz...)
+}
+
+// GetTokenInfoVersionKey - TokenInfoHistoryKey | Symbol | : | Version
+func GetTokenInfoVersionKey(symbol string, version uint64) []byte {
+	return append(GetTokenInfoHistoryKeyPrefix(symbol), sdk.Uint64ToBigEndian(version)...)
+}
+
+// GetTokenInfoHistoryKeyPrefix - TokenInfoHistoryKey | Symbol | :
+func GetTokenInfoHistoryKeyPrefix(symbol string) []byte {
+	return append(append(TokenInfoHistoryKey, symbol...), SeparateKey...)
+}
+
+// GetAttestationKey - AttestationKey | Symbol | : | Verifier
+func GetAttestationKey(symbol string, verifier sdk.AccAddress) []byte {
+	return append(GetAttestationKeyPrefix(symbol), verifier...)
+}
+
+// GetAttestationKeyPrefix - AttestationKey | Symbol | :
+func GetAttestationKeyPrefix(symbol string) []byte {
+	return append(append(AttestationKey, symbol...), SeparateKey...)
+}
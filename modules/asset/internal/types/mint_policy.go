@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintScheduleStep - the cumulative amount minted under the policy can't exceed
+// Amount from Time (unix seconds) until the next step.
+type MintScheduleStep struct {
+	Time   int64   `json:"time" yaml:"time"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// MintPolicy - limits on the minting of a token, a zero limit means no limit.
+// The policy can only be replaced by a tighter one, so the holders can rely on it.
+type MintPolicy struct {
+	MaxPerPeriod sdk.Int            `json:"max_per_period" yaml:"max_per_period"` // Max amount minted in a period
+	Period       int64              `json:"period" yaml:"period"`                 // Length of the period in seconds
+	SupplyCap    sdk.Int            `json:"supply_cap" yaml:"supply_cap"`         // Hard cap of the total supply
+	Schedule     []MintScheduleStep `json:"schedule" yaml:"schedule"`             // Cumulative amounts unlocked over time
+
+	PeriodStart  int64   `json:"period_start" yaml:"period_start"`   // Start time of the current period
+	PeriodMinted sdk.Int `json:"period_minted" yaml:"period_minted"` // Amount minted in the current period
+	TotalMinted  sdk.Int `json:"total_minted" yaml:"total_minted"`   // Amount minted under the policy
+}
+
+func NewMintPolicy(maxPerPeriod sdk.Int, period int64, supplyCap sdk.Int, schedule []MintScheduleStep) MintPolicy {
+	return MintPolicy{
+		MaxPerPeriod: maxPerPeriod,
+		Period:       period,
+		SupplyCap:    supplyCap,
+		Schedule:     schedule,
+		PeriodMinted: sdk.ZeroInt(),
+		TotalMinted:  sdk.ZeroInt(),
+	}
+}
+
+// ResetRecords - returns the policy without the minting records, which are kept by the keeper
+func (p MintPolicy) ResetRecords() MintPolicy {
+	p.PeriodStart = 0
+	p.PeriodMinted = sdk.ZeroInt()
+	p.TotalMinted = sdk.ZeroInt()
+	return p
+}
+
+func (p MintPolicy) Validate() sdk.Error {
+	if p.MaxPerPeriod.IsNegative() || p.Period < 0 || p.MaxPerPeriod.IsPositive() != (p.Period > 0) {
+		return ErrInvalidMintPolicy("max per period must go with a positive period")
+	}
+	if p.SupplyCap.IsNegative() || p.SupplyCap.GTE(MaxTokenAmountInt) {
+		return ErrInvalidMintPolicy("supply cap must be less than the max token amount")
+	}
+	for i, step := range p.Schedule {
+		if step.Amount.IsNegative() ||
+			(i > 0 && (step.Time <= p.Schedule[i-1].Time || step.Amount.LT(p.Schedule[i-1].Amount))) {
+			return ErrInvalidMintPolicy("schedule must be in time order with non-decreasing amounts")
+		}
+	}
+	if p.PeriodMinted.IsNegative() || p.TotalMinted.IsNegative() {
+		return ErrInvalidMintPolicy("negative minted amount")
+	}
+	return nil
+}
+
+// ScheduledAmount - the cumulative amount allowed at time, nothing can be minted before the first step
+func (p MintPolicy) ScheduledAmount(time int64) sdk.Int {
+	amount := sdk.ZeroInt()
+	for _, step := range p.Schedule {
+		if step.Time > time {
+			break
+		}
+		amount = step.Amount
+	}
+	return amount
+}
+
+// IsTighterThan - check whether p allows no more minting than old at any time
+func (p MintPolicy) IsTighterThan(old MintPolicy) bool {
+	if old.MaxPerPeriod.IsPositive() &&
+		(!p.MaxPerPeriod.IsPositive() || p.MaxPerPeriod.GT(old.MaxPerPeriod) || p.Period < old.Period) {
+		return false
+	}
+	if old.SupplyCap.IsPositive() && (!p.SupplyCap.IsPositive() || p.SupplyCap.GT(old.SupplyCap)) {
+		return false
+	}
+	if len(old.Schedule) != 0 {
+		if len(p.Schedule) == 0 {
+			return false
+		}
+		// both schedules are step functions, comparing them at all the steps is enough
+		for _, steps := range [][]MintScheduleStep{p.Schedule, old.Schedule} {
+			for _, step := range steps {
+				if p.ScheduledAmount(step.Time).GT(old.ScheduledAmount(step.Time)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// Mint - check the amount against the policy at time and record it
+func (p *MintPolicy) Mint(time int64, totalSupply, amount sdk.Int) sdk.Error {
+	if p.SupplyCap.IsPositive() && totalSupply.Add(amount).GT(p.SupplyCap) {
+		return ErrMintPolicyViolated(fmt.Sprintf("total supply would exceed the cap %s", p.SupplyCap))
+	}
+	if len(p.Schedule) != 0 && p.TotalMinted.Add(amount).GT(p.ScheduledAmount(time)) {
+		return ErrMintPolicyViolated(fmt.Sprintf("only %s can be minted till now", p.ScheduledAmount(time)))
+	}
+	if p.MaxPerPeriod.IsPositive() {
+		if time >= p.PeriodStart+p.Period {
+			p.PeriodStart = time
+			p.PeriodMinted = sdk.ZeroInt()
+		}
+		if p.PeriodMinted.Add(amount).GT(p.MaxPerPeriod) {
+			return ErrMintPolicyViolated(fmt.Sprintf("only %s can be minted in a period", p.MaxPerPeriod))
+		}
+		p.PeriodMinted = p.PeriodMinted.Add(amount)
+	}
+	p.TotalMinted = p.TotalMinted.Add(amount)
+	return nil
+}
+
+func (p MintPolicy) String() string {
+	return fmt.Sprintf("MintPolicy{%s per %ds, cap %s, schedule [%s]}",
+		p.MaxPerPeriod, p.Period, p.SupplyCap, MintScheduleString(p.Schedule))
+}
+
+// ParseMintSchedule - parse steps like "1577836800:1000,1609459200:2000", an empty string means no schedule
+func ParseMintSchedule(s string) ([]MintScheduleStep, sdk.Error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	var steps []MintScheduleStep
+	for _, str := range strings.Split(s, ",") {
+		split := strings.Split(strings.TrimSpace(str), ":")
+		if len(split) != 2 {
+			return nil, ErrInvalidMintPolicy("invalid schedule " + s)
+		}
+		time, err := strconv.ParseInt(split[0], 10, 64)
+		if err != nil {
+			return nil, ErrInvalidMintPolicy("invalid schedule " + s)
+		}
+		amount, ok := sdk.NewIntFromString(split[1])
+		if !ok {
+			return nil, ErrInvalidMintPolicy("invalid schedule " + s)
+		}
+		steps = append(steps, MintScheduleStep{Time: time, Amount: amount})
+	}
+	return steps, nil
+}
+
+// MintScheduleString - the inverse of ParseMintSchedule
+func MintScheduleString(steps []MintScheduleStep) string {
+	strs := make([]string, len(steps))
+	for i, step := range steps {
+		strs[i] = fmt.Sprintf("%d:%s", step.Time, step.Amount)
+	}
+	return strings.Join(strs, ",")
+}
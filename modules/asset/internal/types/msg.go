@@ -2,9 +2,14 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 // ensure Msg interface compliance at compile time
@@ -20,6 +25,17 @@ var (
 	_ sdk.Msg = &MsgForbidAddr{}
 	_ sdk.Msg = &MsgUnForbidAddr{}
 	_ sdk.Msg = &MsgModifyTokenInfo{}
+	_ sdk.Msg = &MsgSetOwnerGroup{}
+	_ sdk.Msg = &MsgProposeTokenAction{}
+	_ sdk.Msg = &MsgApproveTokenAction{}
+	_ sdk.Msg = &MsgGrantTokenRole{}
+	_ sdk.Msg = &MsgRevokeTokenRole{}
+	_ sdk.Msg = &MsgSetMintPolicy{}
+	_ sdk.Msg = &MsgAirdrop{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgBidSymbol{}
+	_ sdk.Msg = &MsgRevealSymbolBid{}
+	_ sdk.Msg = &MsgAttestToken{}
 )
 
 // MsgIssueToken
@@ -35,6 +51,12 @@ type MsgIssueToken struct {
 	URL              string         `json:"url" yaml:"url"`                             //URL of token website
 	Description      string         `json:"description" yaml:"description"`             //Description of token info
 	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
+
+	Decimals          string         `json:"decimals,omitempty" yaml:"decimals,omitempty"`                     // Decimal places of the display amount, empty if not set
+	DisplayUnits      []DisplayUnit  `json:"display_units,omitempty" yaml:"display_units,omitempty"`           // Units used to display amounts
+	MintPolicy        *MintPolicy    `json:"mint_policy,omitempty" yaml:"mint_policy,omitempty"`               // Limits on minting, only for mintable token
+	Clawbackable      bool           `json:"clawbackable,omitempty" yaml:"clawbackable,omitempty"`             // Whether the owner can take back tokens from forbidden addresses
+	ClawbackRecipient sdk.AccAddress `json:"clawback_recipient,omitempty" yaml:"clawback_recipient,omitempty"` // The recovery address of the clawed back tokens, only for clawbackable token
 }
 
 // NewMsgIssueToken
@@ -43,17 +65,17 @@ func NewMsgIssueToken(name string, symbol string, amt sdk.Int, owner sdk.AccAddr
 	url string, description string, identity string) MsgIssueToken {
 
 	return MsgIssueToken{
-		name,
-		symbol,
-		amt,
-		owner,
-		mintable,
-		burnable,
-		addrForbiddable,
-		tokenForbiddable,
-		url,
-		description,
-		identity,
+		Name:             name,
+		Symbol:           symbol,
+		TotalSupply:      amt,
+		Owner:            owner,
+		Mintable:         mintable,
+		Burnable:         burnable,
+		AddrForbiddable:  addrForbiddable,
+		TokenForbiddable: tokenForbiddable,
+		URL:              url,
+		Description:      description,
+		Identity:         identity,
 	}
 }
 
@@ -73,9 +95,33 @@ func (msg MsgIssueToken) Type() string {
 
 // ValidateBasic Implements Msg.
 func (msg MsgIssueToken) ValidateBasic() sdk.Error {
-	_, err := NewToken(msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
+	token, err := NewToken(msg.Name, msg.Symbol, msg.TotalSupply, msg.Owner,
 		msg.Mintable, msg.Burnable, msg.AddrForbiddable, msg.TokenForbiddable, msg.URL, msg.Description, msg.Identity)
-	return err
+	if err != nil {
+		return err
+	}
+	if msg.Decimals != "" {
+		decimals, err := ParseDecimals(msg.Decimals)
+		if err != nil {
+			return err
+		}
+		if err := token.SetDecimals(decimals); err != nil {
+			return err
+		}
+	}
+	if err := validateClawback(msg.Symbol, msg.Clawbackable, msg.AddrForbiddable, msg.ClawbackRecipient); err != nil {
+		return err
+	}
+	if msg.MintPolicy != nil {
+		if !msg.Mintable {
+			return ErrTokenMintNotSupported(msg.Symbol)
+		}
+		policy := msg.MintPolicy.ResetRecords()
+		if err := token.SetMintPolicy(&policy); err != nil {
+			return err
+		}
+	}
+	return token.SetDisplayUnits(msg.DisplayUnits)
 }
 
 // GetSignBytes Implements Msg.
@@ -470,11 +516,12 @@ func (msg MsgRemoveTokenWhitelist) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
 
-// MsgForbidAddr
+// MsgForbidAddr - the addresses are unforbidden automatically at ExpireTime (unix seconds) if it's positive
 type MsgForbidAddr struct {
-	Symbol    string           `json:"symbol" yaml:"symbol"`
-	OwnerAddr sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
-	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Symbol     string           `json:"symbol" yaml:"symbol"`
+	OwnerAddr  sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
+	Addresses  []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	ExpireTime int64            `json:"expire_time,omitempty" yaml:"expire_time,omitempty"`
 }
 
 func NewMsgForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.AccAddress) MsgForbidAddr {
@@ -482,6 +529,7 @@ func NewMsgForbidAddr(symbol string, owner sdk.AccAddress, addresses []sdk.AccAd
 		symbol,
 		owner,
 		addresses,
+		0,
 	}
 }
 
@@ -510,6 +558,9 @@ func (msg MsgForbidAddr) ValidateBasic() sdk.Error {
 	if len(msg.Addresses) == 0 {
 		return ErrNilForbiddenAddress()
 	}
+	if msg.ExpireTime < 0 {
+		return ErrInvalidForbidExpireTime(msg.ExpireTime)
+	}
 	for _, address := range msg.Addresses {
 		if bytes.Equal(address, msg.OwnerAddr) {
 			return ErrTokenOwnerSelfForbidden()
@@ -606,6 +657,9 @@ type MsgModifyTokenInfo struct {
 	Burnable         string         `json:"burnable" yaml:"burnable"`
 	AddrForbiddable  string         `json:"addr_forbiddable" yaml:"addr_forbiddable"`
 	TokenForbiddable string         `json:"token_forbiddable" yaml:"token_forbiddable"`
+	// decimals and display units can only be set once, empty means do not modify
+	Decimals     string `json:"decimals,omitempty" yaml:"decimals,omitempty"`
+	DisplayUnits string `json:"display_units,omitempty" yaml:"display_units,omitempty"`
 }
 
 func NewMsgModifyTokenInfo(symbol, url, description, identity string, owner sdk.AccAddress,
@@ -687,10 +741,38 @@ func (msg MsgModifyTokenInfo) ValidateBasic() sdk.Error {
 	if err := validateBoolField("TokenForbiddable", msg.TokenForbiddable); err != nil {
 		return err
 	}
+	if IsTokenInfoModified(msg.Decimals) {
+		decimals, err := ParseDecimals(msg.Decimals)
+		if err != nil {
+			return err
+		}
+		if err := tmpToken.SetDecimals(decimals); err != nil {
+			return err
+		}
+	}
+	if IsTokenInfoModified(msg.DisplayUnits) {
+		units, err := ParseDisplayUnits(msg.DisplayUnits)
+		if err != nil {
+			return err
+		}
+		if len(units) == 0 {
+			return ErrInvalidTokenDisplayUnits(msg.DisplayUnits)
+		}
+		// the exponents are checked against the decimals of the token by the keeper
+		if err := ValidateDisplayUnits(units, MaxTokenDecimals); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// IsTokenInfoModified - the fields added after MsgModifyTokenInfo was introduced
+// are also left unmodified if empty
+func IsTokenInfoModified(valStr string) bool {
+	return valStr != "" && valStr != DoNotModifyTokenInfo
+}
+
 func validateBoolField(fieldName, valStr string) sdk.Error {
 	if valStr != DoNotModifyTokenInfo {
 		if _, err := strconv.ParseBool(valStr); err != nil {
@@ -709,3 +791,641 @@ func (msg MsgModifyTokenInfo) GetSignBytes() []byte {
 func (msg MsgModifyTokenInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.OwnerAddress}
 }
+
+// MsgSetOwnerGroup - set the owner group of a token, the group is removed if Members is empty.
+// Once a token has an owner group, it can only be changed by a TokenActionProposal.
+type MsgSetOwnerGroup struct {
+	Symbol       string           `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
+	Members      []sdk.AccAddress `json:"members" yaml:"members"`
+	Threshold    uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewMsgSetOwnerGroup(symbol string, owner sdk.AccAddress, members []sdk.AccAddress, threshold uint32) MsgSetOwnerGroup {
+	return MsgSetOwnerGroup{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Members:      members,
+		Threshold:    threshold,
+	}
+}
+
+func (msg *MsgSetOwnerGroup) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetOwnerGroup) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetOwnerGroup) Type() string {
+	return "set_owner_group"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetOwnerGroup) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if len(msg.Members) == 0 && msg.Threshold == 0 {
+		return nil
+	}
+	return NewOwnerGroup(msg.Symbol, msg.Members, msg.Threshold).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetOwnerGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetOwnerGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgProposeTokenAction - propose an owner-only operation to the owner group of the token
+type MsgProposeTokenAction struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Action   sdk.Msg        `json:"action" yaml:"action"`
+}
+
+func NewMsgProposeTokenAction(symbol string, proposer sdk.AccAddress, action sdk.Msg) MsgProposeTokenAction {
+	return MsgProposeTokenAction{
+		Symbol:   symbol,
+		Proposer: proposer,
+		Action:   action,
+	}
+}
+
+func (msg *MsgProposeTokenAction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Proposer = addr
+}
+
+// Route Implements Msg.
+func (msg MsgProposeTokenAction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgProposeTokenAction) Type() string {
+	return "propose_token_action"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgProposeTokenAction) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Proposer.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Action == nil {
+		return ErrInvalidTokenAction("nil action")
+	}
+	symbol, ok := GetOwnerGroupActionSymbol(msg.Action)
+	if !ok {
+		return ErrInvalidTokenAction(msg.Action.Type())
+	}
+	if symbol != msg.Symbol {
+		return ErrInvalidTokenAction("action of token " + symbol)
+	}
+	return msg.Action.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgProposeTokenAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgProposeTokenAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgApproveTokenAction - approve a proposal, which is executed once approved by enough members
+type MsgApproveTokenAction struct {
+	Symbol     string         `json:"symbol" yaml:"symbol"`
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Approver   sdk.AccAddress `json:"approver" yaml:"approver"`
+}
+
+func NewMsgApproveTokenAction(symbol string, proposalID uint64, approver sdk.AccAddress) MsgApproveTokenAction {
+	return MsgApproveTokenAction{
+		Symbol:     symbol,
+		ProposalID: proposalID,
+		Approver:   approver,
+	}
+}
+
+func (msg *MsgApproveTokenAction) SetAccAddress(addr sdk.AccAddress) {
+	msg.Approver = addr
+}
+
+// Route Implements Msg.
+func (msg MsgApproveTokenAction) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgApproveTokenAction) Type() string {
+	return "approve_token_action"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgApproveTokenAction) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Approver.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgApproveTokenAction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgApproveTokenAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Approver}
+}
+
+// MsgGrantTokenRole - grant a role of token to another address, the allowance is only for
+// the minter role, a zero allowance means the minter can mint without limit.
+type MsgGrantTokenRole struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Grantee      sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Role         string         `json:"role" yaml:"role"`
+	Allowance    sdk.Int        `json:"allowance" yaml:"allowance"`
+}
+
+func NewMsgGrantTokenRole(symbol string, owner, grantee sdk.AccAddress, role string, allowance sdk.Int) MsgGrantTokenRole {
+	return MsgGrantTokenRole{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Grantee:      grantee,
+		Role:         role,
+		Allowance:    allowance,
+	}
+}
+
+func (msg *MsgGrantTokenRole) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgGrantTokenRole) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgGrantTokenRole) Type() string {
+	return "grant_token_role"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgGrantTokenRole) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.Grantee.Equals(msg.OwnerAddress) {
+		return ErrTokenRoleGrantedToOwner(msg.Role)
+	}
+	return NewTokenRole(msg.Symbol, msg.Role, msg.Grantee, msg.Allowance).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgGrantTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgGrantTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgRevokeTokenRole - revoke a role of token granted to an address
+type MsgRevokeTokenRole struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	Grantee      sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Role         string         `json:"role" yaml:"role"`
+}
+
+func NewMsgRevokeTokenRole(symbol string, owner, grantee sdk.AccAddress, role string) MsgRevokeTokenRole {
+	return MsgRevokeTokenRole{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Grantee:      grantee,
+		Role:         role,
+	}
+}
+
+func (msg *MsgRevokeTokenRole) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgRevokeTokenRole) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRevokeTokenRole) Type() string {
+	return "revoke_token_role"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevokeTokenRole) ValidateBasic() sdk.Error {
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return NewTokenRole(msg.Symbol, msg.Role, msg.Grantee, sdk.ZeroInt()).Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevokeTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevokeTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgSetMintPolicy - set the mint policy of token, once set the policy can only be tightened
+type MsgSetMintPolicy struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	MintPolicy   MintPolicy     `json:"mint_policy" yaml:"mint_policy"`
+}
+
+func NewMsgSetMintPolicy(symbol string, owner sdk.AccAddress, policy MintPolicy) MsgSetMintPolicy {
+	return MsgSetMintPolicy{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		MintPolicy:   policy,
+	}
+}
+
+func (msg *MsgSetMintPolicy) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgSetMintPolicy) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgSetMintPolicy) Type() string {
+	return "set_mint_policy"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetMintPolicy) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	return msg.MintPolicy.ResetRecords().Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetMintPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetMintPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgAirdrop - distribute the token of owner to many recipients. Amounts has only one element
+// if all the recipients get the same amount, otherwise it has one for each recipient.
+type MsgAirdrop struct {
+	Symbol       string           `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress   `json:"owner_address" yaml:"owner_address"`
+	Recipients   []sdk.AccAddress `json:"recipients" yaml:"recipients"`
+	Amounts      []sdk.Int        `json:"amounts" yaml:"amounts"`
+}
+
+func NewMsgAirdrop(symbol string, owner sdk.AccAddress, recipients []sdk.AccAddress, amounts []sdk.Int) MsgAirdrop {
+	return MsgAirdrop{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		Recipients:   recipients,
+		Amounts:      amounts,
+	}
+}
+
+func (msg *MsgAirdrop) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgAirdrop) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgAirdrop) Type() string {
+	return "airdrop"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAirdrop) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if len(msg.Recipients) == 0 || len(msg.Recipients) > MaxAirdropRecipients {
+		return ErrInvalidAirdrop(fmt.Sprintf("the number of recipients must be between 1 and %d", MaxAirdropRecipients))
+	}
+	if len(msg.Amounts) != 1 && len(msg.Amounts) != len(msg.Recipients) {
+		return ErrInvalidAirdrop("the number of amounts must be 1 or the number of recipients")
+	}
+	for _, amt := range msg.Amounts {
+		if !amt.IsPositive() {
+			return ErrInvalidAirdrop("amount must be positive")
+		}
+	}
+	for _, addr := range msg.Recipients {
+		if addr.Empty() {
+			return sdk.ErrInvalidAddress("missing recipient address")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAirdrop) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// GetOutputs - the coins received by each recipient
+func (msg MsgAirdrop) GetOutputs() []bank.Output {
+	outputs := make([]bank.Output, len(msg.Recipients))
+	for i, addr := range msg.Recipients {
+		amt := msg.Amounts[0]
+		if len(msg.Amounts) > 1 {
+			amt = msg.Amounts[i]
+		}
+		outputs[i] = bank.NewOutput(addr, NewTokenCoins(msg.Symbol, amt))
+	}
+	return outputs
+}
+
+// MsgClawback - move the token of a forbidden address to the recovery address set at issuance, only for clawbackable token
+type MsgClawback struct {
+	Symbol       string         `json:"symbol" yaml:"symbol"`
+	OwnerAddress sdk.AccAddress `json:"owner_address" yaml:"owner_address"`
+	From         sdk.AccAddress `json:"from" yaml:"from"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+	Memo         string         `json:"memo" yaml:"memo"` // The legal reason of the clawback, mandatory
+}
+
+func NewMsgClawback(symbol string, owner, from sdk.AccAddress, amount sdk.Int, memo string) MsgClawback {
+	return MsgClawback{
+		Symbol:       symbol,
+		OwnerAddress: owner,
+		From:         from,
+		Amount:       amount,
+		Memo:         memo,
+	}
+}
+
+func (msg *MsgClawback) SetAccAddress(addr sdk.AccAddress) {
+	msg.OwnerAddress = addr
+}
+
+// Route Implements Msg.
+func (msg MsgClawback) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgClawback) Type() string {
+	return "clawback"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.OwnerAddress.Empty() {
+		return ErrNilTokenOwner()
+	}
+	if msg.From.Empty() {
+		return ErrInvalidClawback("missing from address")
+	}
+	if !msg.Amount.IsPositive() {
+		return ErrInvalidClawback("amount must be positive")
+	}
+	if len(strings.TrimSpace(msg.Memo)) == 0 || len(msg.Memo) > MaxClawbackMemoLength {
+		return ErrInvalidClawback(fmt.Sprintf("memo is mandatory and limited to %d bytes", MaxClawbackMemoLength))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.OwnerAddress}
+}
+
+// MsgBidSymbol - place a sealed bid in the auction of a short symbol, the auction is opened by the first bid
+type MsgBidSymbol struct {
+	Symbol    string         `json:"symbol" yaml:"symbol"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	SealedBid []byte         `json:"sealed_bid" yaml:"sealed_bid"` // SealSymbolBid(symbol, bidder, amount, salt)
+	Deposit   sdk.Int        `json:"deposit" yaml:"deposit"`       // CET locked until settlement, must cover the bid
+}
+
+func NewMsgBidSymbol(symbol string, bidder sdk.AccAddress, sealedBid []byte, deposit sdk.Int) MsgBidSymbol {
+	return MsgBidSymbol{
+		Symbol:    symbol,
+		Bidder:    bidder,
+		SealedBid: sealedBid,
+		Deposit:   deposit,
+	}
+}
+
+func (msg *MsgBidSymbol) SetAccAddress(addr sdk.AccAddress) {
+	msg.Bidder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgBidSymbol) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgBidSymbol) Type() string {
+	return "bid_symbol"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgBidSymbol) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if !IsAuctionSymbol(msg.Symbol) {
+		return ErrInvalidSymbolAuction(msg.Symbol + " is not an auction symbol")
+	}
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("missing bidder address")
+	}
+	if len(msg.SealedBid) != tmhash.Size {
+		return ErrInvalidSymbolAuction("invalid sealed bid")
+	}
+	if !msg.Deposit.IsPositive() {
+		return ErrInvalidSymbolAuction("deposit must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBidSymbol) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBidSymbol) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgRevealSymbolBid - reveal the sealed bid after the bidding phase, the unrevealed deposits are forfeited
+type MsgRevealSymbolBid struct {
+	Symbol string         `json:"symbol" yaml:"symbol"`
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount sdk.Int        `json:"amount" yaml:"amount"`
+	Salt   string         `json:"salt" yaml:"salt"`
+}
+
+func NewMsgRevealSymbolBid(symbol string, bidder sdk.AccAddress, amount sdk.Int, salt string) MsgRevealSymbolBid {
+	return MsgRevealSymbolBid{
+		Symbol: symbol,
+		Bidder: bidder,
+		Amount: amount,
+		Salt:   salt,
+	}
+}
+
+func (msg *MsgRevealSymbolBid) SetAccAddress(addr sdk.AccAddress) {
+	msg.Bidder = addr
+}
+
+// Route Implements Msg.
+func (msg MsgRevealSymbolBid) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgRevealSymbolBid) Type() string {
+	return "reveal_symbol_bid"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgRevealSymbolBid) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("missing bidder address")
+	}
+	if !msg.Amount.IsPositive() {
+		return ErrInvalidSymbolAuction("bid amount must be positive")
+	}
+	if len(msg.Salt) > MaxSymbolBidSaltLength {
+		return ErrInvalidSymbolAuction(fmt.Sprintf("salt is limited to %d bytes", MaxSymbolBidSaltLength))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealSymbolBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealSymbolBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgAttestToken - attach an attestation to a token by a registered verifier,
+// the previous attestation of the verifier is replaced and an empty claim revokes it
+type MsgAttestToken struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	Verifier sdk.AccAddress `json:"verifier" yaml:"verifier"`
+	Claim    string         `json:"claim" yaml:"claim"`
+}
+
+func NewMsgAttestToken(symbol string, verifier sdk.AccAddress, claim string) MsgAttestToken {
+	return MsgAttestToken{
+		Symbol:   symbol,
+		Verifier: verifier,
+		Claim:    claim,
+	}
+}
+
+func (msg *MsgAttestToken) SetAccAddress(addr sdk.AccAddress) {
+	msg.Verifier = addr
+}
+
+// Route Implements Msg.
+func (msg MsgAttestToken) Route() string {
+	return RouterKey
+}
+
+// Type Implements Msg.
+func (msg MsgAttestToken) Type() string {
+	return "attest_token"
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAttestToken) ValidateBasic() sdk.Error {
+	if err := ValidateTokenSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if msg.Verifier.Empty() {
+		return sdk.ErrInvalidAddress("missing verifier address")
+	}
+	if len(msg.Claim) > MaxAttestationClaimLength {
+		return ErrInvalidAttestation(fmt.Sprintf("claim is limited to %d bytes", MaxAttestationClaimLength))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAttestToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAttestToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Verifier}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -509,6 +510,209 @@ func TestMsgModifyTokenURL_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgProposeTokenAction_ValidateBasic(t *testing.T) {
+	mint := NewMsgMintToken("abc", sdk.NewInt(100), testAddr)
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgProposeTokenAction("abc", testAddr, mint),
+			nil,
+		},
+		{
+			"case-nilAction",
+			NewMsgProposeTokenAction("abc", testAddr, nil),
+			ErrInvalidTokenAction("nil action"),
+		},
+		{
+			"case-notOwnerAction",
+			NewMsgProposeTokenAction("abc", testAddr, NewMsgIssueToken("ABC Token", "abc", sdk.NewInt(10000), testAddr,
+				false, false, false, false, "", "", TestIdentityString)),
+			ErrInvalidTokenAction("issue_token"),
+		},
+		{
+			"case-otherToken",
+			NewMsgProposeTokenAction("xyz", testAddr, mint),
+			ErrInvalidTokenAction("action of token abc"),
+		},
+		{
+			"case-invalidAction",
+			NewMsgProposeTokenAction("abc", testAddr, NewMsgMintToken("abc", sdk.NewInt(-1), testAddr)),
+			ErrInvalidTokenMintAmt("-1"),
+		},
+		{
+			"case-setGroup",
+			NewMsgSetOwnerGroup("abc", testAddr, []sdk.AccAddress{testAddr, testAddr}, 1),
+			ErrInvalidOwnerGroup(2, 1),
+		},
+		{
+			"case-removeGroup",
+			NewMsgSetOwnerGroup("abc", testAddr, nil, 0),
+			nil,
+		},
+		{
+			"case-invalidThreshold",
+			NewMsgSetOwnerGroup("abc", testAddr, []sdk.AccAddress{testAddr}, 2),
+			ErrInvalidOwnerGroup(1, 2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s.ValidateBasic() = %v, want %v", tt.msg.Type(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgGrantTokenRole_ValidateBasic(t *testing.T) {
+	var _, _, grantee = keyPubAddr()
+	tests := []struct {
+		name string
+		msg  MsgGrantTokenRole
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgGrantTokenRole("abc", testAddr, grantee, RoleMinter, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"case-invalidRole",
+			NewMsgGrantTokenRole("abc", testAddr, grantee, "burner", sdk.ZeroInt()),
+			ErrInvalidTokenRole("burner"),
+		},
+		{
+			"case-allowanceOfEditor",
+			NewMsgGrantTokenRole("abc", testAddr, grantee, RoleInfoEditor, sdk.NewInt(100)),
+			ErrInvalidTokenRole("info_editor with allowance 100"),
+		},
+		{
+			"case-negativeAllowance",
+			NewMsgGrantTokenRole("abc", testAddr, grantee, RoleMinter, sdk.NewInt(-1)),
+			ErrInvalidTokenRole("minter with allowance -1"),
+		},
+		{
+			"case-missingAllowance",
+			NewMsgGrantTokenRole("abc", testAddr, grantee, RoleMinter, sdk.Int{}),
+			ErrInvalidTokenRole("minter without allowance"),
+		},
+		{
+			"case-grantedToOwner",
+			NewMsgGrantTokenRole("abc", testAddr, testAddr, RoleMinter, sdk.ZeroInt()),
+			ErrTokenRoleGrantedToOwner(RoleMinter),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgGrantTokenRole("abc", sdk.AccAddress{}, grantee, RoleMinter, sdk.ZeroInt()),
+			ErrNilTokenOwner(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgGrantTokenRole.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgAirdrop_ValidateBasic(t *testing.T) {
+	recipients := []sdk.AccAddress{testAddr, testAddr}
+	tests := []struct {
+		name string
+		msg  MsgAirdrop
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgAirdrop("abc", testAddr, recipients, []sdk.Int{sdk.NewInt(100)}),
+			nil,
+		},
+		{
+			"case-amountForEach",
+			NewMsgAirdrop("abc", testAddr, recipients, []sdk.Int{sdk.NewInt(100), sdk.NewInt(200)}),
+			nil,
+		},
+		{
+			"case-noRecipient",
+			NewMsgAirdrop("abc", testAddr, nil, []sdk.Int{sdk.NewInt(100)}),
+			ErrInvalidAirdrop("the number of recipients must be between 1 and 5000"),
+		},
+		{
+			"case-amountsMismatch",
+			NewMsgAirdrop("abc", testAddr, append(recipients, testAddr), []sdk.Int{sdk.NewInt(100), sdk.NewInt(200)}),
+			ErrInvalidAirdrop("the number of amounts must be 1 or the number of recipients"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgAirdrop("abc", testAddr, recipients, []sdk.Int{sdk.NewInt(100), sdk.ZeroInt()}),
+			ErrInvalidAirdrop("amount must be positive"),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgAirdrop("abc", sdk.AccAddress{}, recipients, []sdk.Int{sdk.NewInt(100)}),
+			ErrNilTokenOwner(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgAirdrop.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	_, _, holder := keyPubAddr()
+	tests := []struct {
+		name string
+		msg  MsgClawback
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), "court order"),
+			nil,
+		},
+		{
+			"case-noFrom",
+			NewMsgClawback("abc", testAddr, sdk.AccAddress{}, sdk.NewInt(100), "court order"),
+			ErrInvalidClawback("missing from address"),
+		},
+		{
+			"case-zeroAmount",
+			NewMsgClawback("abc", testAddr, holder, sdk.ZeroInt(), "court order"),
+			ErrInvalidClawback("amount must be positive"),
+		},
+		{
+			"case-noMemo",
+			NewMsgClawback("abc", testAddr, holder, sdk.NewInt(100), " "),
+			ErrInvalidClawback("memo is mandatory and limited to 512 bytes"),
+		},
+		{
+			"case-invalidOwner",
+			NewMsgClawback("abc", sdk.AccAddress{}, holder, sdk.NewInt(100), "court order"),
+			ErrNilTokenOwner(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgClawback.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMsg_Route(t *testing.T) {
 	want := RouterKey
 	tests := []struct {
@@ -794,3 +998,78 @@ func TestMsg_GetSignBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBidSymbol_ValidateBasic(t *testing.T) {
+	sealed := SealSymbolBid("abc", testAddr, sdk.NewInt(100), "salt")
+	tests := []struct {
+		name string
+		msg  MsgBidSymbol
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgBidSymbol("abc", testAddr, sealed, sdk.NewInt(100)),
+			nil,
+		},
+		{
+			"case-longSymbol",
+			NewMsgBidSymbol("abcde", testAddr, sealed, sdk.NewInt(100)),
+			ErrInvalidSymbolAuction("abcde is not an auction symbol"),
+		},
+		{
+			"case-reservedSymbol",
+			NewMsgBidSymbol("btc", testAddr, sealed, sdk.NewInt(100)),
+			ErrInvalidSymbolAuction("btc is not an auction symbol"),
+		},
+		{
+			"case-invalidSealedBid",
+			NewMsgBidSymbol("abc", testAddr, sealed[1:], sdk.NewInt(100)),
+			ErrInvalidSymbolAuction("invalid sealed bid"),
+		},
+		{
+			"case-zeroDeposit",
+			NewMsgBidSymbol("abc", testAddr, sealed, sdk.ZeroInt()),
+			ErrInvalidSymbolAuction("deposit must be positive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgBidSymbol.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgRevealSymbolBid_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRevealSymbolBid
+		want sdk.Error
+	}{
+		{
+			"base-case",
+			NewMsgRevealSymbolBid("abc", testAddr, sdk.NewInt(100), "salt"),
+			nil,
+		},
+		{
+			"case-zeroAmount",
+			NewMsgRevealSymbolBid("abc", testAddr, sdk.ZeroInt(), "salt"),
+			ErrInvalidSymbolAuction("bid amount must be positive"),
+		},
+		{
+			"case-longSalt",
+			NewMsgRevealSymbolBid("abc", testAddr, sdk.NewInt(100), strings.Repeat("s", MaxSymbolBidSaltLength+1)),
+			ErrInvalidSymbolAuction("salt is limited to 64 bytes"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MsgRevealSymbolBid.ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxOwnerGroupMembers = 20
+)
+
+// OwnerGroup - when a token has an owner group, the owner-only operations can not be
+// signed by the owner alone, they must be proposed and approved by Threshold members.
+type OwnerGroup struct {
+	Symbol    string           `json:"symbol" yaml:"symbol"`
+	Members   []sdk.AccAddress `json:"members" yaml:"members"`
+	Threshold uint32           `json:"threshold" yaml:"threshold"`
+}
+
+func NewOwnerGroup(symbol string, members []sdk.AccAddress, threshold uint32) OwnerGroup {
+	return OwnerGroup{
+		Symbol:    symbol,
+		Members:   members,
+		Threshold: threshold,
+	}
+}
+
+func (g OwnerGroup) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(g.Symbol); err != nil {
+		return err
+	}
+	if len(g.Members) == 0 || len(g.Members) > MaxOwnerGroupMembers ||
+		g.Threshold == 0 || int(g.Threshold) > len(g.Members) {
+		return ErrInvalidOwnerGroup(len(g.Members), g.Threshold)
+	}
+	for i, member := range g.Members {
+		if member.Empty() {
+			return ErrInvalidOwnerGroup(len(g.Members), g.Threshold)
+		}
+		for _, other := range g.Members[i+1:] {
+			if member.Equals(other) {
+				return ErrInvalidOwnerGroup(len(g.Members), g.Threshold)
+			}
+		}
+	}
+	return nil
+}
+
+func (g OwnerGroup) IsMember(addr sdk.AccAddress) bool {
+	for _, member := range g.Members {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g OwnerGroup) String() string {
+	members := make([]string, len(g.Members))
+	for i, member := range g.Members {
+		members[i] = member.String()
+	}
+	return fmt.Sprintf("OwnerGroup{%s, %d of [%s]}", g.Symbol, g.Threshold, strings.Join(members, ", "))
+}
+
+// TokenActionProposal - an owner-only operation waiting for the approvals of the owner group
+type TokenActionProposal struct {
+	ID        uint64           `json:"id" yaml:"id"`
+	Symbol    string           `json:"symbol" yaml:"symbol"`
+	Proposer  sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+	Action    sdk.Msg          `json:"action" yaml:"action"`
+	Approvals []sdk.AccAddress `json:"approvals" yaml:"approvals"`
+}
+
+func (p TokenActionProposal) HasApproved(addr sdk.AccAddress) bool {
+	for _, approval := range p.Approvals {
+		if approval.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOwnerGroupActionSymbol - returns the token symbol of the owner-only operations,
+// which are governed by the owner group of the token if it has one.
+func GetOwnerGroupActionSymbol(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case MsgTransferOwnership:
+		return msg.Symbol, true
+	case MsgMintToken:
+		return msg.Symbol, true
+	case MsgBurnToken:
+		return msg.Symbol, true
+	case MsgForbidToken:
+		return msg.Symbol, true
+	case MsgUnForbidToken:
+		return msg.Symbol, true
+	case MsgAddTokenWhitelist:
+		return msg.Symbol, true
+	case MsgRemoveTokenWhitelist:
+		return msg.Symbol, true
+	case MsgForbidAddr:
+		return msg.Symbol, true
+	case MsgUnForbidAddr:
+		return msg.Symbol, true
+	case MsgModifyTokenInfo:
+		return msg.Symbol, true
+	case MsgSetOwnerGroup:
+		return msg.Symbol, true
+	case MsgGrantTokenRole:
+		return msg.Symbol, true
+	case MsgRevokeTokenRole:
+		return msg.Symbol, true
+	case MsgSetMintPolicy:
+		return msg.Symbol, true
+	case MsgClawback:
+		return msg.Symbol, true
+	default:
+		return "", false
+	}
+}
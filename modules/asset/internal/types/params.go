@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -17,8 +18,14 @@ const (
 	DefaultIssue5CharTokenFee = 200e8   //   200 * 10^8
 	DefaultIssue6CharTokenFee = 100e8   //   100 * 10^8
 	DefaultIssueLongTokenFee  = 50e8    //    50 * 10^8
+
+	DefaultAuctionBiddingPeriod = 3 * 24 * 3600 // 3 days
+	DefaultAuctionRevealPeriod  = 24 * 3600     // 1 day
 )
 
+// MaxTokenAmountInt - MaxTokenAmount as an sdk.Int
+var MaxTokenAmountInt = sdk.NewIntWithDecimal(5, 76)
+
 // Parameter keys
 var (
 	KeyIssueTokenFee      = []byte("IssueTokenFee")
@@ -27,6 +34,12 @@ var (
 	KeyIssue4CharTokenFee = []byte("Issue4CharTokenFee") // DEX2
 	KeyIssue5CharTokenFee = []byte("Issue5CharTokenFee") // DEX2
 	KeyIssue6CharTokenFee = []byte("Issue6CharTokenFee") // DEX2
+
+	KeySymbolAuctionEnabled = []byte("SymbolAuctionEnabled")
+	KeyAuctionBiddingPeriod = []byte("AuctionBiddingPeriod")
+	KeyAuctionRevealPeriod  = []byte("AuctionRevealPeriod")
+
+	KeyTokenVerifiers = []byte("TokenVerifiers")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -40,6 +53,14 @@ type Params struct {
 	Issue4CharTokenFee int64 `json:"issue_4char_token_fee" yaml:"issue_4char_token_fee"` // 4 char
 	Issue5CharTokenFee int64 `json:"issue_5char_token_fee" yaml:"issue_5char_token_fee"` // 5 char
 	Issue6CharTokenFee int64 `json:"issue_6char_token_fee" yaml:"issue_6char_token_fee"` // 6 char
+
+	// AuctionParams define the symbol auction of the short symbols, the issue fee is the reserve price.
+	SymbolAuctionEnabled bool  `json:"symbol_auction_enabled" yaml:"symbol_auction_enabled"` // short symbols can only be issued by auction winners
+	AuctionBiddingPeriod int64 `json:"auction_bidding_period" yaml:"auction_bidding_period"` // seconds
+	AuctionRevealPeriod  int64 `json:"auction_reveal_period" yaml:"auction_reveal_period"`   // seconds
+
+	// TokenVerifiers are the accounts which can attach attestations to tokens
+	TokenVerifiers []sdk.AccAddress `json:"token_verifiers" yaml:"token_verifiers"`
 }
 
 // DefaultParams returns a default set of parameters.
@@ -51,6 +72,9 @@ func DefaultParams() Params {
 		Issue4CharTokenFee: DefaultIssue4CharTokenFee,
 		Issue5CharTokenFee: DefaultIssue5CharTokenFee,
 		Issue6CharTokenFee: DefaultIssue6CharTokenFee,
+
+		AuctionBiddingPeriod: DefaultAuctionBiddingPeriod,
+		AuctionRevealPeriod:  DefaultAuctionRevealPeriod,
 	}
 }
 
@@ -64,19 +88,43 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyIssue4CharTokenFee, Value: &p.Issue4CharTokenFee},
 		{Key: KeyIssue5CharTokenFee, Value: &p.Issue5CharTokenFee},
 		{Key: KeyIssue6CharTokenFee, Value: &p.Issue6CharTokenFee},
+		{Key: KeySymbolAuctionEnabled, Value: &p.SymbolAuctionEnabled},
+		{Key: KeyAuctionBiddingPeriod, Value: &p.AuctionBiddingPeriod},
+		{Key: KeyAuctionRevealPeriod, Value: &p.AuctionRevealPeriod},
+		{Key: KeyTokenVerifiers, Value: &p.TokenVerifiers},
 	}
 }
 
 func (p *Params) ValidateGenesis() error {
+	// the fees and periods must be positive
 	for _, pair := range p.ParamSetPairs() {
-		fee := *(pair.Value.(*int64))
-		if fee <= 0 {
-			return fmt.Errorf("%s is invalid: %d", pair.Key, fee)
+		if value, ok := pair.Value.(*int64); ok && *value <= 0 {
+			return fmt.Errorf("%s is invalid: %d", pair.Key, *value)
+		}
+	}
+	for i, verifier := range p.TokenVerifiers {
+		if verifier.Empty() {
+			return fmt.Errorf("%s is invalid: empty address", KeyTokenVerifiers)
+		}
+		for _, other := range p.TokenVerifiers[i+1:] {
+			if verifier.Equals(other) {
+				return fmt.Errorf("%s is invalid: duplicate %s", KeyTokenVerifiers, verifier)
+			}
 		}
 	}
 	return nil
 }
 
+// IsTokenVerifier - check whether addr is a registered token verifier
+func (p Params) IsTokenVerifier(addr sdk.AccAddress) bool {
+	for _, verifier := range p.TokenVerifiers {
+		if verifier.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p Params) GetIssueTokenFee(symbol string) int64 {
 	switch len(symbol) {
 	case 2:
@@ -108,12 +156,20 @@ func (p Params) String() string {
   Issue3CharTokenFee: %d
   Issue4CharTokenFee: %d
   Issue5CharTokenFee: %d
-  Issue6CharTokenFee: %d`,
+  Issue6CharTokenFee: %d
+  SymbolAuctionEnabled: %t
+  AuctionBiddingPeriod: %d
+  AuctionRevealPeriod:  %d
+  TokenVerifiers: %v`,
 		p.IssueTokenFee,
 		p.IssueRareTokenFee,
 		p.Issue3CharTokenFee,
 		p.Issue4CharTokenFee,
 		p.Issue5CharTokenFee,
 		p.Issue6CharTokenFee,
+		p.SymbolAuctionEnabled,
+		p.AuctionBiddingPeriod,
+		p.AuctionRevealPeriod,
+		p.TokenVerifiers,
 	)
 }
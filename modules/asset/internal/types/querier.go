@@ -1,16 +1,33 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // query endpoints supported by the asset Querier
 const (
-	QueryToken           = "token-info"
-	QueryTokenList       = "token-list"
-	QueryWhitelist       = "token-whitelist"
-	QueryForbiddenAddr   = "addr-forbidden"
-	QueryReservedSymbols = "reserved-symbols"
-	QueryParameters      = "parameters"
+	QueryToken            = "token-info"
+	QueryTokenDisplay     = "token-display"
+	QueryTokenList        = "token-list"
+	QueryWhitelist        = "token-whitelist"
+	QueryForbiddenAddr    = "addr-forbidden"
+	QueryReservedSymbols  = "reserved-symbols"
+	QueryParameters       = "parameters"
+	QueryOwnerGroup       = "owner-group"
+	QueryProposals        = "token-action-proposals"
+	QueryTokenRoles       = "token-roles"
+	QueryTokenHolders     = "token-holders"
+	QuerySymbolAuctions   = "symbol-auctions"
+	QueryReservations     = "symbol-reservations"
+	QueryTokenInfoHistory = "token-info-history"
+	QueryAttestations     = "token-attestations"
+
+	DefaultTokenHoldersLimit = 100
+	MaxTokenHoldersLimit     = 1000
 )
 
-// QueryTokenParams defines the params for query: "custom/asset/token-info"
+// QueryTokenParams defines the params for query: "custom/asset/token-info", "custom/asset/token-display",
+// "custom/asset/owner-group", "custom/asset/token-action-proposals" and "custom/asset/token-roles"
 type QueryTokenParams struct {
 	Symbol string
 }
@@ -42,3 +59,38 @@ func NewQueryForbiddenAddrParams(s string) QueryForbiddenAddrParams {
 		Symbol: s,
 	}
 }
+
+// QueryTokenHoldersParams defines the params for query: "custom/asset/token-holders",
+// after is the last holder of the previous page, empty for the first page,
+// and limit is the number of holders in a page.
+type QueryTokenHoldersParams struct {
+	Symbol string
+	After  sdk.AccAddress
+	Limit  int
+}
+
+func NewQueryTokenHoldersParams(symbol string, after sdk.AccAddress, limit int) QueryTokenHoldersParams {
+	return QueryTokenHoldersParams{
+		Symbol: symbol,
+		After:  after,
+		Limit:  limit,
+	}
+}
+
+// TokenHolder - the balances of a holder, liquid is in the auth account,
+// frozen and locked are in the AccountX.
+type TokenHolder struct {
+	Address sdk.AccAddress `json:"address"`
+	Liquid  sdk.Int        `json:"liquid"`
+	Frozen  sdk.Int        `json:"frozen"`
+	Locked  sdk.Int        `json:"locked"`
+}
+
+// TokenHolders - a page of the holders of a token ordered by address, the next page
+// starts after the last holder of this page, and there is none if this page is not full
+type TokenHolders struct {
+	Symbol  string         `json:"symbol"`
+	After   sdk.AccAddress `json:"after"`
+	Limit   int            `json:"limit"`
+	Holders []TokenHolder  `json:"holders"`
+}
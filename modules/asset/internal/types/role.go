@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The roles the token owner can delegate to other addresses
+const (
+	RoleMinter        = "minter"
+	RoleAddrForbidder = "addr_forbidder"
+	RoleInfoEditor    = "info_editor"
+)
+
+func IsValidTokenRole(role string) bool {
+	return role == RoleMinter || role == RoleAddrForbidder || role == RoleInfoEditor
+}
+
+// TokenRole - a delegated role of token. A minter can only mint within its allowance
+// if the allowance is positive, and the role is removed once the allowance is used up.
+type TokenRole struct {
+	Symbol    string         `json:"symbol" yaml:"symbol"`
+	Role      string         `json:"role" yaml:"role"`
+	Grantee   sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance sdk.Int        `json:"allowance" yaml:"allowance"`
+}
+
+func NewTokenRole(symbol, role string, grantee sdk.AccAddress, allowance sdk.Int) TokenRole {
+	return TokenRole{
+		Symbol:    symbol,
+		Role:      role,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+func (r TokenRole) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(r.Symbol); err != nil {
+		return err
+	}
+	if !IsValidTokenRole(r.Role) {
+		return ErrInvalidTokenRole(r.Role)
+	}
+	if r.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	// the zero value of sdk.Int, which an omitted allowance decodes to, panics on every method
+	if r.Allowance == (sdk.Int{}) {
+		return ErrInvalidTokenRole(fmt.Sprintf("%s without allowance", r.Role))
+	}
+	if r.Allowance.IsNegative() || (r.Role != RoleMinter && !r.Allowance.IsZero()) {
+		return ErrInvalidTokenRole(fmt.Sprintf("%s with allowance %s", r.Role, r.Allowance))
+	}
+	return nil
+}
+
+func (r TokenRole) IsUnlimited() bool {
+	return r.Allowance.IsZero()
+}
+
+func (r TokenRole) String() string {
+	return fmt.Sprintf("TokenRole{%s, %s, %s, %s}", r.Symbol, r.Role, r.Grantee, r.Allowance)
+}
+
+// GetTokenActionRole - returns the role which is allowed to perform the owner operation,
+// or an empty string if only the owner can perform it.
+func GetTokenActionRole(msg sdk.Msg) string {
+	switch msg.(type) {
+	case MsgMintToken:
+		return RoleMinter
+	case MsgForbidAddr, MsgUnForbidAddr:
+		return RoleAddrForbidder
+	case MsgModifyTokenInfo:
+		return RoleInfoEditor
+	default:
+		return ""
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
@@ -14,6 +15,11 @@ const (
 	MaxTokenIdentityLength    = 3000
 	MaxTokenURLLength         = 100
 	MaxTokenDescriptionLength = 1024
+	MaxClawbackMemoLength     = 512
+
+	// wallets assume tokens have the same decimals as CET if not set
+	DefaultTokenDecimals = 8
+	MaxTokenDecimals     = 18
 
 	// constant used in flags to indicate that token info field should not be updated
 	DoNotModifyTokenInfo = "[do-not-modify]"
@@ -67,34 +73,56 @@ type Token interface {
 	GetIdentity() string
 	SetIdentity(string) sdk.Error
 
+	GetDecimals() uint8
+	IsDecimalsSet() bool
+	SetDecimals(uint8) sdk.Error
+
+	GetDisplayUnits() []DisplayUnit
+	SetDisplayUnits([]DisplayUnit) sdk.Error
+
+	GetMintPolicy() *MintPolicy
+	SetMintPolicy(*MintPolicy) sdk.Error
+
+	GetClawbackable() bool
+	SetClawbackable(bool)
+
+	GetClawbackRecipient() sdk.AccAddress
+	SetClawbackRecipient(sdk.AccAddress)
+
 	Validate() sdk.Error
 	// Ensure that token implements stringer
 	String() string
 }
 
-//-----------------------------------------------------------------------------
+// -----------------------------------------------------------------------------
 var _ Token = (*BaseToken)(nil)
 
 // BaseToken - a base Token structure.
 type BaseToken struct {
-	Name             string         `json:"name" yaml:"name"`                           //  Name of the newly issued asset, limited to 32 unicode characters.
-	Symbol           string         `json:"symbol" yaml:"symbol"`                       //  token symbol, [a-z][a-z0-9]{1,7}
-	TotalSupply      sdk.Int        `json:"total_supply" yaml:"total_supply"`           //  The total supply for this token [0]
-	SendLock         sdk.Int        `json:"send_lock" yaml:"send_lock"`                 // The send lock amount
-	Owner            sdk.AccAddress `json:"owner" yaml:"owner"`                         // The initial issuer of this token
-	Mintable         bool           `json:"mintable" yaml:"mintable"`                   // Whether this token could be minted after the issuing
-	Burnable         bool           `json:"burnable" yaml:"burnable"`                   // Whether this token could be burned
-	AddrForbiddable  bool           `json:"addr_forbiddable" yaml:"addr_forbiddable"`   // whether could forbid some addresses to forbid transaction
-	TokenForbiddable bool           `json:"token_forbiddable" yaml:"token_forbiddable"` // whether token could be global forbid
-	TotalBurn        sdk.Int        `json:"total_burn" yaml:"total_burn"`               // Total amount of burn
-	TotalMint        sdk.Int        `json:"total_mint" yaml:"total_mint"`               // Total amount of mint
-	IsForbidden      bool           `json:"is_forbidden" yaml:"is_forbidden"`           // Whether token being forbidden currently
-	URL              string         `json:"url" yaml:"url"`                             //URL of token website
-	Description      string         `json:"description" yaml:"description"`             //Description of token info
-	Identity         string         `json:"identity" yaml:"identity"`                   //Identity of token
-}
-
-//nolint
+	Name              string         `json:"name" yaml:"name"`                             //  Name of the newly issued asset, limited to 32 unicode characters.
+	Symbol            string         `json:"symbol" yaml:"symbol"`                         //  token symbol, [a-z][a-z0-9]{1,7}
+	TotalSupply       sdk.Int        `json:"total_supply" yaml:"total_supply"`             //  The total supply for this token [0]
+	SendLock          sdk.Int        `json:"send_lock" yaml:"send_lock"`                   // The send lock amount
+	Owner             sdk.AccAddress `json:"owner" yaml:"owner"`                           // The initial issuer of this token
+	Mintable          bool           `json:"mintable" yaml:"mintable"`                     // Whether this token could be minted after the issuing
+	Burnable          bool           `json:"burnable" yaml:"burnable"`                     // Whether this token could be burned
+	AddrForbiddable   bool           `json:"addr_forbiddable" yaml:"addr_forbiddable"`     // whether could forbid some addresses to forbid transaction
+	TokenForbiddable  bool           `json:"token_forbiddable" yaml:"token_forbiddable"`   // whether token could be global forbid
+	TotalBurn         sdk.Int        `json:"total_burn" yaml:"total_burn"`                 // Total amount of burn
+	TotalMint         sdk.Int        `json:"total_mint" yaml:"total_mint"`                 // Total amount of mint
+	IsForbidden       bool           `json:"is_forbidden" yaml:"is_forbidden"`             // Whether token being forbidden currently
+	URL               string         `json:"url" yaml:"url"`                               //URL of token website
+	Description       string         `json:"description" yaml:"description"`               //Description of token info
+	Identity          string         `json:"identity" yaml:"identity"`                     //Identity of token
+	Decimals          uint8          `json:"decimals" yaml:"decimals"`                     // Decimal places of the display amount, only valid if DecimalsSet
+	DecimalsSet       bool           `json:"decimals_set" yaml:"decimals_set"`             // Whether decimals has been set, it can't be changed once set
+	DisplayUnits      []DisplayUnit  `json:"display_units" yaml:"display_units"`           // Units used to display amounts, can't be changed once set
+	MintPolicy        *MintPolicy    `json:"mint_policy" yaml:"mint_policy"`               // Limits on minting, can only be tightened once set
+	Clawbackable      bool           `json:"clawbackable" yaml:"clawbackable"`             // Whether the owner can take back tokens from forbidden addresses, set at issuance only
+	ClawbackRecipient sdk.AccAddress `json:"clawback_recipient" yaml:"clawback_recipient"` // The recovery address receiving the clawed back tokens, set at issuance only
+}
+
+// nolint
 var (
 	// Token symbol can be 2 ~ 16 characters long.
 	tokenSymbolRegex = regexp.MustCompile(`^[a-z][a-z0-9]{1,13}([a-z0-9]{1,2}|(\.[a-z]))?$`)
@@ -180,6 +208,38 @@ func (t *BaseToken) Validate() sdk.Error {
 		return ErrInvalidSendLockAmt(t.SendLock.String())
 	}
 
+	if t.Decimals > MaxTokenDecimals || (!t.DecimalsSet && t.Decimals != 0) {
+		return ErrInvalidTokenDecimals(strconv.Itoa(int(t.Decimals)))
+	}
+
+	if err := ValidateDisplayUnits(t.DisplayUnits, t.GetDecimals()); err != nil {
+		return err
+	}
+
+	if err := validateClawback(t.Symbol, t.Clawbackable, t.AddrForbiddable, t.ClawbackRecipient); err != nil {
+		return err
+	}
+
+	if t.MintPolicy != nil {
+		return t.MintPolicy.Validate()
+	}
+	return nil
+}
+
+// validateClawback - a clawbackable token must be address forbiddable and have a recovery address
+func validateClawback(symbol string, clawbackable, addrForbiddable bool, recipient sdk.AccAddress) sdk.Error {
+	if !clawbackable {
+		if !recipient.Empty() {
+			return ErrInvalidClawback("recovery address is only for clawbackable token")
+		}
+		return nil
+	}
+	if !addrForbiddable {
+		return ErrClawbackNotSupported(symbol)
+	}
+	if recipient.Empty() {
+		return ErrInvalidClawback("missing recovery address")
+	}
 	return nil
 }
 
@@ -328,6 +388,71 @@ func (t *BaseToken) SetIdentity(identity string) sdk.Error {
 	return nil
 }
 
+func (t BaseToken) GetDecimals() uint8 {
+	if !t.DecimalsSet {
+		return DefaultTokenDecimals
+	}
+	return t.Decimals
+}
+
+func (t BaseToken) IsDecimalsSet() bool {
+	return t.DecimalsSet
+}
+
+func (t *BaseToken) SetDecimals(decimals uint8) sdk.Error {
+	if decimals > MaxTokenDecimals {
+		return ErrInvalidTokenDecimals(strconv.Itoa(int(decimals)))
+	}
+	if err := ValidateDisplayUnits(t.DisplayUnits, decimals); err != nil {
+		return err
+	}
+	t.Decimals = decimals
+	t.DecimalsSet = true
+	return nil
+}
+
+func (t BaseToken) GetDisplayUnits() []DisplayUnit {
+	return t.DisplayUnits
+}
+
+func (t *BaseToken) SetDisplayUnits(units []DisplayUnit) sdk.Error {
+	if err := ValidateDisplayUnits(units, t.GetDecimals()); err != nil {
+		return err
+	}
+	t.DisplayUnits = units
+	return nil
+}
+
+func (t BaseToken) GetMintPolicy() *MintPolicy {
+	return t.MintPolicy
+}
+
+func (t *BaseToken) SetMintPolicy(policy *MintPolicy) sdk.Error {
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+	t.MintPolicy = policy
+	return nil
+}
+
+func (t BaseToken) GetClawbackable() bool {
+	return t.Clawbackable
+}
+
+func (t *BaseToken) SetClawbackable(enable bool) {
+	t.Clawbackable = enable
+}
+
+func (t BaseToken) GetClawbackRecipient() sdk.AccAddress {
+	return t.ClawbackRecipient
+}
+
+func (t *BaseToken) SetClawbackRecipient(addr sdk.AccAddress) {
+	t.ClawbackRecipient = addr
+}
+
 func (t BaseToken) GetTotalBurn() sdk.Int {
 	return t.TotalBurn
 }
@@ -378,13 +503,26 @@ func (t BaseToken) String() string {
   URL:              %s
   Description:      %s
   Identity:			%s
+  Decimals:         %d
+  DisplayUnits:     %s
+  MintPolicy:       %s
+  Clawbackable:     %t
+  ClawbackRecipient: %s
 ]`,
 		t.Name, t.Symbol, t.TotalSupply.String(), t.SendLock.String(), t.Owner.String(), t.Mintable, t.Burnable,
 		t.AddrForbiddable, t.TokenForbiddable, t.TotalBurn.String(), t.TotalMint.String(), t.IsForbidden,
-		t.URL, t.Description, t.Identity,
+		t.URL, t.Description, t.Identity, t.GetDecimals(), DisplayUnitsString(t.DisplayUnits), t.mintPolicyString(),
+		t.Clawbackable, t.ClawbackRecipient.String(),
 	)
 }
 
+func (t BaseToken) mintPolicyString() string {
+	if t.MintPolicy == nil {
+		return "none"
+	}
+	return t.MintPolicy.String()
+}
+
 func MustUnmarshalToken(cdc *codec.Codec, value []byte) Token {
 	validator, err := UnmarshalToken(cdc, value)
 	if err != nil {
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxAttestationClaimLength = 256
+)
+
+// TokenInfoVersion - a version of the descriptive info of token, a new version is recorded
+// when the token is issued and whenever the info is modified.
+type TokenInfoVersion struct {
+	Symbol      string `json:"symbol" yaml:"symbol"`
+	Version     uint64 `json:"version" yaml:"version"`
+	Height      int64  `json:"height" yaml:"height"`
+	Time        int64  `json:"time" yaml:"time"`
+	Name        string `json:"name" yaml:"name"`
+	URL         string `json:"url" yaml:"url"`
+	Description string `json:"description" yaml:"description"`
+	Identity    string `json:"identity" yaml:"identity"`
+}
+
+// IsSameInfo - check whether the token has the same info as the version
+func (v TokenInfoVersion) IsSameInfo(token Token) bool {
+	return v.Name == token.GetName() && v.URL == token.GetURL() &&
+		v.Description == token.GetDescription() && v.Identity == token.GetIdentity()
+}
+
+func (v TokenInfoVersion) String() string {
+	return fmt.Sprintf("TokenInfoVersion{%s v%d at %d, %s, %s, %s}",
+		v.Symbol, v.Version, v.Height, v.Name, v.URL, v.Identity)
+}
+
+// TokenAttestation - a claim about a token (e.g. "identity verified") signed by a registered verifier,
+// a verifier has at most one attestation on a token.
+type TokenAttestation struct {
+	Symbol   string         `json:"symbol" yaml:"symbol"`
+	Verifier sdk.AccAddress `json:"verifier" yaml:"verifier"`
+	Claim    string         `json:"claim" yaml:"claim"`
+	Height   int64          `json:"height" yaml:"height"`
+	Time     int64          `json:"time" yaml:"time"`
+}
+
+func (a TokenAttestation) Validate() sdk.Error {
+	if err := ValidateTokenSymbol(a.Symbol); err != nil {
+		return err
+	}
+	if a.Verifier.Empty() {
+		return sdk.ErrInvalidAddress("missing verifier address")
+	}
+	if len(a.Claim) == 0 || len(a.Claim) > MaxAttestationClaimLength {
+		return ErrInvalidAttestation(fmt.Sprintf("claim is mandatory and limited to %d bytes", MaxAttestationClaimLength))
+	}
+	return nil
+}
+
+func (a TokenAttestation) String() string {
+	return fmt.Sprintf("TokenAttestation{%s, %s, %s at %d}", a.Symbol, a.Verifier, a.Claim, a.Height)
+}
@@ -38,6 +38,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				0,
+				false,
+				nil,
+				nil,
+				false,
+				nil,
 			},
 			nil,
 		},
@@ -59,6 +65,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				0,
+				false,
+				nil,
+				nil,
+				false,
+				nil,
 			},
 			ErrTokenMintNotSupported("abc"),
 		},
@@ -80,6 +92,12 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				0,
+				false,
+				nil,
+				nil,
+				false,
+				nil,
 			},
 			ErrTokenBurnNotSupported("abc"),
 		},
@@ -101,9 +119,42 @@ func TestBaseToken_Validate(t *testing.T) {
 				"",
 				"",
 				TestIdentityString,
+				0,
+				false,
+				nil,
+				nil,
+				false,
+				nil,
 			},
 			ErrTokenForbiddenNotSupported("abc"),
 		},
+		{
+			"case-invalid-display-units",
+			&BaseToken{
+				"ABC Token",
+				"abc",
+				sdk.NewInt(2100),
+				sdk.ZeroInt(),
+				testAddr,
+				false,
+				false,
+				false,
+				false,
+				sdk.ZeroInt(),
+				sdk.ZeroInt(),
+				false,
+				"",
+				"",
+				TestIdentityString,
+				2,
+				true,
+				[]DisplayUnit{{"abc", 2}, {"kabc", 5}},
+				nil,
+				false,
+				nil,
+			},
+			ErrInvalidTokenDisplayUnits("abc:2,kabc:5"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,3 +165,66 @@ func TestBaseToken_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseToken_SetDecimals(t *testing.T) {
+	token, err := NewToken("ABC Token", "abc", sdk.NewInt(2100), testAddr,
+		false, false, false, false, "", "", TestIdentityString)
+	require.NoError(t, err)
+	require.False(t, token.IsDecimalsSet())
+	require.EqualValues(t, DefaultTokenDecimals, token.GetDecimals())
+
+	require.NoError(t, token.SetDisplayUnits([]DisplayUnit{{"mabc", 5}, {"abc", 8}}))
+	require.Error(t, token.SetDecimals(6))
+	require.Error(t, token.SetDecimals(MaxTokenDecimals+1))
+	require.NoError(t, token.SetDecimals(8))
+	require.True(t, token.IsDecimalsSet())
+	require.NoError(t, token.Validate())
+}
+
+func TestMintPolicy_IsTighterThan(t *testing.T) {
+	schedule, err := ParseMintSchedule("100:1000,200:2000")
+	require.NoError(t, err)
+	old := NewMintPolicy(sdk.NewInt(100), 3600, sdk.NewInt(5000), schedule)
+	require.NoError(t, old.Validate())
+	capped := NewMintPolicy(sdk.ZeroInt(), 0, sdk.NewIntWithDecimal(5, 76), nil)
+	require.Error(t, capped.Validate())
+	capped.SupplyCap = capped.SupplyCap.SubRaw(1)
+	require.NoError(t, capped.Validate())
+
+	policy := NewMintPolicy(sdk.NewInt(50), 7200, sdk.NewInt(4000), schedule)
+	require.True(t, policy.IsTighterThan(old))
+	policy.Period = 1800
+	require.False(t, policy.IsTighterThan(old))
+	policy.Period = 7200
+	policy.SupplyCap = sdk.ZeroInt()
+	require.False(t, policy.IsTighterThan(old))
+	policy.SupplyCap = sdk.NewInt(4000)
+
+	policy.Schedule, _ = ParseMintSchedule("150:1000,200:1500,300:2000")
+	require.True(t, policy.IsTighterThan(old))
+	policy.Schedule, _ = ParseMintSchedule("50:500,200:2000")
+	require.False(t, policy.IsTighterThan(old))
+	policy.Schedule = nil
+	require.False(t, policy.IsTighterThan(old))
+
+	require.Error(t, NewMintPolicy(sdk.NewInt(50), 0, sdk.ZeroInt(), nil).Validate())
+	require.Error(t, NewMintPolicy(sdk.ZeroInt(), 0, sdk.ZeroInt(), []MintScheduleStep{{200, sdk.NewInt(2)}, {100, sdk.NewInt(3)}}).Validate())
+}
+
+func TestMintPolicy_Mint(t *testing.T) {
+	schedule, err := ParseMintSchedule("100:1000,200:2000")
+	require.NoError(t, err)
+	require.Equal(t, "100:1000,200:2000", MintScheduleString(schedule))
+	policy := NewMintPolicy(sdk.NewInt(600), 50, sdk.NewInt(2500), schedule)
+
+	supply := sdk.NewInt(1000)
+	require.Equal(t, CodeMintPolicyViolated, policy.Mint(99, supply, sdk.NewInt(1)).Code())
+	require.NoError(t, policy.Mint(100, supply, sdk.NewInt(600)))
+	require.Equal(t, CodeMintPolicyViolated, policy.Mint(149, supply, sdk.NewInt(1)).Code())
+	require.NoError(t, policy.Mint(150, supply, sdk.NewInt(400)))
+	require.Equal(t, CodeMintPolicyViolated, policy.Mint(199, supply, sdk.NewInt(1)).Code())
+	require.Equal(t, CodeMintPolicyViolated, policy.Mint(300, sdk.NewInt(2000), sdk.NewInt(501)).Code())
+	require.NoError(t, policy.Mint(300, sdk.NewInt(2000), sdk.NewInt(500)))
+	require.Equal(t, sdk.NewInt(1500), policy.TotalMinted)
+	require.Equal(t, sdk.NewInt(500), policy.PeriodMinted)
+}
@@ -123,6 +123,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // module end-block
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.assetKeeper)
 	return []abci.ValidatorUpdate{}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/tendermint/tendermint/crypto"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
 	"github.com/coinexchain/cet-sdk/modules/asset/internal/types"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -27,6 +29,7 @@ type testInput struct {
 	cdc *codec.Codec
 	ctx sdk.Context
 	tk  asset.Keeper
+	bkx bankx.Keeper
 }
 
 func createTestInput() testInput {
@@ -34,14 +37,16 @@ func createTestInput() testInput {
 	app := testapp.NewTestApp()
 	ctx := app.NewCtx()
 	app.AssetKeeper.SetParams(ctx, types.DefaultParams())
+	app.BankxKeeper.SetParams(ctx, bankx.DefaultParams())
 
 	initSupply := dex.NewCetCoinsE8(10000)
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(initSupply))
 	notBondedPool := supply.NewEmptyModuleAccount(staking.NotBondedPoolName, supply.Burner, supply.Staking)
 	_ = notBondedPool.SetCoins(initSupply)
 	app.SupplyKeeper.SetModuleAccount(ctx, notBondedPool)
+	app.DistrKeeper.SetFeePool(ctx, distribution.InitialFeePool())
 
-	return testInput{app.Cdc, ctx, app.AssetKeeper}
+	return testInput{app.Cdc, ctx, app.AssetKeeper, app.BankxKeeper}
 }
 
 var _, _, testAddr = keyPubAddr()
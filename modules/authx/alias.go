@@ -14,9 +14,15 @@ const (
 	CodeSpaceAuthX           = types.CodeSpaceAuthX
 	CodeGasPriceTooLow       = types.CodeGasPriceTooLow
 	CodeRefereeChangeTooFast = types.CodeRefereeChangeTooFast
+	CodeInvalidFeeGrant      = types.CodeInvalidFeeGrant
+	CodeFeeGrantNotFound     = types.CodeFeeGrantNotFound
+	CodeFeeGrantExceeded     = types.CodeFeeGrantExceeded
 
 	DefaultParamspace       = types.DefaultParamspace
 	DefaultMinGasPriceLimit = types.DefaultMinGasPriceLimit
+
+	SecondsPerDay           = types.SecondsPerDay
+	MaxReceiveWhitelistSize = types.MaxReceiveWhitelistSize
 )
 
 var (
@@ -25,12 +31,20 @@ var (
 	ErrRefereeChangeTooFast    = types.ErrRefereeChangeTooFast
 	NewLockedCoin              = types.NewLockedCoin
 	NewSupervisedLockedCoin    = types.NewSupervisedLockedCoin
+	NewVestingLockedCoin       = types.NewVestingLockedCoin
 	NewParams                  = types.NewParams
 	NewAccountX                = types.NewAccountX
 	DefaultParams              = types.DefaultParams
 	ModuleCdc                  = types.ModuleCdc
 	NewAccountXWithAddress     = types.NewAccountXWithAddress
 	NewKeeper                  = keepers.NewKeeper
+	ErrInvalidFeeGrant         = types.ErrInvalidFeeGrant
+	NewFeeGrant                = types.NewFeeGrant
+	NewMsgGrantFee             = types.NewMsgGrantFee
+	NewMsgRevokeFeeGrant       = types.NewMsgRevokeFeeGrant
+	NewMsgUseFeeGrant          = types.NewMsgUseFeeGrant
+	NewReferralStats           = types.NewReferralStats
+	NewTransferRestrictions    = types.NewTransferRestrictions
 )
 
 type (
@@ -38,6 +52,12 @@ type (
 	LockedCoin            = types.LockedCoin
 	LockedCoins           = types.LockedCoins
 	MsgSetReferee         = types.MsgSetReferee
+	FeeGrant              = types.FeeGrant
+	MsgGrantFee           = types.MsgGrantFee
+	MsgRevokeFeeGrant     = types.MsgRevokeFeeGrant
+	MsgUseFeeGrant        = types.MsgUseFeeGrant
+	ReferralStats         = types.ReferralStats
+	TransferRestrictions  = types.TransferRestrictions
 	AccountXKeeper        = keepers.AccountXKeeper
 	ExpectedAccountKeeper = keepers.ExpectedAccountKeeper
 	ExpectedTokenKeeper   = keepers.ExpectedTokenKeeper
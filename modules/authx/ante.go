@@ -1,6 +1,8 @@
 package authx
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -17,7 +19,7 @@ type AnteHelper interface {
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer, or from the granter named by MsgUseFeeGrant.
 func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper authtypes.SupplyKeeper,
 	axk AccountXKeeper, anteHelper AnteHelper) sdk.AnteHandler {
 
@@ -29,14 +31,29 @@ func WrapAnteHandler(ah sdk.AnteHandler,
 	axk AccountXKeeper, anteHelper AnteHelper) sdk.AnteHandler {
 
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		// move the fee from the granter to the fee payer before auth.AnteHandler deducts it,
+		// the move is discarded with the other changes of the ante handler if a signature is invalid
+		stdTx, _ := tx.(auth.StdTx)
+		grantGas, err := payFeeByGrant(ctx, stdTx, simulate, axk)
+		if err != nil {
+			return ctx, err.Result(), true
+		}
+
 		// run auth.AnteHandler first
 		newCtx, res, abort = ah(ctx, tx, simulate)
 		if !res.IsOK() {
 			return
 		}
 
+		// then, charge the gas of the fee grant to the gas meter set by auth.AnteHandler
+		if grantGas > 0 {
+			if !simulate && newCtx.GasMeter().GasConsumed()+grantGas > stdTx.Fee.Gas {
+				return newCtx, sdk.ErrOutOfGas("out of gas in using the fee grant").Result(), true
+			}
+			newCtx.GasMeter().ConsumeGas(grantGas, "feeGrant")
+		}
+
 		// then, do additional check
-		stdTx, _ := tx.(auth.StdTx)
 		if err := doAdditionalCheck(ctx, stdTx, simulate, axk, anteHelper); err != nil {
 			res = err.Result()
 			abort = true
@@ -74,6 +91,41 @@ func doAdditionalCheck(ctx sdk.Context, tx auth.StdTx, simulate bool,
 	return nil
 }
 
+// feeGrantGas is the gas of using a fee grant, which is charged explicitly because the store is not metered
+const feeGrantGas sdk.Gas = 10000
+
+// payFeeByGrant makes the granter named by MsgUseFeeGrant pay the fee of tx for its first signer,
+// which is the account auth.AnteHandler deducts the fee from. It returns the gas to be charged
+// once auth.AnteHandler has set the gas meter.
+func payFeeByGrant(ctx sdk.Context, tx auth.StdTx, simulate bool, axk AccountXKeeper) (sdk.Gas, sdk.Error) {
+	var useGrant *MsgUseFeeGrant
+	otherMsgs := make([]sdk.Msg, 0, len(tx.Msgs))
+	for _, msg := range tx.Msgs {
+		if msg, ok := msg.(MsgUseFeeGrant); ok {
+			if useGrant != nil {
+				return 0, ErrInvalidFeeGrant("only one fee grant can be used by a transaction")
+			}
+			useGrant = &msg
+			continue
+		}
+		otherMsgs = append(otherMsgs, msg)
+	}
+	if useGrant == nil {
+		return 0, nil
+	}
+
+	if signers := tx.GetSigners(); len(signers) == 0 || !signers[0].Equals(useGrant.Grantee) {
+		return 0, ErrInvalidFeeGrant("the grantee must be the first signer")
+	}
+	if tx.Fee.Amount.IsZero() {
+		return 0, nil
+	}
+	if !simulate && tx.Fee.Gas < feeGrantGas {
+		return 0, sdk.ErrOutOfGas(fmt.Sprintf("using a fee grant needs %d gas", feeGrantGas))
+	}
+	return feeGrantGas, axk.UseFeeGrant(ctx, useGrant.Granter, useGrant.Grantee, tx.Fee.Amount, otherMsgs)
+}
+
 func checkGasPrice(ctx sdk.Context, tx auth.StdTx, axk AccountXKeeper) sdk.Error {
 	if ctx.BlockHeader().Height == types.GenesisBlockHeight {
 		// do not check gas price during the genesis block
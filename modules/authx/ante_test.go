@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bankx"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestOriginalAnteHandlerError(t *testing.T) {
@@ -58,3 +60,74 @@ func TestAdditionalError(t *testing.T) {
 	require.True(t, abort)
 	require.Equal(t, expectedErr.Result(), res)
 }
+
+func TestFeeGrant(t *testing.T) {
+	input := setupTestInput()
+	authx.InitGenesis(input.ctx, input.axk, authx.DefaultGenesisState())
+	ctx := input.ctx.WithBlockHeight(1)
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	other := sdk.AccAddress([]byte("other"))
+
+	granterAcc := input.ak.NewAccountWithAddress(ctx, granter)
+	_ = granterAcc.SetCoins(dex.NewCetCoins(1e8))
+	input.ak.SetAccount(ctx, granterAcc)
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, grantee))
+
+	// deducts the fee from the first signer like auth.AnteHandler
+	ah := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		stdTx := tx.(auth.StdTx)
+		acc := input.ak.GetAccount(ctx, stdTx.GetSigners()[0])
+		coins, neg := acc.GetCoins().SafeSub(stdTx.Fee.Amount)
+		if neg {
+			return ctx, sdk.ErrInsufficientFunds("insufficient funds to pay for fees").Result(), true
+		}
+		_ = acc.SetCoins(coins)
+		input.ak.SetAccount(ctx, acc)
+		return ctx, sdk.Result{}, false
+	}
+	ah2 := authx.WrapAnteHandler(ah, input.axk, testAnteHelper{})
+
+	fee := auth.StdFee{Amount: dex.NewCetCoins(2e7), Gas: 100000}
+	send := bankx.NewMsgSend(grantee, other, dex.NewCetCoins(1), 0)
+	tx := auth.StdTx{Msgs: []sdk.Msg{authx.NewMsgUseFeeGrant(grantee, granter), send}, Fee: fee}
+	_, res, abort := ah2(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, authx.CodeFeeGrantNotFound, res.Code)
+
+	grant := authx.NewFeeGrant(granter, grantee, dex.NewCetCoins(3e7), 0, []string{"bankx/send"})
+	input.axk.SetFeeGrant(ctx, grant)
+
+	// the gas of using the grant must be covered by the gas limit, and is charged to the gas meter
+	tx.Fee.Gas = 1000
+	_, res, abort = ah2(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeOutOfGas, res.Code)
+	require.Equal(t, sdk.NewInt(1e8), input.ak.GetAccount(ctx, granter).GetCoins().AmountOf("cet"))
+	tx.Fee.Gas = fee.Gas
+	newCtx, res, abort := ah2(ctx.WithGasMeter(sdk.NewGasMeter(fee.Gas)), tx, false)
+	require.False(t, abort, res.Log)
+	require.True(t, newCtx.GasMeter().GasConsumed() > 0)
+	require.Equal(t, sdk.NewInt(8e7), input.ak.GetAccount(ctx, granter).GetCoins().AmountOf("cet"))
+	require.True(t, input.ak.GetAccount(ctx, grantee).GetCoins().IsZero())
+	grant, _ = input.axk.GetFeeGrant(ctx, granter, grantee)
+	require.Equal(t, dex.NewCetCoins(1e7), grant.SpendLimit)
+
+	// the spend limit, the allowed message types and the fee payer are checked
+	_, res, _ = ah2(ctx, tx, false)
+	require.Equal(t, authx.CodeFeeGrantExceeded, res.Code)
+	tx.Msgs = []sdk.Msg{authx.NewMsgUseFeeGrant(grantee, granter), authx.MsgSetReferee{Sender: grantee, Referee: other}}
+	tx.Fee = auth.StdFee{Amount: dex.NewCetCoins(1e7), Gas: 100000}
+	_, res, _ = ah2(ctx, tx, false)
+	require.Equal(t, authx.CodeInvalidFeeGrant, res.Code)
+	tx.Msgs = []sdk.Msg{bankx.NewMsgSend(other, grantee, dex.NewCetCoins(1), 0), authx.NewMsgUseFeeGrant(grantee, granter)}
+	_, res, _ = ah2(ctx, tx, false)
+	require.Equal(t, authx.CodeInvalidFeeGrant, res.Code)
+
+	// the grant is removed once its spend limit is used up
+	tx.Msgs = []sdk.Msg{authx.NewMsgUseFeeGrant(grantee, granter), send}
+	_, res, abort = ah2(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	_, found := input.axk.GetFeeGrant(ctx, granter, grantee)
+	require.False(t, found)
+}
@@ -26,6 +26,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	assQueryCmd.AddCommand(client.GetCommands(
 		GetQueryParamsCmd(cdc),
+		GetFeeGrantsCmd(cdc),
+		GetReferralsCmd(cdc),
 	)...)
 
 	return assQueryCmd
@@ -60,3 +62,37 @@ func GetAccountXCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	return flags.GetCommands(cmd)[0]
 }
+
+func GetFeeGrantsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-grants [address]",
+		Short: "Query the fee grants given or received by the address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeGrants)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := auth.NewQueryAccountParams(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func GetReferralsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "referrals [address]",
+		Short: "Query the referred accounts and rebates earned by the address as a referee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReferrals)
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := auth.NewQueryAccountParams(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
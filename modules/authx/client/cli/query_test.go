@@ -58,3 +58,24 @@ func TestQueryParams(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.Equal(t, "custom/accx/parameters", ResultPath)
 }
+
+func TestQueryReferrals(t *testing.T) {
+	cliutil.CliQuery = func(cdc *codec.Codec, path string, param interface{}) error {
+		ResultParam = param.(*auth.QueryAccountParams)
+		ResultPath = path
+		return nil
+	}
+
+	sdk.GetConfig().SetBech32PrefixForAccount("coinex", "coinexpub")
+	cmd := GetQueryCmd(nil)
+	args := []string{
+		"referrals",
+		"coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a",
+	}
+	addr, _ := sdk.AccAddressFromBech32("coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a")
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "custom/accx/referrals", ResultPath)
+	assert.Equal(t, &auth.QueryAccountParams{Address: addr}, ResultParam)
+}
@@ -1,7 +1,12 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +16,11 @@ import (
 	"github.com/coinexchain/cosmos-utils/client/cliutil"
 )
 
+const (
+	FlagExpiration      = "expiration"
+	FlagAllowedMsgTypes = "allowed-msg-types"
+)
+
 func SetRefereeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-referee <referee address>",
@@ -29,5 +39,68 @@ func SetRefereeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd = client.PostCommands(cmd)[0]
 	_ = cmd.MarkFlagRequired(client.FlagFrom)
 
+	cmd.AddCommand(client.PostCommands(
+		GrantFeeCmd(cdc),
+		RevokeFeeGrantCmd(cdc),
+	)...)
+
+	return cmd
+}
+
+func GrantFeeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee <grantee address> <spend limit>",
+		Short: "Allow the grantee to pay transaction fees with your coins",
+		Long: `Allow the grantee to pay transaction fees with your coins, at most the spend limit in total.
+The grantee uses the grant by adding a MsgUseFeeGrant naming you to its transactions.
+
+Example:
+    cetcli tx set-referee grant-fee coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 100000000cet \
+        --expiration=1600000000 --allowed-msg-types=bankx/send,market/create_order \
+        --from=granter_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			expiration := viper.GetInt64(FlagExpiration)
+			if expiration != 0 && expiration <= time.Now().Unix() {
+				return fmt.Errorf("expiration should be later than the current time")
+			}
+			var msgTypes []string
+			if s := viper.GetString(FlagAllowedMsgTypes); len(s) != 0 {
+				msgTypes = strings.Split(s, ",")
+			}
+
+			msg := types.NewMsgGrantFee(nil, grantee, spendLimit, expiration, msgTypes)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiration, 0, "The unix timestamp when the grant expires, 0 means never")
+	cmd.Flags().String(FlagAllowedMsgTypes, "", "The comma separated message types the grant can be used for, such as bankx/send, empty means any")
+
 	return cmd
 }
+
+func RevokeFeeGrantCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-fee-grant <grantee address>",
+		Short: "Revoke the fee grant given to the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeFeeGrant(nil, grantee)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+}
@@ -20,6 +20,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec)
 	r.HandleFunc("/auth/accounts/{address}", QueryAccountRequestHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/auth/parameters", QueryParamsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/auth/accounts/{address}/referee", setRefereeHandleFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/auth/accounts/{address}/fee_grants", grantFeeHandleFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/auth/accounts/{address}/fee_grants/revocations", revokeFeeGrantHandleFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/auth/accounts/{address}/fee_grants", QueryFeeGrantsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/auth/accounts/{address}/referrals", QueryReferralsHandlerFn(cliCtx, cdc)).Methods("GET")
 }
 
 // query accountREST Handler
@@ -38,6 +42,36 @@ func QueryAccountRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) h
 	}
 }
 
+// HTTP request handler to query the fee grants given or received by an account
+func QueryFeeGrantsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryFeeGrants)
+		acc, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := auth.NewQueryAccountParams(acc)
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+// HTTP request handler to query the referral stats of an account
+func QueryReferralsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryReferrals)
+		acc, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := auth.NewQueryAccountParams(acc)
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
 // HTTP request handler to query the authx params values
 func QueryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
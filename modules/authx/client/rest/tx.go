@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -12,3 +14,18 @@ import (
 func setRefereeHandleFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(setRefereeReq)).Build(nil)
 }
+
+func grantFeeHandleFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		expiration := req.(*grantFeeReq).Expiration
+		if expiration != 0 && expiration <= time.Now().Unix() {
+			return fmt.Errorf("expiration should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(grantFeeReq)).Build(checker)
+}
+
+func revokeFeeGrantHandleFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(revokeFeeGrantReq)).Build(nil)
+}
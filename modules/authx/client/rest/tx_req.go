@@ -29,3 +29,44 @@ func (req *setRefereeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Ms
 	}
 	return types.NewMsgSetReferee(sender, referee), nil
 }
+
+type grantFeeReq struct {
+	BaseReq         rest.BaseReq `json:"base_req"`
+	Grantee         string       `json:"grantee"`
+	SpendLimit      sdk.Coins    `json:"spend_limit"`
+	Expiration      int64        `json:"expiration,omitempty"`
+	AllowedMsgTypes []string     `json:"allowed_msg_types,omitempty"`
+}
+
+func (req *grantFeeReq) New() restutil.RestReq {
+	return new(grantFeeReq)
+}
+func (req *grantFeeReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *grantFeeReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgGrantFee(sender, grantee, req.SpendLimit, req.Expiration, req.AllowedMsgTypes), nil
+}
+
+type revokeFeeGrantReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Grantee string       `json:"grantee"`
+}
+
+func (req *revokeFeeGrantReq) New() restutil.RestReq {
+	return new(revokeFeeGrantReq)
+}
+func (req *revokeFeeGrantReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *revokeFeeGrantReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgRevokeFeeGrant(sender, grantee), nil
+}
@@ -3,15 +3,24 @@ package authx
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func EndBlocker(ctx sdk.Context, aux AccountXKeeper, keeper ExpectedAccountKeeper, tk ExpectedTokenKeeper) {
 	currentTime := ctx.BlockHeader().Time.Unix()
 	iterator := aux.UnlockedCoinsQueueIterator(ctx, currentTime)
-	defer iterator.Close()
+	var keys [][]byte
+	var addrs []sdk.AccAddress
 	for ; iterator.Valid(); iterator.Next() {
-		addr := iterator.Value()
+		keys = append(keys, iterator.Key())
+		addrs = append(addrs, iterator.Value())
+	}
+	iterator.Close()
+
+	// vesting coins are queued again for their next slice, so the queue
+	// must not be modified while it is being iterated
+	for i, addr := range addrs {
 		if addr != nil {
 			acc, ok := aux.GetAccountX(ctx, addr)
 			if !ok {
@@ -19,7 +28,7 @@ func EndBlocker(ctx sdk.Context, aux AccountXKeeper, keeper ExpectedAccountKeepe
 				continue
 			}
 			withdrawUnlockedCoins(&acc, currentTime, ctx, aux, keeper, tk)
-			aux.RemoveFromUnlockedCoinsQueueByKey(ctx, iterator.Key())
+			aux.RemoveFromUnlockedCoinsQueueByKey(ctx, keys[i])
 		}
 	}
 }
@@ -33,15 +42,35 @@ type NotificationUnlock struct {
 	Height      int64          `json:"height"`
 }
 
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register("notify_unlock", 1, NotificationUnlock{})
+}
+
 func withdrawUnlockedCoins(accx *AccountX, time int64, ctx sdk.Context, kx AccountXKeeper, keeper ExpectedAccountKeeper, tk ExpectedTokenKeeper) {
 	var unlocked = sdk.Coins{}
 	var stillLocked LockedCoins
+	var nextVestingTimes []int64
 	for _, c := range accx.LockedCoins {
-		if c.UnlockTime <= time {
-			unlocked = unlocked.Add(sdk.Coins{c.Coin})
-		} else {
-			stillLocked = append(stillLocked, c)
+		if !c.IsVesting() {
+			if c.UnlockTime <= time {
+				unlocked = unlocked.Add(sdk.Coins{c.Coin})
+			} else {
+				stillLocked = append(stillLocked, c)
+			}
+			continue
+		}
+		vested, boundary := c.VestedAt(time)
+		if vested.IsPositive() {
+			unlocked = unlocked.Add(sdk.Coins{vested})
 		}
+		if boundary >= c.UnlockTime {
+			continue
+		}
+		c.Coin = c.Coin.Sub(vested)
+		c.VestedTime = boundary
+		stillLocked = append(stillLocked, c)
+		nextVestingTimes = append(nextVestingTimes, c.NextVestingTime())
 	}
 	unlocked = unlocked.Sort()
 
@@ -55,6 +84,9 @@ func withdrawUnlockedCoins(accx *AccountX, time int64, ctx sdk.Context, kx Accou
 
 	accx.LockedCoins = stillLocked
 	kx.SetAccountX(ctx, *accx)
+	for _, t := range nextVestingTimes {
+		kx.InsertUnlockedCoinsQueue(ctx, t, accx.Address)
+	}
 
 	if len(kx.EventTypeMsgQueue) != 0 {
 		notifyUnlock := NotificationUnlock{
@@ -2,6 +2,7 @@ package authx_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -61,3 +62,47 @@ func TestEndBlocker(t *testing.T) {
 	acc2 = input.ak.GetAccount(input.ctx, addr2)
 	require.Equal(t, int64(20), acc2.GetCoins().AmountOf("cet").Int64())
 }
+
+func TestEndBlockerVesting(t *testing.T) {
+	input := setupTestInput()
+	start := input.ctx.BlockHeader().Time.Unix()
+
+	addr := sdk.AccAddress("addr1")
+	accX := authx.AccountX{Address: addr}
+	accX.LockedCoins = authx.LockedCoins{
+		authx.NewVestingLockedCoin("cet", sdk.NewInt(1000), start, start+150, 100, 4),
+	}
+	input.axk.SetAccountX(input.ctx, accX)
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, addr))
+	input.axk.InsertUnlockedCoinsQueue(input.ctx, start+150, addr)
+
+	unlocked := accX.GetAllUnlockedCoinsAtTheTime(start + 120)
+	require.Equal(t, 0, len(unlocked))
+	unlocked = accX.GetAllUnlockedCoinsAtTheTime(start + 250)
+	require.Equal(t, int64(500), unlocked[0].Coin.Amount.Int64())
+	require.Equal(t, start+200, unlocked[0].UnlockTime)
+
+	// nothing is released before the cliff
+	ctx := input.ctx.WithBlockTime(time.Unix(start+120, 0))
+	authx.EndBlocker(ctx, input.axk, input.ak, input.tk)
+	require.True(t, input.ak.GetAccount(ctx, addr).GetCoins().AmountOf("cet").IsZero())
+
+	// the slice vested at start+100 is released at the cliff
+	ctx = input.ctx.WithBlockTime(time.Unix(start+150, 0))
+	authx.EndBlocker(ctx, input.axk, input.ak, input.tk)
+	require.Equal(t, int64(250), input.ak.GetAccount(ctx, addr).GetCoins().AmountOf("cet").Int64())
+	accX, _ = input.axk.GetAccountX(ctx, addr)
+	require.Equal(t, int64(750), accX.LockedCoins[0].Coin.Amount.Int64())
+	require.Equal(t, start+100, accX.LockedCoins[0].VestedTime)
+
+	ctx = input.ctx.WithBlockTime(time.Unix(start+200, 0))
+	authx.EndBlocker(ctx, input.axk, input.ak, input.tk)
+	require.Equal(t, int64(500), input.ak.GetAccount(ctx, addr).GetCoins().AmountOf("cet").Int64())
+
+	// missed periods are released together with the rest at the end of the schedule
+	ctx = input.ctx.WithBlockTime(time.Unix(start+1000, 0))
+	authx.EndBlocker(ctx, input.axk, input.ak, input.tk)
+	require.Equal(t, int64(1000), input.ak.GetAccount(ctx, addr).GetCoins().AmountOf("cet").Int64())
+	accX, _ = input.axk.GetAccountX(ctx, addr)
+	require.Equal(t, 0, len(accX.LockedCoins))
+}
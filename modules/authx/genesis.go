@@ -9,20 +9,24 @@ import (
 )
 
 type GenesisState struct {
-	Params    types.Params    `json:"params"`
-	AccountXs types.AccountXs `json:"accountxs"`
+	Params        types.Params          `json:"params"`
+	AccountXs     types.AccountXs       `json:"accountxs"`
+	FeeGrants     []types.FeeGrant      `json:"fee_grants"`
+	ReferralStats []types.ReferralStats `json:"referral_stats"`
 }
 
-func NewGenesisState(params types.Params, accountXs types.AccountXs) GenesisState {
+func NewGenesisState(params types.Params, accountXs types.AccountXs, feeGrants []types.FeeGrant, referralStats []types.ReferralStats) GenesisState {
 	return GenesisState{
-		Params:    params,
-		AccountXs: accountXs,
+		Params:        params,
+		AccountXs:     accountXs,
+		FeeGrants:     feeGrants,
+		ReferralStats: referralStats,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(types.DefaultParams(), types.AccountXs{})
+	return NewGenesisState(types.DefaultParams(), types.AccountXs{}, []types.FeeGrant{}, []types.ReferralStats{})
 }
 
 // InitGenesis - Init store state from genesis data
@@ -33,8 +37,25 @@ func InitGenesis(ctx sdk.Context, keeper AccountXKeeper, data GenesisState) {
 		accountX := types.NewAccountX(accx.Address, accx.MemoRequired,
 			accx.LockedCoins, accx.FrozenCoins,
 			accx.Referee, accx.RefereeChangeTime)
+		accountX.TransferRestrictions = accx.TransferRestrictions
+		accountX.DailySent = accx.DailySent
+		accountX.DailySentDay = accx.DailySentDay
 		keeper.SetAccountX(ctx, accountX)
 	}
+	for _, grant := range data.FeeGrants {
+		keeper.SetFeeGrant(ctx, grant)
+	}
+	// the referred accounts are derived from the referees of the accounts,
+	// which may have been set before the referral stats were tracked
+	for _, stats := range data.ReferralStats {
+		stats.ReferredAccounts = 0
+		keeper.SetReferralStats(ctx, stats)
+	}
+	for _, accx := range data.AccountXs {
+		if !accx.Referee.Empty() {
+			keeper.ChangeReferee(ctx, nil, accx.Referee)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -46,7 +67,7 @@ func ExportGenesis(ctx sdk.Context, keeper AccountXKeeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(keeper.GetParams(ctx), accountXs)
+	return NewGenesisState(keeper.GetParams(ctx), accountXs, keeper.GetFeeGrants(ctx, nil), keeper.GetAllReferralStats(ctx))
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -67,8 +88,35 @@ func (data GenesisState) ValidateGenesis() error {
 			return fmt.Errorf("duplicate accountX found in genesis state; address: %s", addrStr)
 		}
 
+		if err := accx.TransferRestrictions.Validate(); err != nil {
+			return err
+		}
+
 		addrMap[addrStr] = true
 	}
 
+	grantMap := make(map[string]bool, len(data.FeeGrants))
+	for _, grant := range data.FeeGrants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+		key := grant.Granter.String() + grant.Grantee.String()
+		if grantMap[key] {
+			return fmt.Errorf("duplicate fee grant found in genesis state; granter: %s, grantee: %s", grant.Granter, grant.Grantee)
+		}
+		grantMap[key] = true
+	}
+
+	refereeMap := make(map[string]bool, len(data.ReferralStats))
+	for _, stats := range data.ReferralStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		if refereeMap[stats.Referee.String()] {
+			return fmt.Errorf("duplicate referral stats found in genesis state; referee: %s", stats.Referee)
+		}
+		refereeMap[stats.Referee.String()] = true
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/coinexchain/cet-sdk/modules/authx"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func TestValidate(t *testing.T) {
@@ -17,22 +18,35 @@ func TestValidate(t *testing.T) {
 	genState := authx.DefaultGenesisState()
 	require.Nil(t, genState.ValidateGenesis())
 
-	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr2)})
+	genState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr2)}, nil, nil)
 	require.Nil(t, genState.ValidateGenesis())
 
-	errGenState := authx.NewGenesisState(authx.NewParams(sdk.NewDec(-1), 24*60*60*1000, 1000), []authx.AccountX{})
+	errGenState := authx.NewGenesisState(authx.NewParams(sdk.NewDec(-1), 24*60*60*1000, 1000, nil), []authx.AccountX{}, nil, nil)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(sdk.AccAddress{})})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{authx.NewAccountXWithAddress(sdk.AccAddress{})}, nil, nil)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr1)})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{authx.NewAccountXWithAddress(addr1), authx.NewAccountXWithAddress(addr1)}, nil, nil)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), -1, 1000), []authx.AccountX{})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), -1, 1000, nil), []authx.AccountX{}, nil, nil)
 	require.NotNil(t, errGenState.ValidateGenesis())
 
-	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 100000), []authx.AccountX{})
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 100000, nil), []authx.AccountX{}, nil, nil)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	grant := authx.NewFeeGrant(addr1, addr2, dex.NewCetCoins(100), 0, nil)
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{}, []authx.FeeGrant{grant, grant}, nil)
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	stats := authx.NewReferralStats(addr1)
+	stats.ReferredAccounts = 1
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{}, nil, []authx.ReferralStats{stats, stats})
+	require.NotNil(t, errGenState.ValidateGenesis())
+
+	stats.ReferredAccounts = -1
+	errGenState = authx.NewGenesisState(authx.NewParams(sdk.NewDec(10), 24*60*60*1000, 1000, nil), []authx.AccountX{}, nil, []authx.ReferralStats{stats})
 	require.NotNil(t, errGenState.ValidateGenesis())
 
 }
@@ -41,9 +55,25 @@ func TestExport(t *testing.T) {
 	accx := authx.NewAccountX(sdk.AccAddress([]byte("addr")), false, nil, nil, nil, 0)
 
 	testInput := setupTestInput()
-	genState1 := authx.NewGenesisState(authx.NewParams(sdk.NewDec(50), 1000, 1000), []authx.AccountX{accx})
+	grant := authx.NewFeeGrant(sdk.AccAddress([]byte("addr")), sdk.AccAddress([]byte("grantee")), dex.NewCetCoins(100), 0, []string{"bankx/send"})
+	referee := sdk.AccAddress([]byte("referee"))
+	referred := authx.NewAccountX(sdk.AccAddress([]byte("referred")), false, nil, nil, referee, 0)
+	stats := authx.NewReferralStats(referee)
+	stats.ReferredAccounts = 2
+	stats.TotalRebates = dex.NewCetCoins(300)
+	stats.LastRebateHeight = 10
+	genState1 := authx.NewGenesisState(authx.NewParams(sdk.NewDec(50), 1000, 1000, nil), []authx.AccountX{accx, referred}, []authx.FeeGrant{grant}, []authx.ReferralStats{stats})
 	authx.InitGenesis(testInput.ctx, testInput.axk, genState1)
 	genState2 := authx.ExportGenesis(testInput.ctx, testInput.axk)
-	require.Equal(t, genState1, genState2)
 	require.True(t, genState2.Params.Equal(genState1.Params))
+
+	// the referred accounts are counted from the referees of the accounts
+	genState1.ReferralStats[0].ReferredAccounts = 1
+	require.Equal(t, genState1, genState2)
+
+	// the referee links set before the stats were tracked are counted as well
+	testInput = setupTestInput()
+	genState1.ReferralStats = nil
+	authx.InitGenesis(testInput.ctx, testInput.axk, genState1)
+	require.Equal(t, int64(1), testInput.axk.GetReferralStats(testInput.ctx, referee).ReferredAccounts)
 }
@@ -16,6 +16,12 @@ func NewHandler(k keepers.AccountXKeeper, ak ExpectedAccountKeeper) sdk.Handler
 		switch msg := msg.(type) {
 		case types.MsgSetReferee:
 			return handleMsgSetReferee(ctx, k, ak, msg)
+		case types.MsgGrantFee:
+			return handleMsgGrantFee(ctx, k, ak, msg)
+		case types.MsgRevokeFeeGrant:
+			return handleMsgRevokeFeeGrant(ctx, k, msg)
+		case types.MsgUseFeeGrant:
+			return handleMsgUseFeeGrant(ctx, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 
@@ -35,6 +41,7 @@ func handleMsgSetReferee(ctx sdk.Context, k keepers.AccountXKeeper, ak ExpectedA
 		return err.Result()
 	}
 
+	k.ChangeReferee(ctx, senderAccx.Referee, msg.Referee)
 	senderAccx.UpdateRefereeAddr(msg.Referee, ctx.BlockTime().UnixNano())
 	k.SetAccountX(ctx, senderAccx)
 
@@ -81,3 +88,69 @@ func preCheckTime(timeNow int64, refereeChangeTime int64, refereeChangeMinInterv
 	}
 	return nil
 }
+
+func handleMsgGrantFee(ctx sdk.Context, k keepers.AccountXKeeper, ak ExpectedAccountKeeper, msg types.MsgGrantFee) sdk.Result {
+	if ak.GetAccount(ctx, msg.Granter) == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("granter %s is not exist yet", msg.Granter)).Result()
+	}
+	if k.BlacklistedAddr(msg.Grantee) {
+		return sdk.ErrInvalidAddress("grantee can not be module address").Result()
+	}
+	if msg.Expiration != 0 && msg.Expiration <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidFeeGrant(fmt.Sprintf("expiration %d is not later than the current time", msg.Expiration)).Result()
+	}
+
+	k.SetFeeGrant(ctx, msg.ToFeeGrant())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+		sdk.NewEvent(types.EventTypeGrantFee,
+			sdk.NewAttribute(types.AttributeGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.SpendLimit.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRevokeFeeGrant(ctx sdk.Context, k keepers.AccountXKeeper, msg types.MsgRevokeFeeGrant) sdk.Result {
+	if err := k.RevokeFeeGrant(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+		sdk.NewEvent(types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeGrantee, msg.Grantee.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// the fee has been paid by the granter in the ante handler
+func handleMsgUseFeeGrant(ctx sdk.Context, msg types.MsgUseFeeGrant) sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+		sdk.NewEvent(types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeGranter, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/authx/internal/types"
 	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 var (
@@ -30,6 +31,7 @@ func Test_HandleMsg(t *testing.T) {
 
 	res := handler(input.ctx, msg)
 	require.True(t, res.IsOK())
+	require.Equal(t, int64(1), input.axk.GetReferralStats(input.ctx, referee).ReferredAccounts)
 }
 
 func Test_HandleMsg_AccNotExist(t *testing.T) {
@@ -95,3 +97,29 @@ func Test_HandleMsg_RefereeChangeTooFast(t *testing.T) {
 	res = handler(ctx, msg)
 	require.True(t, res.IsOK())
 }
+
+func Test_HandleMsgFeeGrant(t *testing.T) {
+	input := setupTestInput()
+	handler := authx.NewHandler(input.axk, input.ak)
+	spendLimit := dex.NewCetCoins(100)
+	now := input.ctx.BlockHeader().Time.Unix()
+
+	msg := types.NewMsgGrantFee(sender, referee, spendLimit, 0, nil)
+	res := handler(input.ctx, msg)
+	require.Equal(t, sdk.CodeUnknownAddress, res.Code)
+
+	input.ak.SetAccount(input.ctx, input.ak.NewAccountWithAddress(input.ctx, sender))
+	msg.Expiration = now
+	res = handler(input.ctx, msg)
+	require.Equal(t, authx.CodeInvalidFeeGrant, res.Code)
+	msg.Expiration = now + 100
+	res = handler(input.ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 1, len(input.axk.GetFeeGrants(input.ctx, referee)))
+
+	res = handler(input.ctx, types.NewMsgRevokeFeeGrant(sender, referee))
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(input.axk.GetFeeGrants(input.ctx, referee)))
+	res = handler(input.ctx, types.NewMsgRevokeFeeGrant(sender, referee))
+	require.Equal(t, authx.CodeFeeGrantNotFound, res.Code)
+}
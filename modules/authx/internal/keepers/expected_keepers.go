@@ -11,6 +11,7 @@ type SupplyKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) supply.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc supply.ModuleAccountI)
+	GetSupply(ctx sdk.Context) supply.SupplyI
 	//SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
@@ -19,11 +20,14 @@ type ExpectedAccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.Account
 	SetAccount(ctx sdk.Context, acc auth.Account)
 	GetParams(ctx sdk.Context) (params auth.Params)
+	IterateAccounts(ctx sdk.Context, process func(auth.Account) (stop bool))
 }
 
 type ExpectedTokenKeeper interface {
 	UpdateTokenSendLock(ctx sdk.Context, symbol string, amount sdk.Int, lock bool) sdk.Error
+	IterateTokenSendLocks(ctx sdk.Context, process func(symbol string, sendLock sdk.Int) (stop bool))
 }
 type ExpectedBankKeeper interface {
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
@@ -0,0 +1,88 @@
+package keepers
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx/internal/types"
+)
+
+func FeeGrantKey(granter, grantee sdk.AccAddress) []byte {
+	return append(append(append([]byte{}, FeeGrantKeyPrefix...), granter.Bytes()...), grantee.Bytes()...)
+}
+
+func (axk AccountXKeeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeGrant, found bool) {
+	store := ctx.KVStore(axk.key)
+	bz := store.Get(FeeGrantKey(granter, grantee))
+	if bz == nil {
+		return
+	}
+	axk.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// GetFeeGrants returns all the fee grants, or only those given or received by addr if it is not empty
+func (axk AccountXKeeper) GetFeeGrants(ctx sdk.Context, addr sdk.AccAddress) []types.FeeGrant {
+	grants := make([]types.FeeGrant, 0)
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, FeeGrantKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeGrant
+		axk.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if addr.Empty() || bytes.Equal(grant.Granter, addr) || bytes.Equal(grant.Grantee, addr) {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+func (axk AccountXKeeper) SetFeeGrant(ctx sdk.Context, grant types.FeeGrant) {
+	store := ctx.KVStore(axk.key)
+	store.Set(FeeGrantKey(grant.Granter, grant.Grantee), axk.cdc.MustMarshalBinaryBare(grant))
+}
+
+func (axk AccountXKeeper) RevokeFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	if _, found := axk.GetFeeGrant(ctx, granter, grantee); !found {
+		return types.ErrFeeGrantNotFound(granter.String(), grantee.String())
+	}
+	ctx.KVStore(axk.key).Delete(FeeGrantKey(granter, grantee))
+	return nil
+}
+
+// UseFeeGrant moves fee from the granter to the grantee, so that the fee deducted from the
+// grantee as the first signer is actually paid by the granter. The grant must allow all the
+// msgs and its spend limit is reduced by fee, it is removed once the limit is used up.
+func (axk AccountXKeeper) UseFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) sdk.Error {
+	grant, found := axk.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time.Unix()) {
+		return types.ErrFeeGrantNotFound(granter.String(), grantee.String())
+	}
+	for _, msg := range msgs {
+		if !grant.Allows(msg) {
+			return types.ErrInvalidFeeGrant(fmt.Sprintf("message type %s is not allowed", types.MsgTypeOf(msg)))
+		}
+	}
+	// the grantee must be activated in the normal way, instead of being created by the fee
+	if axk.ak.GetAccount(ctx, grantee) == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", grantee))
+	}
+
+	remaining, neg := grant.SpendLimit.SafeSub(fee)
+	if neg {
+		return types.ErrFeeGrantExceeded(grant.SpendLimit, fee)
+	}
+	if err := axk.bk.SendCoins(ctx, granter, grantee, fee); err != nil {
+		return err
+	}
+
+	if remaining.IsZero() {
+		ctx.KVStore(axk.key).Delete(FeeGrantKey(granter, grantee))
+	} else {
+		grant.SpendLimit = remaining
+		axk.SetFeeGrant(ctx, grant)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/coinexchain/cet-sdk/modules/authx/internal/types"
@@ -15,6 +16,10 @@ import (
 var (
 	// AddressStoreKeyPrefix prefix for accountx-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
+	// FeeGrantKeyPrefix prefix for fee-grant-by-granter-and-grantee store
+	FeeGrantKeyPrefix = []byte{0x02}
+	// ReferralStatsKeyPrefix prefix for referral-stats-by-referee store
+	ReferralStatsKeyPrefix = []byte{0x03}
 
 	PrefixUnlockedCoinsQueue = []byte("UnlockedCoinsQueue")
 	KeyDelimiter             = []byte(";")
@@ -119,6 +124,11 @@ func (axk AccountXKeeper) InsertUnlockedCoinsQueue(ctx sdk.Context, unlockedTime
 	store.Set(KeyUnlockedCoinsQueue(unlockedTime, address), address)
 }
 
+func (axk AccountXKeeper) HasUnlockedCoinsQueue(ctx sdk.Context, unlockedTime int64, address sdk.AccAddress) bool {
+	store := ctx.KVStore(axk.key)
+	return store.Has(KeyUnlockedCoinsQueue(unlockedTime, address))
+}
+
 func (axk AccountXKeeper) RemoveFromUnlockedCoinsQueue(ctx sdk.Context, unlockedTime int64, address sdk.AccAddress) {
 	store := ctx.KVStore(axk.key)
 	store.Delete(KeyUnlockedCoinsQueue(unlockedTime, address))
@@ -145,6 +155,29 @@ func (axk AccountXKeeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return types.RebateRatioBase
 }
 
+// GetRebateRatios returns the rebate ratios of all referral levels, starting from level 1
+func (axk AccountXKeeper) GetRebateRatios(ctx sdk.Context) []int64 {
+	return axk.GetParams(ctx).RebateRatios()
+}
+
+// GetReferralChain returns addr's referee, the referee's referee and so on, at most as many
+// levels as there are rebate ratios. The chain stops at the first address already seen.
+func (axk AccountXKeeper) GetReferralChain(ctx sdk.Context, addr sdk.AccAddress) []sdk.AccAddress {
+	referee := axk.GetRefereeAddr(ctx, addr)
+	if len(referee) == 0 {
+		return nil
+	}
+	levels := len(axk.GetRebateRatios(ctx))
+	chain := make([]sdk.AccAddress, 0, levels)
+	seen := map[string]bool{string(addr): true}
+	for len(chain) < levels && len(referee) != 0 && !seen[string(referee)] {
+		seen[string(referee)] = true
+		chain = append(chain, referee)
+		referee = axk.GetRefereeAddr(ctx, referee)
+	}
+	return chain
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
@@ -186,6 +219,27 @@ func (axk AccountXKeeper) PreTotalSupply(ctx sdk.Context) {
 	axk.supplyKeeper.SetModuleAccount(ctx, authxMacc)
 }
 
+// GetModuleAccountCoins returns the coins of the authx module account, which mirror the locked
+// and frozen coins of all the accounts after PreTotalSupply
+func (axk AccountXKeeper) GetModuleAccountCoins(ctx sdk.Context) sdk.Coins {
+	return axk.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+}
+
+// GetCoinsOutsideAccounts returns the total supply minus the coins of all accounts except the authx
+// module account, which should be the locked and frozen coins. The result is negative when the
+// accounts hold more coins than the total supply
+func (axk AccountXKeeper) GetCoinsOutsideAccounts(ctx sdk.Context) (sdk.Coins, bool) {
+	authxAddr := axk.supplyKeeper.GetModuleAddress(types.ModuleName)
+	var inAccounts sdk.Coins
+	axk.ak.IterateAccounts(ctx, func(acc auth.Account) bool {
+		if !acc.GetAddress().Equals(authxAddr) {
+			inAccounts = inAccounts.Add(acc.GetCoins())
+		}
+		return false
+	})
+	return axk.supplyKeeper.GetSupply(ctx).GetTotal().SafeSub(inAccounts)
+}
+
 // -----------------------------------------------------------------------------
 // Keys
 
@@ -81,3 +81,63 @@ func TestIteratorAccounts(t *testing.T) {
 
 	require.Equal(t, 4, len(accxs))
 }
+
+func TestGetReferralChain(t *testing.T) {
+	input := setupTestInput()
+	params := types.DefaultParams()
+	params.ReferralRebateRatios = []int64{1000}
+	input.axk.SetParams(input.ctx, params)
+
+	addr0 := sdk.AccAddress([]byte("addr0"))
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	require.Empty(t, input.axk.GetReferralChain(input.ctx, addr0))
+
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr0, false, nil, nil, addr1, 0))
+	require.Equal(t, []sdk.AccAddress{addr1}, input.axk.GetReferralChain(input.ctx, addr0))
+
+	// the chain is cut at the number of rebate levels
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr1, false, nil, nil, addr2, 0))
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr2, false, nil, nil, addr3, 0))
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, input.axk.GetReferralChain(input.ctx, addr0))
+	require.Equal(t, []int64{2000, 1000}, input.axk.GetRebateRatios(input.ctx))
+
+	// cycles are not followed
+	input.axk.SetAccountX(input.ctx, types.NewAccountX(addr1, false, nil, nil, addr0, 0))
+	require.Equal(t, []sdk.AccAddress{addr1}, input.axk.GetReferralChain(input.ctx, addr0))
+
+	input.axk.AddRebateEarned(input.ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin("cet", 10)))
+	input.axk.AddRebateEarned(input.ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin("cet", 5)))
+	stats := input.axk.GetReferralStats(input.ctx, addr3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("cet", 15)), stats.TotalRebates)
+}
+
+func TestReferralStats(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+	referee1 := sdk.AccAddress([]byte("referee1"))
+	referee2 := sdk.AccAddress([]byte("referee2"))
+
+	stats := input.axk.GetReferralStats(ctx, referee1)
+	require.Equal(t, types.NewReferralStats(referee1), stats)
+	require.True(t, stats.IsEmpty())
+
+	input.axk.ChangeReferee(ctx, nil, referee1)
+	input.axk.ChangeReferee(ctx, nil, referee1)
+	input.axk.AddRebateEarned(ctx, referee1, sdk.NewCoins(sdk.NewInt64Coin("cet", 10)))
+	stats = input.axk.GetReferralStats(ctx, referee1)
+	require.Equal(t, int64(2), stats.ReferredAccounts)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("cet", 10)), stats.TotalRebates)
+	require.Equal(t, int64(10), stats.LastRebateHeight)
+
+	input.axk.ChangeReferee(ctx, referee1, referee2)
+	input.axk.ChangeReferee(ctx, referee2, referee2)
+	require.Equal(t, int64(1), input.axk.GetReferralStats(ctx, referee1).ReferredAccounts)
+	require.Equal(t, int64(1), input.axk.GetReferralStats(ctx, referee2).ReferredAccounts)
+	require.Len(t, input.axk.GetAllReferralStats(ctx), 2)
+
+	// stats without anything accumulated are not kept
+	input.axk.ChangeReferee(ctx, referee2, nil)
+	require.Len(t, input.axk.GetAllReferralStats(ctx), 1)
+}
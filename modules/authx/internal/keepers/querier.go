@@ -20,6 +20,10 @@ func NewQuerier(keeper AccountXKeeper) sdk.Querier {
 			return queryParameters(ctx, keeper)
 		case types.QueryAccountMix:
 			return queryAccountMix(ctx, req, keeper)
+		case types.QueryFeeGrants:
+			return queryFeeGrants(ctx, req, keeper)
+		case types.QueryReferrals:
+			return queryReferrals(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown authx query endpoint")
 		}
@@ -43,7 +47,7 @@ func queryAccountMix(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeep
 		aux = types.AccountX{}
 	}
 
-	mix := types.NewAccountMix(au, aux)
+	mix := types.NewAccountMix(au, aux, keeper.GetReferralStats(ctx, addr))
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, mix)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -51,6 +55,32 @@ func queryAccountMix(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeep
 
 	return bz, nil
 }
+func queryFeeGrants(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeeper) ([]byte, sdk.Error) {
+	var params auth.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetFeeGrants(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryReferrals(ctx sdk.Context, req abci.RequestQuery, keeper AccountXKeeper) ([]byte, sdk.Error) {
+	var params auth.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetReferralStats(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryParameters(ctx sdk.Context, k AccountXKeeper) ([]byte, sdk.Error) {
 	params := k.ak.GetParams(ctx)
 	paramsx := k.GetParams(ctx)
@@ -13,6 +13,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/authx/internal/types"
 	"github.com/coinexchain/cet-sdk/testapp"
 	"github.com/coinexchain/cet-sdk/testutil"
+	dex "github.com/coinexchain/cet-sdk/types"
 )
 
 func Test_queryParams(t *testing.T) {
@@ -70,4 +71,12 @@ func Test_queryAccount(t *testing.T) {
 	res, err = query(input.ctx, path0, req)
 	require.Nil(t, err)
 	require.NotNil(t, res)
+
+	// the rebates are read from the referral stats
+	input.axk.AddRebateEarned(input.ctx, addr, dex.NewCetCoins(10))
+	res, err = query(input.ctx, path0, req)
+	require.Nil(t, err)
+	var mix types.AccountMix
+	input.cdc.MustUnmarshalJSON(res, &mix)
+	require.Equal(t, dex.NewCetCoins(10), mix.RebateEarned)
 }
@@ -0,0 +1,73 @@
+package keepers
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx/internal/types"
+)
+
+func ReferralStatsKey(referee sdk.AccAddress) []byte {
+	return append(append([]byte{}, ReferralStatsKeyPrefix...), referee.Bytes()...)
+}
+
+// GetReferralStats returns the stats of referee, which are empty if nothing has been accumulated yet
+func (axk AccountXKeeper) GetReferralStats(ctx sdk.Context, referee sdk.AccAddress) types.ReferralStats {
+	bz := ctx.KVStore(axk.key).Get(ReferralStatsKey(referee))
+	if bz == nil {
+		return types.NewReferralStats(referee)
+	}
+	var stats types.ReferralStats
+	axk.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+func (axk AccountXKeeper) GetAllReferralStats(ctx sdk.Context) []types.ReferralStats {
+	allStats := make([]types.ReferralStats, 0)
+	store := ctx.KVStore(axk.key)
+	iter := sdk.KVStorePrefixIterator(store, ReferralStatsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats types.ReferralStats
+		axk.cdc.MustUnmarshalBinaryBare(iter.Value(), &stats)
+		allStats = append(allStats, stats)
+	}
+	return allStats
+}
+
+func (axk AccountXKeeper) SetReferralStats(ctx sdk.Context, stats types.ReferralStats) {
+	store := ctx.KVStore(axk.key)
+	if stats.IsEmpty() {
+		store.Delete(ReferralStatsKey(stats.Referee))
+		return
+	}
+	store.Set(ReferralStatsKey(stats.Referee), axk.cdc.MustMarshalBinaryBare(stats))
+}
+
+// AddRebateEarned accumulates the rebates a referee has received
+func (axk AccountXKeeper) AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins) {
+	stats := axk.GetReferralStats(ctx, referee)
+	stats.TotalRebates = stats.TotalRebates.Add(amt)
+	stats.LastRebateHeight = ctx.BlockHeight()
+	axk.SetReferralStats(ctx, stats)
+}
+
+// ChangeReferee moves one referred account from the old referee to the new one
+func (axk AccountXKeeper) ChangeReferee(ctx sdk.Context, oldReferee, newReferee sdk.AccAddress) {
+	if bytes.Equal(oldReferee, newReferee) {
+		return
+	}
+	if !oldReferee.Empty() {
+		stats := axk.GetReferralStats(ctx, oldReferee)
+		if stats.ReferredAccounts > 0 {
+			stats.ReferredAccounts--
+		}
+		axk.SetReferralStats(ctx, stats)
+	}
+	if !newReferee.Empty() {
+		stats := axk.GetReferralStats(ctx, newReferee)
+		stats.ReferredAccounts++
+		axk.SetReferralStats(ctx, stats)
+	}
+}
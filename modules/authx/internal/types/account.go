@@ -16,6 +16,10 @@ type AccountX struct {
 	FrozenCoins       sdk.Coins      `json:"frozen_coins"`
 	Referee           sdk.AccAddress `json:"referee,omitempty"`             // DEX2
 	RefereeChangeTime int64          `json:"referee_change_time,omitempty"` // DEX2
+
+	TransferRestrictions TransferRestrictions `json:"transfer_restrictions"`
+	DailySent            sdk.Coins            `json:"daily_sent,omitempty"`     // sent during DailySentDay
+	DailySentDay         int64                `json:"daily_sent_day,omitempty"` // unix time divided by SecondsPerDay
 }
 
 type AccountXs []AccountX
@@ -70,8 +74,8 @@ func (acc *AccountX) GetLockedCoinsByDemon(demon string) LockedCoins {
 func (acc *AccountX) GetUnlockedCoinsAtTheTime(demon string, time int64) LockedCoins {
 	var coins LockedCoins
 	for _, c := range acc.GetLockedCoinsByDemon(demon) {
-		if c.UnlockTime <= time {
-			coins = append(coins, c)
+		if vested, ok := c.vestedPart(time); ok {
+			coins = append(coins, vested)
 		}
 	}
 	return coins
@@ -80,8 +84,8 @@ func (acc *AccountX) GetUnlockedCoinsAtTheTime(demon string, time int64) LockedC
 func (acc *AccountX) GetAllUnlockedCoinsAtTheTime(time int64) LockedCoins {
 	var coins LockedCoins
 	for _, c := range acc.GetAllLockedCoins() {
-		if c.UnlockTime <= time {
-			coins = append(coins, c)
+		if vested, ok := c.vestedPart(time); ok {
+			coins = append(coins, vested)
 		}
 	}
 	return coins
@@ -129,9 +133,10 @@ type AccountMix struct {
 	MemoRequired      bool           `json:"memo_required"` // if memo is required for receiving coins
 	Referee           sdk.AccAddress `json:"referee"`
 	RefereeChangeTime int64          `json:"referee_change_time"`
+	RebateEarned      sdk.Coins      `json:"rebate_earned"` // rebates received as a referee of other accounts
 }
 
-func NewAccountMix(acc auth.Account, x AccountX) AccountMix {
+func NewAccountMix(acc auth.Account, x AccountX, stats ReferralStats) AccountMix {
 	return AccountMix{
 		acc.GetAddress(),
 		acc.GetCoins(),
@@ -143,5 +148,6 @@ func NewAccountMix(acc auth.Account, x AccountX) AccountMix {
 		x.IsMemoRequired(),
 		x.Referee,
 		x.RefereeChangeTime,
+		stats.TotalRebates,
 	}
 }
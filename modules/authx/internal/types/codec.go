@@ -14,4 +14,7 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(AccountX{}, "authx/AccountX", nil)
 	cdc.RegisterConcrete(MsgSetReferee{}, "authx/MsgSetReferee", nil)
+	cdc.RegisterConcrete(MsgGrantFee{}, "authx/MsgGrantFee", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeGrant{}, "authx/MsgRevokeFeeGrant", nil)
+	cdc.RegisterConcrete(MsgUseFeeGrant{}, "authx/MsgUseFeeGrant", nil)
 }
@@ -13,6 +13,9 @@ const (
 	CodeRefereeChangeTooFast    sdk.CodeType = 203
 	CodeRefereeMemoRequired     sdk.CodeType = 204
 	CodeRefereeCanNotBeYourself sdk.CodeType = 205
+	CodeInvalidFeeGrant         sdk.CodeType = 206
+	CodeFeeGrantNotFound        sdk.CodeType = 207
+	CodeFeeGrantExceeded        sdk.CodeType = 208
 )
 
 func ErrInvalidMinGasPriceLimit(limit sdk.Dec) sdk.Error {
@@ -33,3 +36,12 @@ func ErrRefereeMemoRequired(referee string) sdk.Error {
 func ErrRefereeCanNotBeYouself(referee string) sdk.Error {
 	return sdk.NewError(CodeSpaceAuthX, CodeRefereeCanNotBeYourself, "referee %s can not be yourself", referee)
 }
+func ErrInvalidFeeGrant(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceAuthX, CodeInvalidFeeGrant, msg)
+}
+func ErrFeeGrantNotFound(granter, grantee string) sdk.Error {
+	return sdk.NewError(CodeSpaceAuthX, CodeFeeGrantNotFound, "fee grant from %s to %s not found", granter, grantee)
+}
+func ErrFeeGrantExceeded(spendLimit, fee sdk.Coins) sdk.Error {
+	return sdk.NewError(CodeSpaceAuthX, CodeFeeGrantExceeded, "fee %s exceeds the spend limit %s", fee, spendLimit)
+}
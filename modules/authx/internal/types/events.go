@@ -3,8 +3,13 @@ package types
 const (
 	AttributeValueCategory = ModuleName
 
-	EventTypeSetReferee = "set_referee"
+	EventTypeSetReferee     = "set_referee"
+	EventTypeGrantFee       = "grant_fee"
+	EventTypeRevokeFeeGrant = "revoke_fee_grant"
+	EventTypeUseFeeGrant    = "use_fee_grant"
 
 	AttributeReferee           = "referee_addr"
 	AttributeRefereeChangeTime = "referee_change_time"
+	AttributeGranter           = "granter"
+	AttributeGrantee           = "grantee"
 )
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeGrant lets the grantee pay transaction fees with the granter's coins, at most SpendLimit
+// in total and until Expiration if it is not zero. If AllowedMsgTypes is not empty, the
+// grant can only be used by transactions whose messages all have one of these types.
+type FeeGrant struct {
+	Granter         sdk.AccAddress `json:"granter"`
+	Grantee         sdk.AccAddress `json:"grantee"`
+	SpendLimit      sdk.Coins      `json:"spend_limit"`
+	Expiration      int64          `json:"expiration"`
+	AllowedMsgTypes []string       `json:"allowed_msg_types"`
+}
+
+func NewFeeGrant(granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration int64, allowedMsgTypes []string) FeeGrant {
+	return FeeGrant{
+		Granter:         granter,
+		Grantee:         grantee,
+		SpendLimit:      spendLimit,
+		Expiration:      expiration,
+		AllowedMsgTypes: allowedMsgTypes,
+	}
+}
+
+func (g FeeGrant) Validate() sdk.Error {
+	if g.Granter.Empty() || g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing granter or grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrInvalidFeeGrant("granter and grantee must be different")
+	}
+	if !g.SpendLimit.IsValid() || !g.SpendLimit.IsAllPositive() {
+		return sdk.ErrInvalidCoins("spend limit is invalid: " + g.SpendLimit.String())
+	}
+	if g.Expiration < 0 || g.Expiration > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidFeeGrant("invalid expiration")
+	}
+	for _, msgType := range g.AllowedMsgTypes {
+		if len(msgType) == 0 {
+			return ErrInvalidFeeGrant("empty message type")
+		}
+	}
+	return nil
+}
+
+// IsExpired returns whether the grant can no longer be used at the time
+func (g FeeGrant) IsExpired(time int64) bool {
+	return g.Expiration != 0 && g.Expiration <= time
+}
+
+// Allows returns whether the grant can pay the fee of a transaction carrying msg
+func (g FeeGrant) Allows(msg sdk.Msg) bool {
+	if len(g.AllowedMsgTypes) == 0 {
+		return true
+	}
+	msgType := MsgTypeOf(msg)
+	for _, allowed := range g.AllowedMsgTypes {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+func (g FeeGrant) String() string {
+	return fmt.Sprintf(`FeeGrant:
+  Granter:         %s
+  Grantee:         %s
+  SpendLimit:      %s
+  Expiration:      %d
+  AllowedMsgTypes: %s`,
+		g.Granter, g.Grantee, g.SpendLimit, g.Expiration, strings.Join(g.AllowedMsgTypes, ","))
+}
+
+// MsgTypeOf returns the type of msg used by AllowedMsgTypes, such as "bankx/send"
+func MsgTypeOf(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
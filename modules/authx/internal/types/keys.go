@@ -13,4 +13,6 @@ const (
 const (
 	QueryParameters = "parameters"
 	QueryAccountMix = "accountMix"
+	QueryFeeGrants  = "feeGrants"
+	QueryReferrals  = "referrals"
 )
@@ -16,6 +16,13 @@ type LockedCoin struct {
 	FromAddress sdk.AccAddress `json:"from_address,omitempty"`
 	Supervisor  sdk.AccAddress `json:"supervisor,omitempty"`
 	Reward      int64          `json:"reward,omitempty"`
+
+	// A vesting locked coin is released in equal slices every VestingPeriod seconds,
+	// VestedTime is the last boundary at which a slice was released and UnlockTime
+	// is the end of the schedule, Coin holds the amount which is still locked.
+	VestingPeriod int64 `json:"vesting_period,omitempty"`
+	VestedTime    int64 `json:"vested_time,omitempty"`
+	CliffTime     int64 `json:"cliff_time,omitempty"`
 }
 
 func NewLockedCoin(denom string, amount sdk.Int, unlockTime int64) LockedCoin {
@@ -35,6 +42,68 @@ func NewSupervisedLockedCoin(denom string, amount sdk.Int, unlockTime int64, fro
 	}
 }
 
+func NewVestingLockedCoin(denom string, amount sdk.Int, startTime, cliffTime, period, periods int64) LockedCoin {
+	return LockedCoin{
+		Coin:          sdk.NewCoin(denom, amount),
+		UnlockTime:    startTime + period*periods,
+		VestingPeriod: period,
+		VestedTime:    startTime,
+		CliffTime:     cliffTime,
+	}
+}
+
+func (coin LockedCoin) IsVesting() bool {
+	return coin.VestingPeriod > 0
+}
+
+// VestedAt returns the amount which is released at the given time and the boundary
+// of the last released slice, a plain locked coin is released as a whole at UnlockTime.
+func (coin LockedCoin) VestedAt(time int64) (sdk.Coin, int64) {
+	if !coin.IsVesting() {
+		if coin.UnlockTime <= time {
+			return coin.Coin, coin.UnlockTime
+		}
+		return sdk.NewCoin(coin.Coin.Denom, sdk.ZeroInt()), coin.VestedTime
+	}
+	if time < coin.CliffTime || time < coin.VestedTime+coin.VestingPeriod {
+		return sdk.NewCoin(coin.Coin.Denom, sdk.ZeroInt()), coin.VestedTime
+	}
+	if coin.UnlockTime <= time {
+		return coin.Coin, coin.UnlockTime
+	}
+	remaining := (coin.UnlockTime - coin.VestedTime) / coin.VestingPeriod
+	elapsed := (time - coin.VestedTime) / coin.VestingPeriod
+	amount := coin.Coin.Amount.MulRaw(elapsed).QuoRaw(remaining)
+	return sdk.NewCoin(coin.Coin.Denom, amount), coin.VestedTime + elapsed*coin.VestingPeriod
+}
+
+// NextVestingTime returns the earliest time at which another slice can be released
+func (coin LockedCoin) NextVestingTime() int64 {
+	if !coin.IsVesting() {
+		return coin.UnlockTime
+	}
+	next := coin.VestedTime + coin.VestingPeriod
+	if next < coin.CliffTime {
+		next = coin.CliffTime
+	}
+	return next
+}
+
+// vestedPart returns the part of the coin released at the given time, the UnlockTime
+// of a vesting coin's part is the boundary of its last released slice.
+func (coin LockedCoin) vestedPart(time int64) (LockedCoin, bool) {
+	if !coin.IsVesting() {
+		return coin, coin.UnlockTime <= time
+	}
+	vested, boundary := coin.VestedAt(time)
+	if !vested.IsPositive() {
+		return coin, false
+	}
+	coin.Coin = vested
+	coin.UnlockTime = boundary
+	return coin, true
+}
+
 func (coin LockedCoin) String() string {
 	str := fmt.Sprintf("coin: %s, unlocked_time: %d", coin.Coin, coin.UnlockTime)
 	if coin.FromAddress != nil {
@@ -43,6 +112,9 @@ func (coin LockedCoin) String() string {
 	if coin.Supervisor != nil {
 		str += fmt.Sprintf(", supervisor: %s, reward: %d", coin.Supervisor.String(), coin.Reward)
 	}
+	if coin.IsVesting() {
+		str += fmt.Sprintf(", vesting_period: %d, vested_time: %d, cliff_time: %d", coin.VestingPeriod, coin.VestedTime, coin.CliffTime)
+	}
 	str += "\n"
 	return str
 }
@@ -51,6 +123,9 @@ func (coin LockedCoin) IsEqual(other LockedCoin) bool {
 	return coin.Coin.IsEqual(other.Coin) &&
 		coin.UnlockTime == other.UnlockTime &&
 		coin.Reward == other.Reward &&
+		coin.VestingPeriod == other.VestingPeriod &&
+		coin.VestedTime == other.VestedTime &&
+		coin.CliffTime == other.CliffTime &&
 		bytes.Equal(coin.FromAddress, other.FromAddress) &&
 		bytes.Equal(coin.Supervisor, other.Supervisor)
 }
@@ -43,3 +43,122 @@ func (msg MsgSetReferee) GetSignBytes() []byte {
 func (msg MsgSetReferee) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+var _ sdk.Msg = MsgGrantFee{}
+
+// MsgGrantFee replaces the fee grant from the granter to the grantee
+type MsgGrantFee struct {
+	Granter         sdk.AccAddress `json:"granter"`
+	Grantee         sdk.AccAddress `json:"grantee"`
+	SpendLimit      sdk.Coins      `json:"spend_limit"`
+	Expiration      int64          `json:"expiration"`
+	AllowedMsgTypes []string       `json:"allowed_msg_types"`
+}
+
+func NewMsgGrantFee(granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration int64, allowedMsgTypes []string) MsgGrantFee {
+	return MsgGrantFee{
+		Granter:         granter,
+		Grantee:         grantee,
+		SpendLimit:      spendLimit,
+		Expiration:      expiration,
+		AllowedMsgTypes: allowedMsgTypes,
+	}
+}
+
+func (msg *MsgGrantFee) SetAccAddress(addr sdk.AccAddress) {
+	msg.Granter = addr
+}
+
+func (msg MsgGrantFee) Route() string { return RouteKey }
+
+func (msg MsgGrantFee) Type() string { return "grant_fee" }
+
+func (msg MsgGrantFee) ValidateBasic() sdk.Error {
+	return msg.ToFeeGrant().Validate()
+}
+
+func (msg MsgGrantFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgGrantFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgGrantFee) ToFeeGrant() FeeGrant {
+	return NewFeeGrant(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration, msg.AllowedMsgTypes)
+}
+
+var _ sdk.Msg = MsgRevokeFeeGrant{}
+
+type MsgRevokeFeeGrant struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeGrant(granter, grantee sdk.AccAddress) MsgRevokeFeeGrant {
+	return MsgRevokeFeeGrant{Granter: granter, Grantee: grantee}
+}
+
+func (msg *MsgRevokeFeeGrant) SetAccAddress(addr sdk.AccAddress) {
+	msg.Granter = addr
+}
+
+func (msg MsgRevokeFeeGrant) Route() string { return RouteKey }
+
+func (msg MsgRevokeFeeGrant) Type() string { return "revoke_fee_grant" }
+
+func (msg MsgRevokeFeeGrant) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() || msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	return nil
+}
+
+func (msg MsgRevokeFeeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeFeeGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+var _ sdk.Msg = MsgUseFeeGrant{}
+
+// MsgUseFeeGrant names the granter who pays the fee of the transaction carrying it, the grantee
+// must be the first signer of the transaction. The fee is paid by the ante handler, so handling
+// this message does nothing else than emitting an event.
+type MsgUseFeeGrant struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Granter sdk.AccAddress `json:"granter"`
+}
+
+func NewMsgUseFeeGrant(grantee, granter sdk.AccAddress) MsgUseFeeGrant {
+	return MsgUseFeeGrant{Grantee: grantee, Granter: granter}
+}
+
+func (msg *MsgUseFeeGrant) SetAccAddress(addr sdk.AccAddress) {
+	msg.Grantee = addr
+}
+
+func (msg MsgUseFeeGrant) Route() string { return RouteKey }
+
+func (msg MsgUseFeeGrant) Type() string { return "use_fee_grant" }
+
+func (msg MsgUseFeeGrant) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() || msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return ErrInvalidFeeGrant("granter and grantee must be different")
+	}
+	return nil
+}
+
+func (msg MsgUseFeeGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgUseFeeGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
@@ -63,3 +63,21 @@ func TestMsgSetReferee_Routesg(t *testing.T) {
 	msg := NewMsgSetReferee(sender, referee)
 	require.Equal(t, msg.Route(), ModuleName)
 }
+
+func TestMsgFeeGrant_ValidateBasic(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("cet", 100))
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgGrantFee(sender, referee, limit, 0, nil)},
+		{Valid: true, Msg: NewMsgGrantFee(sender, referee, limit, 1000, []string{"bankx/send"})},
+		{Valid: false, Msg: NewMsgGrantFee(noneAddr, referee, limit, 0, nil)},
+		{Valid: false, Msg: NewMsgGrantFee(sender, sender, limit, 0, nil)},
+		{Valid: false, Msg: NewMsgGrantFee(sender, referee, nil, 0, nil)},
+		{Valid: false, Msg: NewMsgGrantFee(sender, referee, limit, -1, nil)},
+		{Valid: false, Msg: NewMsgGrantFee(sender, referee, limit, 0, []string{""})},
+		{Valid: true, Msg: NewMsgRevokeFeeGrant(sender, referee)},
+		{Valid: false, Msg: NewMsgRevokeFeeGrant(sender, noneAddr)},
+		{Valid: true, Msg: NewMsgUseFeeGrant(referee, sender)},
+		{Valid: false, Msg: NewMsgUseFeeGrant(sender, sender)},
+	})
+}
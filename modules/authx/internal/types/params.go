@@ -27,6 +27,9 @@ const (
 	DefaultRefereeChangeMinInterval = time.Second * 24 * 60 * 60 * 7
 	DefaultRebateRatio              = 2000
 	RebateRatioBase                 = 10000
+
+	// MaxReferralLevels limits how far up the referee chain rebates are paid
+	MaxReferralLevels = 3
 )
 
 // Parameter keys
@@ -34,6 +37,7 @@ var (
 	KeyMinGasPriceLimit         = []byte("MinGasPriceLimit")
 	KeyRefereeChangeMinInterval = []byte("RefereeChangeMinInterval")
 	KeyRebateRatio              = []byte("RebateRatio")
+	KeyReferralRebateRatios     = []byte("ReferralRebateRatios")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -43,6 +47,8 @@ type Params struct {
 	MinGasPriceLimit         sdk.Dec `json:"min_gas_price_limit"`
 	RefereeChangeMinInterval int64   `json:"referee_change_min_interval"` // DEX2
 	RebateRatio              int64   `json:"rebate_ratio"`                // DEX2
+	// ratios paid to the level-2, level-3, ... referees, level 1 uses RebateRatio
+	ReferralRebateRatios []int64 `json:"referral_rebate_ratios"`
 }
 
 // ParamKeyTable for authx module
@@ -59,11 +65,12 @@ func DefaultParams() Params {
 	}
 }
 
-func NewParams(minGasPriceLimit sdk.Dec, refreeChangeMinInterval int64, rebateRatio int64) Params {
+func NewParams(minGasPriceLimit sdk.Dec, refreeChangeMinInterval int64, rebateRatio int64, referralRebateRatios []int64) Params {
 	return Params{
 		MinGasPriceLimit:         minGasPriceLimit,
 		RefereeChangeMinInterval: refreeChangeMinInterval,
 		RebateRatio:              rebateRatio,
+		ReferralRebateRatios:     referralRebateRatios,
 	}
 }
 
@@ -74,6 +81,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyMinGasPriceLimit, Value: &p.MinGasPriceLimit},
 		{Key: KeyRefereeChangeMinInterval, Value: &p.RefereeChangeMinInterval},
 		{Key: KeyRebateRatio, Value: &p.RebateRatio},
+		{Key: KeyReferralRebateRatios, Value: &p.ReferralRebateRatios},
 	}
 }
 
@@ -99,5 +107,23 @@ func (p *Params) ValidateGenesis() error {
 	if p.RebateRatio <= 0 || p.RebateRatio > 10000 {
 		return fmt.Errorf("RebateRatio must be in range of 1 to 10000, is %d", p.RebateRatio)
 	}
+	if len(p.ReferralRebateRatios) >= MaxReferralLevels {
+		return fmt.Errorf("%s can have at most %d levels, has %d", KeyReferralRebateRatios, MaxReferralLevels-1, len(p.ReferralRebateRatios))
+	}
+	total := p.RebateRatio
+	for _, ratio := range p.ReferralRebateRatios {
+		if ratio <= 0 || ratio > RebateRatioBase {
+			return fmt.Errorf("%s must be in range of 1 to 10000, is %d", KeyReferralRebateRatios, ratio)
+		}
+		total += ratio
+	}
+	if total > RebateRatioBase {
+		return fmt.Errorf("sum of all rebate ratios must not exceed %d, is %d", RebateRatioBase, total)
+	}
 	return nil
 }
+
+// RebateRatios returns the rebate ratios of all referral levels, starting from level 1
+func (p Params) RebateRatios() []int64 {
+	return append([]int64{p.RebateRatio}, p.ReferralRebateRatios...)
+}
@@ -17,6 +17,7 @@ type MergedParams struct {
 	MinGasPriceLimit         sdk.Dec `json:"min_gas_price_limit" yaml:"min_gas_price_limit"`
 	RefereeChangeMinInterval int64   `json:"referee_change_min_interval" yaml:"referee_change_min_interval"`
 	RebateRatio              int64   `json:"rebate_ratio" yaml:"rebate_ratio"`
+	ReferralRebateRatios     []int64 `json:"referral_rebate_ratios" yaml:"referral_rebate_ratios"`
 }
 
 func NewMergedParams(params auth.Params, paramsx Params) MergedParams {
@@ -29,6 +30,7 @@ func NewMergedParams(params auth.Params, paramsx Params) MergedParams {
 		MinGasPriceLimit:         paramsx.MinGasPriceLimit,
 		RefereeChangeMinInterval: paramsx.RefereeChangeMinInterval,
 		RebateRatio:              paramsx.RebateRatio,
+		ReferralRebateRatios:     paramsx.ReferralRebateRatios,
 	}
 }
 
@@ -44,5 +46,6 @@ func (p MergedParams) String() string {
 	sb.WriteString(fmt.Sprintf("MinGasPriceLimit: %s\n", p.MinGasPriceLimit))
 	sb.WriteString(fmt.Sprintf("RefereeChangeMinInterval: %d\n", p.RefereeChangeMinInterval))
 	sb.WriteString(fmt.Sprintf("RebateRatio: %d\n", p.RebateRatio))
+	sb.WriteString(fmt.Sprintf("ReferralRebateRatios: %v\n", p.ReferralRebateRatios))
 	return sb.String()
 }
@@ -10,7 +10,26 @@ import (
 
 func TestParams_Equal(t *testing.T) {
 	param := DefaultParams()
-	param2 := NewParams(sdk.MustNewDecFromStr("20.0"), 7*24*60*60*1000000000, 2000)
+	param2 := NewParams(sdk.MustNewDecFromStr("20.0"), 7*24*60*60*1000000000, 2000, []int64{})
 	b := param.Equal(param2)
 	require.Equal(t, true, b)
 }
+
+func TestParams_ReferralRebateRatios(t *testing.T) {
+	param := DefaultParams()
+	require.Nil(t, param.ValidateGenesis())
+	require.Equal(t, []int64{2000}, param.RebateRatios())
+
+	param.ReferralRebateRatios = []int64{1000, 500}
+	require.Nil(t, param.ValidateGenesis())
+	require.Equal(t, []int64{2000, 1000, 500}, param.RebateRatios())
+
+	param.ReferralRebateRatios = []int64{1000, 500, 100}
+	require.NotNil(t, param.ValidateGenesis())
+
+	param.ReferralRebateRatios = []int64{0}
+	require.NotNil(t, param.ValidateGenesis())
+
+	param.ReferralRebateRatios = []int64{8001}
+	require.NotNil(t, param.ValidateGenesis())
+}
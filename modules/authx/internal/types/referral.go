@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReferralStats accumulates what a referee has earned from the accounts it referred.
+// ReferredAccounts counts the accounts using Referee as their level-1 referee, while
+// TotalRebates includes the rebates received as a referee of any level.
+type ReferralStats struct {
+	Referee          sdk.AccAddress `json:"referee"`
+	ReferredAccounts int64          `json:"referred_accounts"`
+	TotalRebates     sdk.Coins      `json:"total_rebates"`
+	LastRebateHeight int64          `json:"last_rebate_height"`
+}
+
+func NewReferralStats(referee sdk.AccAddress) ReferralStats {
+	return ReferralStats{
+		Referee:      referee,
+		TotalRebates: sdk.Coins{},
+	}
+}
+
+func (s ReferralStats) Validate() sdk.Error {
+	if s.Referee.Empty() {
+		return sdk.ErrInvalidAddress("missing referee address")
+	}
+	if s.ReferredAccounts < 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("referred accounts must not be negative, is %d", s.ReferredAccounts))
+	}
+	if !s.TotalRebates.IsValid() {
+		return sdk.ErrInvalidCoins("total rebates are invalid: " + s.TotalRebates.String())
+	}
+	if s.LastRebateHeight < 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("last rebate height must not be negative, is %d", s.LastRebateHeight))
+	}
+	return nil
+}
+
+// IsEmpty returns whether nothing has been accumulated for the referee
+func (s ReferralStats) IsEmpty() bool {
+	return s.ReferredAccounts == 0 && s.TotalRebates.Empty() && s.LastRebateHeight == 0
+}
+
+func (s ReferralStats) String() string {
+	return fmt.Sprintf(`ReferralStats:
+  Referee:          %s
+  ReferredAccounts: %d
+  TotalRebates:     %s
+  LastRebateHeight: %d`,
+		s.Referee, s.ReferredAccounts, s.TotalRebates, s.LastRebateHeight)
+}
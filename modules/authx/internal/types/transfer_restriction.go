@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	SecondsPerDay = 24 * 60 * 60
+
+	MaxReceiveWhitelistSize = 100
+)
+
+// TransferRestrictions are the opt-in protections of an account against unexpected transfers
+type TransferRestrictions struct {
+	ReceiveWhitelist []sdk.AccAddress `json:"receive_whitelist"` // if not empty, only these addresses can send coins to the account
+	DailySendLimit   sdk.Coins        `json:"daily_send_limit"`  // the most of each listed denom the account can send per day
+	DelayThreshold   sdk.Coins        `json:"delay_threshold"`   // sending at least this much of a listed denom is delayed
+	TransferDelay    int64            `json:"transfer_delay"`    // seconds a transfer is delayed, it can be cancelled meanwhile
+}
+
+func NewTransferRestrictions(receiveWhitelist []sdk.AccAddress, dailySendLimit, delayThreshold sdk.Coins, transferDelay int64) TransferRestrictions {
+	return TransferRestrictions{
+		ReceiveWhitelist: receiveWhitelist,
+		DailySendLimit:   dailySendLimit,
+		DelayThreshold:   delayThreshold,
+		TransferDelay:    transferDelay,
+	}
+}
+
+func (r TransferRestrictions) Validate() error {
+	if len(r.ReceiveWhitelist) > MaxReceiveWhitelistSize {
+		return fmt.Errorf("receive whitelist can have at most %d addresses", MaxReceiveWhitelistSize)
+	}
+	for _, addr := range r.ReceiveWhitelist {
+		if addr.Empty() {
+			return fmt.Errorf("empty address in receive whitelist")
+		}
+	}
+	if !r.DailySendLimit.IsValid() {
+		return fmt.Errorf("daily send limit is invalid: %s", r.DailySendLimit)
+	}
+	if !r.DelayThreshold.IsValid() {
+		return fmt.Errorf("delay threshold is invalid: %s", r.DelayThreshold)
+	}
+	if r.TransferDelay < 0 || r.TransferDelay > math.MaxInt64/int64(time.Second) {
+		return fmt.Errorf("invalid transfer delay %d", r.TransferDelay)
+	}
+	if r.TransferDelay == 0 && !r.DelayThreshold.Empty() {
+		return fmt.Errorf("transfer delay must be positive when delay threshold is set")
+	}
+	return nil
+}
+
+// CanReceiveFrom returns whether the account accepts coins sent by addr
+func (acc *AccountX) CanReceiveFrom(addr sdk.AccAddress) bool {
+	whitelist := acc.TransferRestrictions.ReceiveWhitelist
+	if len(whitelist) == 0 {
+		return true
+	}
+	for _, allowed := range whitelist {
+		if allowed.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDailySent returns the coins sent on the day of time
+func (acc *AccountX) GetDailySent(time int64) sdk.Coins {
+	if acc.DailySentDay != time/SecondsPerDay {
+		return sdk.Coins{}
+	}
+	return acc.DailySent
+}
+
+// ExceedsDailySendLimit returns whether sending amt at time exceeds the daily send limit
+func (acc *AccountX) ExceedsDailySendLimit(amt sdk.Coins, time int64) bool {
+	sent := acc.GetDailySent(time).Add(amt)
+	for _, limit := range acc.TransferRestrictions.DailySendLimit {
+		if sent.AmountOf(limit.Denom).GT(limit.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddDailySent records amt as sent at time, only the denoms under the daily send limit are recorded
+func (acc *AccountX) AddDailySent(amt sdk.Coins, time int64) {
+	sent := acc.GetDailySent(time)
+	for _, limit := range acc.TransferRestrictions.DailySendLimit {
+		if amount := amt.AmountOf(limit.Denom); amount.IsPositive() {
+			sent = sent.Add(sdk.Coins{sdk.NewCoin(limit.Denom, amount)})
+		}
+	}
+	acc.DailySent = sent
+	acc.DailySentDay = time / SecondsPerDay
+}
+
+// NeedsTransferDelay returns whether sending amt must be delayed
+func (acc *AccountX) NeedsTransferDelay(amt sdk.Coins) bool {
+	for _, threshold := range acc.TransferRestrictions.DelayThreshold {
+		if amt.AmountOf(threshold.Denom).GTE(threshold.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLoosenedBy returns whether replacing r with next lets any transfer through sooner or at all,
+// which is when next trusts a new sender, raises or drops a limit or threshold, or shortens the delay
+func (r TransferRestrictions) IsLoosenedBy(next TransferRestrictions) bool {
+	if len(r.ReceiveWhitelist) != 0 {
+		if len(next.ReceiveWhitelist) == 0 {
+			return true
+		}
+		for _, addr := range next.ReceiveWhitelist {
+			if !containsAddress(r.ReceiveWhitelist, addr) {
+				return true
+			}
+		}
+	}
+	if isRaisedBy(r.DailySendLimit, next.DailySendLimit) || isRaisedBy(r.DelayThreshold, next.DelayThreshold) {
+		return true
+	}
+	return next.TransferDelay < r.TransferDelay
+}
+
+// isRaisedBy returns whether next drops or raises any of the amounts in limits
+func isRaisedBy(limits, next sdk.Coins) bool {
+	for _, limit := range limits {
+		amount := next.AmountOf(limit.Denom)
+		if !amount.IsPositive() || amount.GT(limit.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferRestrictions_IsLoosenedBy(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1")
+	addr2 := sdk.AccAddress("addr2")
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("cet", amount)) }
+	current := NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(100), coins(10), 3600)
+
+	tightened := []TransferRestrictions{
+		current,
+		NewTransferRestrictions([]sdk.AccAddress{addr1}, coins(100), coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(50), coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(100), coins(5), 7200),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2},
+			coins(100).Add(sdk.NewCoins(sdk.NewInt64Coin("abc", 1))), coins(10), 3600),
+	}
+	for _, next := range tightened {
+		require.False(t, current.IsLoosenedBy(next), next)
+	}
+
+	loosened := []TransferRestrictions{
+		NewTransferRestrictions(nil, coins(100), coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, sdk.AccAddress("addr3")}, coins(100), coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, nil, coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(200), coins(10), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(100), coins(20), 3600),
+		NewTransferRestrictions([]sdk.AccAddress{addr1, addr2}, coins(100), coins(10), 60),
+	}
+	for _, next := range loosened {
+		require.True(t, current.IsLoosenedBy(next), next)
+	}
+
+	// any restriction tightens an account without restrictions
+	require.False(t, TransferRestrictions{}.IsLoosenedBy(current))
+}
@@ -1,15 +1,101 @@
 package authx
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// RegisterInvariants registers all authx invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k AccountXKeeper, tk ExpectedTokenKeeper) {
+	ir.RegisterRoute(ModuleName, "pre-total-supply", PreTotalSupplyInvariant(k))
+	ir.RegisterRoute(ModuleName, "unlocked-coins-queue", UnlockedCoinsQueueInvariant(k))
+	ir.RegisterRoute(ModuleName, "send-lock", SendLockInvariant(k, tk))
+}
+
+// AllInvariants runs all invariants of the authx module
+func AllInvariants(k AccountXKeeper, tk ExpectedTokenKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := PreTotalSupplyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := UnlockedCoinsQueueInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return SendLockInvariant(k, tk)(ctx)
+	}
+}
+
+// PreTotalSupplyInvariant sets the authx module account to the locked and frozen coins of all
+// accounts, so that the total supply invariant counts them, and checks these coins equal the
+// total supply minus the coins held by all the other accounts
 func PreTotalSupplyInvariant(k AccountXKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		k.PreTotalSupply(ctx)
 
-		// TODO
+		var expectedTotal sdk.Coins
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			expectedTotal = expectedTotal.Add(acc.GetAllCoins())
+			return false
+		})
+		outside, negative := k.GetCoinsOutsideAccounts(ctx)
+		diff, diffNegative := outside.SafeSub(expectedTotal)
+		broken := negative || diffNegative || !diff.IsZero()
+
 		return sdk.FormatInvariant(ModuleName, "total supply",
-			"ok"), false
+			fmt.Sprintf("\tsum of locked and frozen coins: %s\n\ttotal supply minus account coins: %s\n",
+				expectedTotal, outside)), broken
+	}
+}
+
+// UnlockedCoinsQueueInvariant checks every locked coin is queued for its next unlocking
+func UnlockedCoinsQueueInvariant(k AccountXKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			for _, coin := range acc.LockedCoins {
+				if !k.HasUnlockedCoinsQueue(ctx, coin.NextVestingTime(), acc.Address) {
+					count++
+					msg += fmt.Sprintf("\t%s has locked %s without a queue entry at %d\n",
+						acc.Address, coin.Coin, coin.NextVestingTime())
+				}
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "unlocked coins queue",
+			fmt.Sprintf("%d locked coins are not in the unlocked coins queue\n%s", count, msg)), broken
+	}
+}
+
+// SendLockInvariant checks the send lock of every token equals the amount of it locked in all accounts
+func SendLockInvariant(k AccountXKeeper, tk ExpectedTokenKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var locked sdk.Coins
+		k.IterateAccounts(ctx, func(acc AccountX) bool {
+			for _, coin := range acc.LockedCoins {
+				locked = locked.Add(sdk.Coins{coin.Coin})
+			}
+			return false
+		})
+
+		var msg string
+		var count int
+		tk.IterateTokenSendLocks(ctx, func(symbol string, sendLock sdk.Int) bool {
+			expected := locked.AmountOf(symbol)
+			if !sendLock.Equal(expected) {
+				count++
+				msg += fmt.Sprintf("\t%s send lock: %s, locked in accounts: %s\n",
+					symbol, sendLock, expected)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(ModuleName, "send lock",
+			fmt.Sprintf("%d tokens have a wrong send lock\n%s", count, msg)), broken
 	}
 }
@@ -0,0 +1,62 @@
+package authx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func TestInvariants(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	cet, err := asset.NewToken("cet", "cet", sdk.NewInt(200000000000000), sdk.AccAddress("owner"),
+		false, false, true, true,
+		"", "", asset.TestIdentityString)
+	require.Nil(t, err)
+	require.Nil(t, input.tk.SetToken(ctx, cet))
+
+	unlockTime := ctx.BlockHeader().Time.Unix() + 1000
+	accx := authx.NewAccountX(sdk.AccAddress("addr"), false,
+		authx.LockedCoins{authx.NewLockedCoin("cet", sdk.NewInt(100), unlockTime)},
+		dex.NewCetCoins(50), nil, 0)
+	input.axk.SetAccountX(ctx, accx)
+	invariant := authx.AllInvariants(input.axk, input.tk)
+
+	// the locked coin is neither queued nor counted in the send lock
+	_, broken := authx.UnlockedCoinsQueueInvariant(input.axk)(ctx)
+	require.True(t, broken)
+	_, broken = authx.SendLockInvariant(input.axk, input.tk)(ctx)
+	require.True(t, broken)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	input.axk.InsertUnlockedCoinsQueue(ctx, unlockTime, accx.Address)
+	require.Nil(t, input.tk.UpdateTokenSendLock(ctx, "cet", sdk.NewInt(100), true))
+	acc := input.ak.NewAccountWithAddress(ctx, accx.Address)
+	require.Nil(t, acc.SetCoins(dex.NewCetCoins(30)))
+	input.ak.SetAccount(ctx, acc)
+	input.sk.SetSupply(ctx, supply.NewSupply(dex.NewCetCoins(180)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+	require.Equal(t, dex.NewCetCoins(150), input.axk.GetModuleAccountCoins(ctx))
+
+	// coins minted into an account without changing the total supply
+	require.Nil(t, acc.SetCoins(dex.NewCetCoins(40)))
+	input.ak.SetAccount(ctx, acc)
+	_, broken = authx.PreTotalSupplyInvariant(input.axk)(ctx)
+	require.True(t, broken)
+
+	// locked coins that are not counted in the total supply
+	require.Nil(t, acc.SetCoins(dex.NewCetCoins(30)))
+	input.ak.SetAccount(ctx, acc)
+	input.sk.SetSupply(ctx, supply.NewSupply(dex.NewCetCoins(170)))
+	_, broken = authx.PreTotalSupplyInvariant(input.axk)(ctx)
+	require.True(t, broken)
+}
@@ -38,6 +38,7 @@ func setupTestInput() testInput {
 	ctx := sdk.NewContext(testApp.Cms, abci.Header{ChainID: "test-chain-id", Time: time.Unix(1560334620, 0)}, false, log.NewNopLogger())
 	initSupply := dex.NewCetCoinsE8(10000)
 	testApp.SupplyKeeper.SetSupply(ctx, supply.NewSupply(initSupply))
+	testApp.AssetKeeper.SetParams(ctx, asset.DefaultParams())
 
 	return testInput{ctx: ctx, axk: testApp.AccountXKeeper, ak: testApp.AccountKeeper,
 		sk: testApp.SupplyKeeper, cdc: testApp.Cdc, tk: testApp.AssetKeeper}
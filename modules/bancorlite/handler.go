@@ -9,6 +9,7 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/bancorlite/internal/types"
 	"github.com/coinexchain/cet-sdk/modules/market"
 	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -198,14 +199,24 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 	}
 
 	commission := getTradeFee(ctx, k, msg, diff)
-	rebateAcc, rebate, balance, exist := k.GetRebate(ctx, msg.Sender, commission)
+	rebateAccs, rebates, balance, exist := k.GetRebate(ctx, msg.Sender, commission)
+	rebateAcc, rebate := sdk.AccAddress{}, sdk.ZeroInt()
+	var referralRebates []types.ReferralRebate
 	if exist {
 		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, balance))); err != nil {
 			return err.Result()
 		}
-		if err := k.SendCoins(ctx, msg.Sender, rebateAcc, sdk.NewCoins(sdk.NewCoin(dex.CET, rebate))); err != nil {
-			return err.Result()
+		for i, acc := range rebateAccs {
+			amt := sdk.NewCoins(sdk.NewCoin(dex.CET, rebates[i]))
+			if err := k.SendCoinsUnrestricted(ctx, msg.Sender, acc, amt); err != nil {
+				return err.Result()
+			}
+			k.AddRebateEarned(ctx, acc, amt)
+			if i > 0 {
+				referralRebates = append(referralRebates, types.ReferralRebate{Referee: acc, Amount: rebates[i].Int64()})
+			}
 		}
+		rebateAcc, rebate = rebateAccs[0], rebates[0]
 	} else {
 		if err := k.DeductFee(ctx, msg.Sender, sdk.NewCoins(sdk.NewCoin(dex.CET, commission))); err != nil {
 			return err.Result()
@@ -236,6 +247,7 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 		UsedCommission:    balance.Int64(),
 		RebateAmount:      rebate.Int64(),
 		RebateRefereeAddr: rebateAcc,
+		ReferralRebates:   referralRebates,
 		BlockHeight:       ctx.BlockHeight(),
 	}
 	info := keepers.NewBancorInfoDisplay(&biNew)
@@ -267,6 +279,13 @@ func handleMsgBancorTrade(ctx sdk.Context, k Keeper, msg types.MsgBancorTrade) s
 	}
 }
 
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register(KafkaBancorCreate, 1, keepers.BancorInfoDisplay{})
+	reg.Register(KafkaBancorTrade, 1, types.MsgBancorTradeInfoForKafka{})
+	reg.Register(KafkaBancorInfo, 1, keepers.BancorInfoDisplay{})
+}
+
 func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
 	if keeper.IsSubscribed(types.Topic) {
 		msgqueue.FillMsgs(ctx, key, msg)
@@ -290,7 +309,7 @@ func getTradeFee(ctx sdk.Context, k keepers.Keeper, msg types.MsgBancorTrade,
 
 func swapStockAndMoney(ctx sdk.Context, k keepers.Keeper, trader sdk.AccAddress, owner sdk.AccAddress,
 	coinsFromPool sdk.Coins, coinsToPool sdk.Coins) sdk.Error {
-	if err := k.SendCoins(ctx, trader, owner, coinsToPool); err != nil {
+	if err := k.SendCoinsUnrestricted(ctx, trader, owner, coinsToPool); err != nil {
 		return err
 	}
 	if err := k.FreezeCoins(ctx, owner, coinsToPool); err != nil {
@@ -299,7 +318,7 @@ func swapStockAndMoney(ctx sdk.Context, k keepers.Keeper, trader sdk.AccAddress,
 	if err := k.UnFreezeCoins(ctx, owner, coinsFromPool); err != nil {
 		return err
 	}
-	if err := k.SendCoins(ctx, owner, trader, coinsFromPool); err != nil {
+	if err := k.SendCoinsUnrestricted(ctx, owner, trader, coinsFromPool); err != nil {
 		return err
 	}
 	return nil
@@ -147,8 +147,8 @@ func (keeper *Keeper) Iterate(ctx sdk.Context, biProc func(bi *BancorInfo)) {
 	keeper.bik.Iterate(ctx, biProc)
 }
 
-func (keeper *Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return keeper.bxk.SendCoins(ctx, from, to, amt)
+func (keeper *Keeper) SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return keeper.bxk.SendCoinsUnrestricted(ctx, from, to, amt)
 }
 func (keeper *Keeper) FreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return keeper.bxk.FreezeCoins(ctx, acc, amt)
@@ -200,19 +200,29 @@ func (keeper *Keeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return keeper.axk.GetRebateRatioBase(ctx)
 }
 
-func (keeper *Keeper) GetRebate(ctx sdk.Context, address sdk.AccAddress, total sdk.Int) (acc sdk.AccAddress, rebate, balance sdk.Int, exist bool) {
-	acc = keeper.axk.GetRefereeAddr(ctx, address)
-	if len(acc) == 0 {
-		return acc, sdk.ZeroInt(), sdk.ZeroInt(), false
+// GetRebate splits total among the referral chain of address. accs[i] is the level-(i+1) referee
+// and gets rebates[i], balance is what remains after all the rebates.
+func (keeper *Keeper) GetRebate(ctx sdk.Context, address sdk.AccAddress, total sdk.Int) (accs []sdk.AccAddress, rebates []sdk.Int, balance sdk.Int, exist bool) {
+	accs = keeper.axk.GetReferralChain(ctx, address)
+	if len(accs) == 0 {
+		return accs, nil, sdk.ZeroInt(), false
 	}
-	ratio := keeper.GetRebateRatio(ctx)
+	ratios := keeper.axk.GetRebateRatios(ctx)
 	base := keeper.GetRebateRatioBase(ctx)
-	rebate = total.MulRaw(ratio).QuoRaw(base)
-	balance = total.Sub(rebate)
+	balance = total
+	rebates = make([]sdk.Int, len(accs))
+	for i := range accs {
+		rebates[i] = total.MulRaw(ratios[i]).QuoRaw(base)
+		balance = balance.Sub(rebates[i])
+	}
 	exist = true
 	return
 }
 
+func (keeper *Keeper) AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins) {
+	keeper.axk.AddRebateEarned(ctx, referee, amt)
+}
+
 func (keeper *Keeper) IsSubscribed(topic string) bool {
 	return keeper.msgProducer.IsSubscribed(topic)
 }
@@ -283,11 +283,23 @@ func TestKeeper_GetRebate(t *testing.T) {
 	ctx := sdk.NewContext(app.Cms, abci.Header{}, false, log.NewNopLogger())
 	app.AccountXKeeper.SetParams(ctx, authx.DefaultParams())
 	app.AccountXKeeper.SetAccountX(ctx, authx.NewAccountX(owner, false, nil, nil, referee, 0))
-	acc, rebate, balance, exist := app.BancorKeeper.GetRebate(ctx, owner, sdk.NewInt(100000))
-	require.Equal(t, acc, referee)
-	require.Equal(t, int64(20000), rebate.Int64())
+	accs, rebates, balance, exist := app.BancorKeeper.GetRebate(ctx, owner, sdk.NewInt(100000))
+	require.Equal(t, []sdk.AccAddress{referee}, accs)
+	require.Equal(t, int64(20000), rebates[0].Int64())
 	require.Equal(t, int64(80000), balance.Int64())
 	require.Equal(t, exist, true)
+
+	referee2 := sdk.AccAddress("referee2")
+	params := authx.DefaultParams()
+	params.ReferralRebateRatios = []int64{1000}
+	app.AccountXKeeper.SetParams(ctx, params)
+	app.AccountXKeeper.SetAccountX(ctx, authx.NewAccountX(referee, false, nil, nil, referee2, 0))
+	accs, rebates, balance, exist = app.BancorKeeper.GetRebate(ctx, owner, sdk.NewInt(100000))
+	require.Equal(t, []sdk.AccAddress{referee, referee2}, accs)
+	require.Equal(t, int64(20000), rebates[0].Int64())
+	require.Equal(t, int64(10000), rebates[1].Int64())
+	require.Equal(t, int64(70000), balance.Int64())
+	require.Equal(t, exist, true)
 }
 
 func TestCurrentPriceCalculate(t *testing.T) {
@@ -6,9 +6,9 @@ import (
 
 // Bankx Keeper will implement the interface
 type ExpectedBankxKeeper interface {
-	SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error // to tranfer coins
-	FreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                   // freeze some coins when creating orders
-	UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                 // unfreeze coins and then orders can be executed
+	SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error // to settle trades and pay rebates
+	FreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                               // freeze some coins when creating orders
+	UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                             // unfreeze coins and then orders can be executed
 	DeductFee(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error
 	DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error
 }
@@ -31,4 +31,7 @@ type ExpectedAuthXKeeper interface {
 	GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress
 	GetRebateRatio(ctx sdk.Context) int64
 	GetRebateRatioBase(ctx sdk.Context) int64
+	GetRebateRatios(ctx sdk.Context) []int64
+	GetReferralChain(ctx sdk.Context, accAddr sdk.AccAddress) []sdk.AccAddress
+	AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins)
 }
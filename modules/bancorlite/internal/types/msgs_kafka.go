@@ -41,7 +41,14 @@ type MsgBancorTradeInfoForKafka struct {
 	UsedCommission    int64          `json:"used_commission"`
 	RebateAmount      int64          `json:"rebate_amount"`
 	RebateRefereeAddr sdk.AccAddress `json:"rebate_referee_addr"`
-	BlockHeight       int64          `json:"block_height"`
+	// rebates paid to the level-2 and higher referees
+	ReferralRebates []ReferralRebate `json:"referral_rebates,omitempty"`
+	BlockHeight     int64            `json:"block_height"`
+}
+
+type ReferralRebate struct {
+	Referee sdk.AccAddress `json:"referee"`
+	Amount  int64          `json:"amount"`
 }
 
 type MsgBancorCancelForKafka struct {
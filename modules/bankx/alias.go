@@ -9,6 +9,7 @@ const (
 	DefaultCodespace = types.CodeSpaceBankx
 
 	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
 	RouterKey         = types.RouterKey
 	QuerierRoute      = types.RouterKey
 	DefaultParamspace = types.DefaultParamspace
@@ -19,6 +20,15 @@ const (
 	Return                    = types.Return
 	EarlierUnlockBySender     = types.EarlierUnlockBySender
 	EarlierUnlockBySupervisor = types.EarlierUnlockBySupervisor
+
+	EscrowRelease = types.EscrowRelease
+	EscrowRefund  = types.EscrowRefund
+	EscrowSplit   = types.EscrowSplit
+
+	MinRecurringPaymentInterval  = types.MinRecurringPaymentInterval
+	MaxRecurringPaymentsPerPayer = types.MaxRecurringPaymentsPerPayer
+	MaxRecurringPaymentFailures  = types.MaxRecurringPaymentFailures
+	MaxRecurringPaymentsPerBlock = types.MaxRecurringPaymentsPerBlock
 )
 
 var (
@@ -32,6 +42,25 @@ var (
 	NewMsgSend                         = types.NewMsgSend
 	NewMsgSetTransferMemoRequired      = types.NewMsgSetTransferMemoRequired
 	NewMsgMultiSend                    = types.NewMsgMultiSend
+	NewMsgVestingSend                  = types.NewMsgVestingSend
+	NewMsgCreateEscrow                 = types.NewMsgCreateEscrow
+	NewMsgResolveEscrow                = types.NewMsgResolveEscrow
+	NewEscrow                          = types.NewEscrow
+	NewMsgCreateHTLC                   = types.NewMsgCreateHTLC
+	NewMsgClaimHTLC                    = types.NewMsgClaimHTLC
+	NewHTLC                            = types.NewHTLC
+	HashSecret                         = types.HashSecret
+	NewMsgCreateRecurringPayment       = types.NewMsgCreateRecurringPayment
+	NewMsgCancelRecurringPayment       = types.NewMsgCancelRecurringPayment
+	NewRecurringPayment                = types.NewRecurringPayment
+	NewMsgApprove                      = types.NewMsgApprove
+	NewMsgTransferFrom                 = types.NewMsgTransferFrom
+	NewAllowance                       = types.NewAllowance
+	NewMsgSetTransferRestrictions      = types.NewMsgSetTransferRestrictions
+	NewMsgCancelDelayedTransfer        = types.NewMsgCancelDelayedTransfer
+	NewDelayedTransfer                 = types.NewDelayedTransfer
+	NewMsgCancelTransferRestrictions   = types.NewMsgCancelTransferRestrictions
+	NewPendingTransferRestrictions     = types.NewPendingTransferRestrictions
 	ErrMemoMissing                     = types.ErrMemoMissing
 	ErrInsufficientCETForActivatingFee = types.ErrInsufficientCETForActivatingFee
 
@@ -40,6 +69,8 @@ var (
 	ModuleCdc                           = types.ModuleCdc
 	CodeMemoMissing                     = types.CodeMemoMissing
 	CodeInsufficientCETForActivatingFee = types.CodeInsufficientCETForActivationFee
+	CodeTransferNotWhitelisted          = types.CodeTransferNotWhitelisted
+	CodeDailySendLimitExceeded          = types.CodeDailySendLimitExceeded
 )
 
 type (
@@ -48,4 +79,29 @@ type (
 	MsgSetMemoRequired = types.MsgSetMemoRequired
 	MsgMultiSend       = types.MsgMultiSend
 	MsgSupervisedSend  = types.MsgSupervisedSend
+	MsgVestingSend     = types.MsgVestingSend
+	MsgCreateEscrow    = types.MsgCreateEscrow
+	MsgResolveEscrow   = types.MsgResolveEscrow
+	Escrow             = types.Escrow
+	EscrowResult       = types.EscrowResult
+	MsgCreateHTLC      = types.MsgCreateHTLC
+	MsgClaimHTLC       = types.MsgClaimHTLC
+	HTLC               = types.HTLC
+	HTLCSettlement     = types.HTLCSettlement
+
+	MsgCreateRecurringPayment = types.MsgCreateRecurringPayment
+	MsgCancelRecurringPayment = types.MsgCancelRecurringPayment
+	RecurringPayment          = types.RecurringPayment
+	RecurringPaymentExecution = types.RecurringPaymentExecution
+	MsgApprove                = types.MsgApprove
+	MsgTransferFrom           = types.MsgTransferFrom
+	Allowance                 = types.Allowance
+
+	MsgSetTransferRestrictions = types.MsgSetTransferRestrictions
+	MsgCancelDelayedTransfer   = types.MsgCancelDelayedTransfer
+	DelayedTransfer            = types.DelayedTransfer
+	DelayedTransferSettlement  = types.DelayedTransferSettlement
+
+	MsgCancelTransferRestrictions = types.MsgCancelTransferRestrictions
+	PendingTransferRestrictions   = types.PendingTransferRestrictions
 )
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -23,6 +25,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	aliasQueryCmd.AddCommand(client.GetCommands(
 		QueryParamsCmd(cdc),
 		QueryBalancesCmd(cdc),
+		QueryEscrowsCmd(cdc),
+		QueryEscrowCmd(cdc),
+		QueryHTLCsCmd(cdc),
+		QueryHTLCCmd(cdc),
+		QueryRecurringPaymentsCmd(cdc),
+		QueryAllowancesCmd(cdc),
+		QueryDelayedTransfersCmd(cdc),
 	)...)
 	return aliasQueryCmd
 }
@@ -55,3 +64,137 @@ func QueryBalancesCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func QueryEscrowsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrows [address]",
+		Short: "Query all the escrows, or those in which the address takes part",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryEscrows)
+			if len(args) == 0 {
+				return cliutil.CliQuery(cdc, route, nil)
+			}
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrBalances(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryEscrowCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrow [id]",
+		Short: "Query an escrow",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryEscrow)
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryEscrowParam(id)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryHTLCsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlcs [address]",
+		Short: "Query all the HTLCs, or those sent or received by the address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLCs)
+			if len(args) == 0 {
+				return cliutil.CliQuery(cdc, route, nil)
+			}
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrBalances(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlc [hash_lock]",
+		Short: "Query an HTLC by its hex encoded hash lock",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLC)
+			hashLock, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryHTLCParam(hashLock)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryRecurringPaymentsCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "recurring-payments [address]",
+		Short: "Query all the recurring payments, or those paid or received by the address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryRecurringPayments)
+			if len(args) == 0 {
+				return cliutil.CliQuery(cdc, route, nil)
+			}
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrBalances(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryAllowancesCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [address]",
+		Short: "Query all the allowances, or those granted or received by the address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryAllowances)
+			if len(args) == 0 {
+				return cliutil.CliQuery(cdc, route, nil)
+			}
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrBalances(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
+
+func QueryDelayedTransfersCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delayed-transfers [address]",
+		Short: "Query all the delayed transfers, or those sent or received by the address",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryDelayedTransfers)
+			if len(args) == 0 {
+				return cliutil.CliQuery(cdc, route, nil)
+			}
+			acc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			param := keeper.NewQueryAddrBalances(acc)
+			return cliutil.CliQuery(cdc, route, &param)
+		},
+	}
+}
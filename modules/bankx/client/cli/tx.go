@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -22,8 +24,37 @@ const (
 	FlagSupervisor = "supervisor"
 	FlagReward     = "reward"
 	FlagOperation  = "operation"
+	FlagStartTime  = "start-time"
+	FlagCliffTime  = "cliff-time"
+	FlagPeriod     = "period"
+	FlagPeriods    = "periods"
+
+	FlagArbiter        = "arbiter"
+	FlagArbiterFee     = "arbiter-fee"
+	FlagDeadline       = "deadline"
+	FlagDefaultOutcome = "default-outcome"
+	FlagDecision       = "decision"
+	FlagSellerAmount   = "seller-amount"
+
+	FlagHashLock   = "hash-lock"
+	FlagExpireTime = "expire-time"
+
+	FlagInterval = "interval"
+	FlagCount    = "count"
+	FlagEndTime  = "end-time"
+
+	FlagReceiveWhitelist = "receive-whitelist"
+	FlagDailySendLimit   = "daily-send-limit"
+	FlagDelayThreshold   = "delay-threshold"
+	FlagTransferDelay    = "transfer-delay"
 )
 
+var escrowDecisions = map[string]byte{
+	"release": types.EscrowRelease,
+	"refund":  types.EscrowRefund,
+	"split":   types.EscrowSplit,
+}
+
 // SendTxCmd will create a send tx and sign it with the given key.
 func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -65,6 +96,18 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(client.PostCommands(
 		SendSupervisedTxCmd(cdc),
+		SendVestingTxCmd(cdc),
+		CreateEscrowCmd(cdc),
+		ResolveEscrowCmd(cdc),
+		CreateHTLCCmd(cdc),
+		ClaimHTLCCmd(cdc),
+		CreateRecurringPaymentCmd(cdc),
+		CancelRecurringPaymentCmd(cdc),
+		ApproveCmd(cdc),
+		TransferFromCmd(cdc),
+		SetTransferRestrictionsCmd(cdc),
+		CancelDelayedTransferCmd(cdc),
+		CancelTransferRestrictionsCmd(cdc),
 	)...)
 
 	return cmd
@@ -169,3 +212,462 @@ Example:
 
 	return cmd
 }
+
+// SendVestingTxCmd
+func SendVestingTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-tx [to_address] [amount]",
+		Short: "Create and sign a vesting tx",
+		Long: `Create and sign a vesting tx, the amount is released in equal slices,
+one every period seconds from the start time on, and no slice is released before the cliff time.
+
+Example:
+    cetcli tx send vesting-tx coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1200000000cet \
+        --start-time=1600000000 \
+        --cliff-time=1607776000 \
+        --period=2592000 \
+        --periods=12 \
+        --from=sender_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime := viper.GetInt64(FlagStartTime)
+			if startTime < time.Now().Unix() {
+				return fmt.Errorf("start time should be later than the current time")
+			}
+
+			msg := types.NewMsgVestingSend(nil, to, coins, startTime,
+				viper.GetInt64(FlagCliffTime), viper.GetInt64(FlagPeriod), viper.GetInt64(FlagPeriods))
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp when vesting starts")
+	cmd.Flags().Int64(FlagCliffTime, 0, "The unix timestamp before which nothing is released")
+	cmd.Flags().Int64(FlagPeriod, 0, "The length of a vesting period in seconds")
+	cmd.Flags().Int64(FlagPeriods, 0, "The number of vesting periods")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagPeriod)
+	_ = cmd.MarkFlagRequired(FlagPeriods)
+
+	return cmd
+}
+
+// CreateEscrowCmd
+func CreateEscrowCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow [seller_address] [amount]",
+		Short: "Create an escrow as the buyer",
+		Long: `Create an escrow as the buyer, the amount and the arbiter fee are held until the escrow
+is released to the seller, refunded to the buyer or split by the arbiter. If no decision is
+made before the deadline, the escrow is settled with the default outcome (release or refund).
+
+Example:
+    cetcli tx send create-escrow coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --arbiter=coinex1qga320mdvfhr62hcjn78n6pjl3z3vsvgtz2w8t \
+        --arbiter-fee=10000000cet \
+        --deadline=1600000000 \
+        --default-outcome=refund \
+        --from=buyer_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			seller, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			arbiter, err := sdk.AccAddressFromBech32(viper.GetString(FlagArbiter))
+			if err != nil {
+				return err
+			}
+			arbiterFee, err := sdk.ParseCoins(viper.GetString(FlagArbiterFee))
+			if err != nil {
+				return err
+			}
+			deadline := viper.GetInt64(FlagDeadline)
+			if deadline <= time.Now().Unix() {
+				return fmt.Errorf("deadline should be later than the current time")
+			}
+			outcome, ok := escrowDecisions[viper.GetString(FlagDefaultOutcome)]
+			if !ok || outcome == types.EscrowSplit {
+				return fmt.Errorf("default outcome must be release or refund")
+			}
+
+			msg := types.NewMsgCreateEscrow(nil, seller, arbiter, amount, arbiterFee, deadline, outcome)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagArbiter, "", "The arbiter's address")
+	cmd.Flags().String(FlagArbiterFee, "", "The fee paid to the arbiter when it makes the decision")
+	cmd.Flags().Int64(FlagDeadline, 0, "The unix timestamp when the escrow is settled with the default outcome")
+	cmd.Flags().String(FlagDefaultOutcome, "refund", "The outcome when no decision is made before the deadline (release|refund)")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	_ = cmd.MarkFlagRequired(FlagArbiter)
+	_ = cmd.MarkFlagRequired(FlagDeadline)
+
+	return cmd
+}
+
+// ResolveEscrowCmd
+func ResolveEscrowCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-escrow [id]",
+		Short: "Release, refund or split an escrow",
+		Long: `Release an escrow to the seller (by the buyer or the arbiter), refund it to the buyer
+(by the seller or the arbiter), or split it (by the arbiter only), in which case the seller
+amount goes to the seller and the rest to the buyer.
+
+Example:
+    cetcli tx send resolve-escrow 1 --decision=split --seller-amount=600000000cet --from=arbiter_user
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			decision, ok := escrowDecisions[viper.GetString(FlagDecision)]
+			if !ok {
+				return fmt.Errorf("decision must be release, refund or split")
+			}
+			sellerAmount, err := sdk.ParseCoins(viper.GetString(FlagSellerAmount))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveEscrow(nil, id, decision, sellerAmount)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDecision, "", "The decision on the escrow (release|refund|split)")
+	cmd.Flags().String(FlagSellerAmount, "", "The amount paid to the seller when the escrow is split")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	_ = cmd.MarkFlagRequired(FlagDecision)
+
+	return cmd
+}
+
+// CreateHTLCCmd
+func CreateHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-htlc [to_address] [amount]",
+		Short: "Create a hash time-locked transfer",
+		Long: `Create a hash time-locked transfer, the amount goes to the recipient when the preimage
+of the SHA-256 hash lock is revealed before the expire time, otherwise it is refunded.
+
+Example:
+    cetcli tx send create-htlc coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --hash-lock=9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 \
+        --expire-time=1600000000 \
+        --from=sender_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			hashLock, err := hex.DecodeString(viper.GetString(FlagHashLock))
+			if err != nil {
+				return err
+			}
+			expireTime := viper.GetInt64(FlagExpireTime)
+			if expireTime <= time.Now().Unix() {
+				return fmt.Errorf("expire time should be later than the current time")
+			}
+
+			msg := types.NewMsgCreateHTLC(nil, to, coins, hashLock, expireTime)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHashLock, "", "The hex encoded SHA-256 hash of the secret")
+	cmd.Flags().Int64(FlagExpireTime, 0, "The unix timestamp when the amount is refunded if not claimed")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	_ = cmd.MarkFlagRequired(FlagHashLock)
+	_ = cmd.MarkFlagRequired(FlagExpireTime)
+
+	return cmd
+}
+
+// ClaimHTLCCmd
+func ClaimHTLCCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-htlc [hash_lock] [secret]",
+		Short: "Claim a hash time-locked transfer for its recipient by revealing the hex encoded secret",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hashLock, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			secret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimHTLC(nil, hashLock, secret)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// CreateRecurringPaymentCmd
+func CreateRecurringPaymentCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-recurring-payment [to_address] [amount]",
+		Short: "Authorize paying the amount to the address periodically",
+		Long: `Authorize paying the amount to the address every interval seconds from the start time on,
+count times or until the end time. The interval is at least one hour. A payment which can not be
+afforded when it is due is skipped, and the recurring payment is cancelled after 3 consecutive skips.
+The activation fee of a fresh recipient is deducted from the payment.
+
+Example:
+    cetcli tx send create-recurring-payment coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --start-time=1600000000 --interval=2592000 --count=12 \
+        --from=payer_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			startTime := viper.GetInt64(FlagStartTime)
+			if startTime < time.Now().Unix() {
+				return fmt.Errorf("start time should not be earlier than the current time")
+			}
+
+			msg := types.NewMsgCreateRecurringPayment(nil, to, coins, startTime,
+				viper.GetInt64(FlagInterval), viper.GetInt64(FlagCount), viper.GetInt64(FlagEndTime))
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartTime, 0, "The unix timestamp of the first payment")
+	cmd.Flags().Int64(FlagInterval, 0, "The seconds between two payments")
+	cmd.Flags().Int64(FlagCount, 0, "The number of payments, 0 means no limit")
+	cmd.Flags().Int64(FlagEndTime, 0, "The unix timestamp after which no payment is made, 0 means no limit")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	_ = cmd.MarkFlagRequired(FlagStartTime)
+	_ = cmd.MarkFlagRequired(FlagInterval)
+
+	return cmd
+}
+
+// CancelRecurringPaymentCmd
+func CancelRecurringPaymentCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-recurring-payment [id]",
+		Short: "Cancel a recurring payment by its payer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRecurringPayment(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// ApproveCmd
+func ApproveCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [spender] [amount]",
+		Short: "Allow the spender to transfer at most the amount of your coins",
+		Long: `Set the allowance of the spender on your coins of the amount's denom, the previous allowance
+on the same denom is replaced and a zero amount revokes it.
+
+Example:
+    cetcli tx send approve coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x 1000000000cet \
+        --expire-time=1600000000 --from=owner_user
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			expireTime := viper.GetInt64(FlagExpireTime)
+			if expireTime != 0 && expireTime <= time.Now().Unix() {
+				return fmt.Errorf("expire time should be later than the current time")
+			}
+
+			msg := types.NewMsgApprove(nil, spender, coin, expireTime)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpireTime, 0, "The unix timestamp when the allowance expires, 0 means never")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// TransferFromCmd
+func TransferFromCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-from [owner] [to_address] [amount]",
+		Short: "Transfer the owner's coins with the allowances granted to you",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferFrom(nil, owner, to, coins)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// SetTransferRestrictionsCmd
+func SetTransferRestrictionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-restrictions",
+		Short: "Set the restrictions on the transfers from and to your account",
+		Long: `Replace the transfer restrictions of your account, the restrictions not given are turned off.
+Only the addresses in the receive whitelist can send coins to the account if it is not empty.
+The account can send at most the daily send limit of each listed denom per day, and sending
+at least the delay threshold of a listed denom is delayed by transfer-delay seconds, during
+which the transfer can be cancelled. Restrictions looser than the current ones take effect
+after the current transfer delay, and can be cancelled until then.
+
+Example:
+    cetcli tx send set-transfer-restrictions \
+        --receive-whitelist=coinex1ke3qq22zvzlcdh3j8nenlrjxmvnrna7z426n0x,coinex1px8alypku5j84qlwzdpynhn4nyrkagaytu5u4a \
+        --daily-send-limit=10000000000cet --delay-threshold=5000000000cet --transfer-delay=86400 \
+        --from=hot_wallet
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var whitelist []sdk.AccAddress
+			if str := viper.GetString(FlagReceiveWhitelist); len(str) != 0 {
+				for _, s := range strings.Split(str, ",") {
+					addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(s))
+					if err != nil {
+						return err
+					}
+					whitelist = append(whitelist, addr)
+				}
+			}
+			dailySendLimit, err := sdk.ParseCoins(viper.GetString(FlagDailySendLimit))
+			if err != nil {
+				return err
+			}
+			delayThreshold, err := sdk.ParseCoins(viper.GetString(FlagDelayThreshold))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferRestrictions(nil, whitelist, dailySendLimit, delayThreshold,
+				viper.GetInt64(FlagTransferDelay))
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReceiveWhitelist, "", "The comma separated addresses allowed to send coins to the account")
+	cmd.Flags().String(FlagDailySendLimit, "", "The coins the account can send at most per day")
+	cmd.Flags().String(FlagDelayThreshold, "", "The coins from which on a transfer is delayed")
+	cmd.Flags().Int64(FlagTransferDelay, 0, "The seconds a transfer reaching the delay threshold is delayed")
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// CancelDelayedTransferCmd
+func CancelDelayedTransferCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-delayed-transfer [id]",
+		Short: "Cancel a delayed transfer by its sender and get the coins back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDelayedTransfer(nil, id)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
+
+// CancelTransferRestrictionsCmd
+func CancelTransferRestrictionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-transfer-restrictions",
+		Short: "Cancel the looser transfer restrictions of your account which have not taken effect",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			msg := types.NewMsgCancelTransferRestrictions(nil)
+			return cliutil.CliRunCommand(cdc, &msg)
+		},
+	}
+
+	cmd.Flags().Bool(cliutil.FlagGenerateUnsignedTx, false, "Generate a unsigned tx")
+
+	return cmd
+}
@@ -1,8 +1,10 @@
 package rest
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -38,3 +40,116 @@ func QueryBalancesRequestHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec)
 		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
 	}
 }
+
+func queryEscrowsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryEscrows)
+		var params keeper.QueryAddrBalances
+		if addr := r.URL.Query().Get("address"); addr != "" {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = keeper.NewQueryAddrBalances(acc)
+		}
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryEscrowHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryEscrow)
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryEscrowParam(id)
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryHTLCsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLCs)
+		var params keeper.QueryAddrBalances
+		if addr := r.URL.Query().Get("address"); addr != "" {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = keeper.NewQueryAddrBalances(acc)
+		}
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryHTLCHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryHTLC)
+		hashLock, err := hex.DecodeString(mux.Vars(r)["hash_lock"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := keeper.NewQueryHTLCParam(hashLock)
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryRecurringPaymentsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryRecurringPayments)
+		var params keeper.QueryAddrBalances
+		if addr := r.URL.Query().Get("address"); addr != "" {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = keeper.NewQueryAddrBalances(acc)
+		}
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryAllowancesHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryAllowances)
+		var params keeper.QueryAddrBalances
+		if addr := r.URL.Query().Get("address"); addr != "" {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = keeper.NewQueryAddrBalances(acc)
+		}
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
+
+func queryDelayedTransfersHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.StoreKey, keeper.QueryDelayedTransfers)
+		var params keeper.QueryAddrBalances
+		if addr := r.URL.Query().Get("address"); addr != "" {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = keeper.NewQueryAddrBalances(acc)
+		}
+
+		restutil.RestQuery(cdc, cliCtx, w, r, route, &params, nil)
+	}
+}
@@ -11,6 +11,25 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", sendTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/accounts/{address}/supervised_transfers", sendSupervisedTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/vesting_transfers", sendVestingTxRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows", createEscrowHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows/{id}/resolutions", resolveEscrowHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows", queryEscrowsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/escrows/{id}", queryEscrowHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/htlcs", createHTLCHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{hash_lock}/claims", claimHTLCHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs", queryHTLCsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/htlcs/{hash_lock}", queryHTLCHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/recurring_payments", createRecurringPaymentHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/recurring_payments/{id}/cancellations", cancelRecurringPaymentHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/recurring_payments", queryRecurringPaymentsHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/allowances", approveHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/allowances/transfers", transferFromHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/allowances", queryAllowancesHandlerFn(cliCtx, cdc)).Methods("GET")
+	r.HandleFunc("/bank/accounts/transfer_restrictions", setTransferRestrictionsHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/transfer_restrictions/cancellations", cancelTransferRestrictionsHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/delayed_transfers/{id}/cancellations", cancelDelayedTransferHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/delayed_transfers", queryDelayedTransfersHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/bank/accounts/memo", sendRequestHandlerFn(cliCtx.Codec, cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx, cdc)).Methods("GET")
 	r.HandleFunc("/bank/parameters", queryParamsHandlerFn(cliCtx)).Methods("GET")
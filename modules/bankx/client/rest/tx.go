@@ -46,3 +46,82 @@ func sendSupervisedTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContex
 	}
 	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(sendSupervisedReq)).Build(checker)
 }
+
+func sendVestingTxRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*sendVestingReq).StartTime < time.Now().Unix() {
+			return fmt.Errorf("start time should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(sendVestingReq)).Build(checker)
+}
+
+func createEscrowHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*createEscrowReq).Deadline <= time.Now().Unix() {
+			return fmt.Errorf("deadline should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(createEscrowReq)).Build(checker)
+}
+
+func resolveEscrowHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(resolveEscrowReq))
+}
+
+func createHTLCHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*createHTLCReq).ExpireTime <= time.Now().Unix() {
+			return fmt.Errorf("expire time should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(createHTLCReq)).Build(checker)
+}
+
+func claimHTLCHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(claimHTLCReq))
+}
+
+func createRecurringPaymentHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		if req.(*createRecurringPaymentReq).StartTime < time.Now().Unix() {
+			return fmt.Errorf("start time should not be earlier than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(createRecurringPaymentReq)).Build(checker)
+}
+
+func cancelRecurringPaymentHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelRecurringPaymentReq))
+}
+
+func approveHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	checker := func(cdc *codec.Codec, cliCtx context.CLIContext, req restutil.RestReq) error {
+		expireTime := req.(*approveReq).ExpireTime
+		if expireTime != 0 && expireTime <= time.Now().Unix() {
+			return fmt.Errorf("expire time should be later than the current time")
+		}
+		return nil
+	}
+	return restutil.NewRestHandlerBuilder(cdc, cliCtx, new(approveReq)).Build(checker)
+}
+
+func transferFromHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(transferFromReq))
+}
+
+func setTransferRestrictionsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(setTransferRestrictionsReq))
+}
+
+func cancelDelayedTransferHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelDelayedTransferReq))
+}
+
+func cancelTransferRestrictionsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return restutil.NewRestHandler(cdc, cliCtx, new(cancelTransferRestrictionsReq))
+}
@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"encoding/hex"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -33,6 +35,88 @@ type (
 		Reward     int64        `json:"reward,omitempty"`
 		Operation  byte         `json:"operation"`
 	}
+
+	sendVestingReq struct {
+		BaseReq   rest.BaseReq `json:"base_req"`
+		Amount    sdk.Coins    `json:"amount"`
+		StartTime int64        `json:"start_time"`
+		CliffTime int64        `json:"cliff_time,omitempty"`
+		Period    int64        `json:"period"`
+		Periods   int64        `json:"periods"`
+	}
+
+	createEscrowReq struct {
+		BaseReq        rest.BaseReq `json:"base_req"`
+		Seller         string       `json:"seller"`
+		Arbiter        string       `json:"arbiter"`
+		Amount         sdk.Coins    `json:"amount"`
+		ArbiterFee     sdk.Coins    `json:"arbiter_fee"`
+		Deadline       int64        `json:"deadline"`
+		DefaultOutcome byte         `json:"default_outcome"`
+	}
+
+	resolveEscrowReq struct {
+		BaseReq      rest.BaseReq `json:"base_req"`
+		Decision     byte         `json:"decision"`
+		SellerAmount sdk.Coins    `json:"seller_amount"`
+	}
+
+	createHTLCReq struct {
+		BaseReq    rest.BaseReq `json:"base_req"`
+		Recipient  string       `json:"recipient"`
+		Amount     sdk.Coins    `json:"amount"`
+		HashLock   string       `json:"hash_lock"`
+		ExpireTime int64        `json:"expire_time"`
+	}
+
+	claimHTLCReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Secret  string       `json:"secret"`
+	}
+
+	createRecurringPaymentReq struct {
+		BaseReq   rest.BaseReq `json:"base_req"`
+		Recipient string       `json:"recipient"`
+		Amount    sdk.Coins    `json:"amount"`
+		StartTime int64        `json:"start_time"`
+		Interval  int64        `json:"interval"`
+		Count     int64        `json:"count,omitempty"`
+		EndTime   int64        `json:"end_time,omitempty"`
+	}
+
+	cancelRecurringPaymentReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
+
+	approveReq struct {
+		BaseReq    rest.BaseReq `json:"base_req"`
+		Spender    string       `json:"spender"`
+		Amount     sdk.Coin     `json:"amount"`
+		ExpireTime int64        `json:"expire_time,omitempty"`
+	}
+
+	transferFromReq struct {
+		BaseReq   rest.BaseReq `json:"base_req"`
+		Owner     string       `json:"owner"`
+		Recipient string       `json:"recipient"`
+		Amount    sdk.Coins    `json:"amount"`
+	}
+
+	setTransferRestrictionsReq struct {
+		BaseReq          rest.BaseReq `json:"base_req"`
+		ReceiveWhitelist []string     `json:"receive_whitelist,omitempty"`
+		DailySendLimit   sdk.Coins    `json:"daily_send_limit,omitempty"`
+		DelayThreshold   sdk.Coins    `json:"delay_threshold,omitempty"`
+		TransferDelay    int64        `json:"transfer_delay,omitempty"`
+	}
+
+	cancelDelayedTransferReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
+
+	cancelTransferRestrictionsReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+	}
 )
 
 func (req *sendReq) New() restutil.RestReq {
@@ -89,6 +173,190 @@ func (req *sendSupervisedReq) GetMsg(r *http.Request, addr sdk.AccAddress) (sdk.
 		req.Reward, req.Operation), nil
 }
 
+func (req *sendVestingReq) New() restutil.RestReq {
+	return new(sendVestingReq)
+}
+func (req *sendVestingReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *sendVestingReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	toAddr := getAddr(r)
+	return types.NewMsgVestingSend(sender, toAddr, req.Amount, req.StartTime, req.CliffTime,
+		req.Period, req.Periods), nil
+}
+
+func (req *createEscrowReq) New() restutil.RestReq {
+	return new(createEscrowReq)
+}
+func (req *createEscrowReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createEscrowReq) GetMsg(r *http.Request, buyer sdk.AccAddress) (sdk.Msg, error) {
+	seller, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+	arbiter, err := sdk.AccAddressFromBech32(req.Arbiter)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCreateEscrow(buyer, seller, arbiter, req.Amount, req.ArbiterFee,
+		req.Deadline, req.DefaultOutcome), nil
+}
+
+func (req *resolveEscrowReq) New() restutil.RestReq {
+	return new(resolveEscrowReq)
+}
+func (req *resolveEscrowReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *resolveEscrowReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgResolveEscrow(sender, id, req.Decision, req.SellerAmount), nil
+}
+
+func (req *createHTLCReq) New() restutil.RestReq {
+	return new(createHTLCReq)
+}
+func (req *createHTLCReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createHTLCReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	hashLock, err := hex.DecodeString(req.HashLock)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCreateHTLC(sender, recipient, req.Amount, hashLock, req.ExpireTime), nil
+}
+
+func (req *claimHTLCReq) New() restutil.RestReq {
+	return new(claimHTLCReq)
+}
+func (req *claimHTLCReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *claimHTLCReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	hashLock, err := hex.DecodeString(mux.Vars(r)["hash_lock"])
+	if err != nil {
+		return nil, err
+	}
+	secret, err := hex.DecodeString(req.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgClaimHTLC(sender, hashLock, secret), nil
+}
+
+func (req *createRecurringPaymentReq) New() restutil.RestReq {
+	return new(createRecurringPaymentReq)
+}
+func (req *createRecurringPaymentReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *createRecurringPaymentReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCreateRecurringPayment(sender, recipient, req.Amount,
+		req.StartTime, req.Interval, req.Count, req.EndTime), nil
+}
+
+func (req *cancelRecurringPaymentReq) New() restutil.RestReq {
+	return new(cancelRecurringPaymentReq)
+}
+func (req *cancelRecurringPaymentReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelRecurringPaymentReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCancelRecurringPayment(sender, id), nil
+}
+
+func (req *approveReq) New() restutil.RestReq {
+	return new(approveReq)
+}
+func (req *approveReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *approveReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgApprove(sender, spender, req.Amount, req.ExpireTime), nil
+}
+
+func (req *transferFromReq) New() restutil.RestReq {
+	return new(transferFromReq)
+}
+func (req *transferFromReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *transferFromReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgTransferFrom(sender, owner, recipient, req.Amount), nil
+}
+
+func (req *setTransferRestrictionsReq) New() restutil.RestReq {
+	return new(setTransferRestrictionsReq)
+}
+func (req *setTransferRestrictionsReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *setTransferRestrictionsReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	var whitelist []sdk.AccAddress
+	for _, s := range req.ReceiveWhitelist {
+		addr, err := sdk.AccAddressFromBech32(s)
+		if err != nil {
+			return nil, err
+		}
+		whitelist = append(whitelist, addr)
+	}
+	return types.NewMsgSetTransferRestrictions(sender, whitelist, req.DailySendLimit, req.DelayThreshold, req.TransferDelay), nil
+}
+
+func (req *cancelDelayedTransferReq) New() restutil.RestReq {
+	return new(cancelDelayedTransferReq)
+}
+func (req *cancelDelayedTransferReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelDelayedTransferReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewMsgCancelDelayedTransfer(sender, id), nil
+}
+
+func (req *cancelTransferRestrictionsReq) New() restutil.RestReq {
+	return new(cancelTransferRestrictionsReq)
+}
+func (req *cancelTransferRestrictionsReq) GetBaseReq() *rest.BaseReq {
+	return &req.BaseReq
+}
+func (req *cancelTransferRestrictionsReq) GetMsg(r *http.Request, sender sdk.AccAddress) (sdk.Msg, error) {
+	return types.NewMsgCancelTransferRestrictions(sender), nil
+}
+
 func getAddr(r *http.Request) sdk.AccAddress {
 	vars := mux.Vars(r)
 	addr, err := sdk.AccAddressFromBech32(vars["address"])
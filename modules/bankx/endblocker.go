@@ -0,0 +1,73 @@
+package bankx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+)
+
+// EndBlocker settles the escrows whose deadline has passed with their default outcome
+// and refunds the expired HTLCs to their senders, then makes the due recurring payments
+// and delayed transfers, and lets the due pending transfer restrictions take effect
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	currentTime := ctx.BlockHeader().Time.Unix()
+	results := k.SettleExpiredEscrows(ctx, currentTime)
+	for _, result := range results {
+		fillMsgQueue(ctx, k, "resolve_escrow", result)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeResolveEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", result.ID)),
+			sdk.NewAttribute(types.AttributeKeyDecision, fmt.Sprintf("%d", result.Decision)),
+		))
+	}
+
+	settlements := k.RefundExpiredHTLCs(ctx, currentTime)
+	for _, settlement := range settlements {
+		fillMsgQueue(ctx, k, "refund_htlc", settlement)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRefundHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, settlement.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, settlement.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, settlement.Amount.String()),
+		))
+	}
+	executions := k.ExecuteRecurringPayments(ctx, currentTime)
+	for _, execution := range executions {
+		fillMsgQueue(ctx, k, "recurring_payment", execution)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRecurringPayment,
+			sdk.NewAttribute(types.AttributeKeyPaymentID, fmt.Sprintf("%d", execution.ID)),
+			sdk.NewAttribute(types.AttributeKeyExecuted, fmt.Sprintf("%v", execution.Executed)),
+		))
+	}
+
+	transfers := k.ExecuteDelayedTransfers(ctx, currentTime)
+	for _, transfer := range transfers {
+		if transfer.Cancelled {
+			fillMsgQueue(ctx, k, "cancel_delayed_transfer", transfer)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeCancelDelayedTransfer,
+				sdk.NewAttribute(types.AttributeKeyTransferID, fmt.Sprintf("%d", transfer.ID)),
+			))
+			continue
+		}
+		fillMsgQueue(ctx, k, "execute_delayed_transfer", transfer)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExecuteDelayedTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, fmt.Sprintf("%d", transfer.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, transfer.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Amount.String()),
+		))
+	}
+
+	pendings := k.ApplyPendingTransferRestrictions(ctx, currentTime)
+	for _, pending := range pendings {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSetTransferRestrictions,
+			sdk.NewAttribute(types.AttributeKeySender, pending.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, fmt.Sprintf("%d", pending.EffectiveTime)),
+		))
+	}
+}
@@ -1,6 +1,8 @@
 package bankx
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
@@ -8,13 +10,35 @@ import (
 
 // GenesisState - all asset state that must be provided at genesis
 type GenesisState struct {
-	Params types.Params `json:"params"`
+	Params       types.Params   `json:"params"`
+	Escrows      []types.Escrow `json:"escrows"`
+	NextEscrowID uint64         `json:"next_escrow_id"`
+	HTLCs        []types.HTLC   `json:"htlcs"`
+
+	RecurringPayments      []types.RecurringPayment `json:"recurring_payments"`
+	NextRecurringPaymentID uint64                   `json:"next_recurring_payment_id"`
+
+	Allowances []types.Allowance `json:"allowances"`
+
+	DelayedTransfers      []types.DelayedTransfer `json:"delayed_transfers"`
+	NextDelayedTransferID uint64                  `json:"next_delayed_transfer_id"`
+
+	PendingTransferRestrictions []types.PendingTransferRestrictions `json:"pending_transfer_restrictions"`
 }
 
-// NewGenesisState - Create a new genesis state
+// NewGenesisState - Create a new genesis state without escrows, htlcs, recurring payments, allowances or delayed transfers
 func NewGenesisState(param types.Params) GenesisState {
 	return GenesisState{
-		Params: param,
+		Params:                      param,
+		Escrows:                     []types.Escrow{},
+		NextEscrowID:                1,
+		HTLCs:                       []types.HTLC{},
+		RecurringPayments:           []types.RecurringPayment{},
+		NextRecurringPaymentID:      1,
+		Allowances:                  []types.Allowance{},
+		DelayedTransfers:            []types.DelayedTransfer{},
+		NextDelayedTransferID:       1,
+		PendingTransferRestrictions: []types.PendingTransferRestrictions{},
 	}
 }
 
@@ -26,12 +50,43 @@ func DefaultGenesisState() GenesisState {
 // InitGenesis - Init store state from genesis data
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	for _, escrow := range data.Escrows {
+		keeper.ImportGenesisEscrow(ctx, escrow)
+	}
+	keeper.SetNextEscrowID(ctx, data.NextEscrowID)
+	for _, htlc := range data.HTLCs {
+		keeper.ImportGenesisHTLC(ctx, htlc)
+	}
+	for _, payment := range data.RecurringPayments {
+		keeper.ImportGenesisRecurringPayment(ctx, payment)
+	}
+	keeper.SetNextRecurringPaymentID(ctx, data.NextRecurringPaymentID)
+	for _, allowance := range data.Allowances {
+		keeper.ImportGenesisAllowance(ctx, allowance)
+	}
+	for _, transfer := range data.DelayedTransfers {
+		keeper.ImportGenesisDelayedTransfer(ctx, transfer)
+	}
+	keeper.SetNextDelayedTransferID(ctx, data.NextDelayedTransferID)
+	for _, pending := range data.PendingTransferRestrictions {
+		keeper.ImportGenesisPendingTransferRestrictions(ctx, pending)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(params)
+	state := NewGenesisState(params)
+	state.Escrows = keeper.GetEscrows(ctx, nil)
+	state.NextEscrowID = keeper.GetNextEscrowID(ctx)
+	state.HTLCs = keeper.GetHTLCs(ctx, nil)
+	state.RecurringPayments = keeper.GetRecurringPayments(ctx, nil)
+	state.NextRecurringPaymentID = keeper.GetNextRecurringPaymentID(ctx)
+	state.Allowances = keeper.GetAllowances(ctx, nil)
+	state.DelayedTransfers = keeper.GetDelayedTransfers(ctx, nil)
+	state.NextDelayedTransferID = keeper.GetNextDelayedTransferID(ctx)
+	state.PendingTransferRestrictions = keeper.GetAllPendingTransferRestrictions(ctx)
+	return state
 }
 
 // ValidateGenesis performs basic validation of asset genesis data returning an
@@ -46,5 +101,90 @@ func (data GenesisState) ValidateGenesis() error {
 	if lockCoinsFee := data.Params.LockCoinsFeePerDay; lockCoinsFee < 0 {
 		return types.ErrInvalidLockCoinsFee()
 	}
+	if data.NextEscrowID == 0 {
+		return types.ErrInvalidEscrow("next escrow id must be positive")
+	}
+	escrowIDs := make(map[uint64]bool)
+	for _, escrow := range data.Escrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+		if escrow.ID == 0 || escrow.ID >= data.NextEscrowID {
+			return types.ErrInvalidEscrow(fmt.Sprintf("invalid escrow id %d", escrow.ID))
+		}
+		if escrowIDs[escrow.ID] {
+			return types.ErrInvalidEscrow(fmt.Sprintf("duplicate escrow id %d", escrow.ID))
+		}
+		escrowIDs[escrow.ID] = true
+	}
+	hashLocks := make(map[string]bool)
+	for _, htlc := range data.HTLCs {
+		if err := htlc.Validate(); err != nil {
+			return err
+		}
+		if hashLocks[htlc.HashLock.String()] {
+			return types.ErrInvalidHTLC(fmt.Sprintf("duplicate htlc %s", htlc.HashLock))
+		}
+		hashLocks[htlc.HashLock.String()] = true
+	}
+	if data.NextRecurringPaymentID == 0 {
+		return types.ErrInvalidRecurringPayment("next recurring payment id must be positive")
+	}
+	paymentIDs := make(map[uint64]bool)
+	paymentCounts := make(map[string]int)
+	for _, payment := range data.RecurringPayments {
+		if err := payment.Validate(); err != nil {
+			return err
+		}
+		if payment.ID == 0 || payment.ID >= data.NextRecurringPaymentID {
+			return types.ErrInvalidRecurringPayment(fmt.Sprintf("invalid recurring payment id %d", payment.ID))
+		}
+		if paymentIDs[payment.ID] {
+			return types.ErrInvalidRecurringPayment(fmt.Sprintf("duplicate recurring payment id %d", payment.ID))
+		}
+		paymentIDs[payment.ID] = true
+		paymentCounts[payment.Payer.String()]++
+		if paymentCounts[payment.Payer.String()] > types.MaxRecurringPaymentsPerPayer {
+			return types.ErrInvalidRecurringPayment(fmt.Sprintf("too many recurring payments of %s", payment.Payer))
+		}
+	}
+	allowanceKeys := make(map[string]bool)
+	for _, allowance := range data.Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+		key := string(types.GetAllowanceKey(allowance.Owner, allowance.Spender, allowance.Amount.Denom))
+		if allowanceKeys[key] {
+			return types.ErrInvalidAllowance(fmt.Sprintf("duplicate allowance of %s on %s of %s",
+				allowance.Spender, allowance.Amount.Denom, allowance.Owner))
+		}
+		allowanceKeys[key] = true
+	}
+	if data.NextDelayedTransferID == 0 {
+		return types.ErrInvalidTransferRestrictions("next delayed transfer id must be positive")
+	}
+	transferIDs := make(map[uint64]bool)
+	for _, transfer := range data.DelayedTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		if transfer.ID == 0 || transfer.ID >= data.NextDelayedTransferID {
+			return types.ErrInvalidTransferRestrictions(fmt.Sprintf("invalid delayed transfer id %d", transfer.ID))
+		}
+		if transferIDs[transfer.ID] {
+			return types.ErrInvalidTransferRestrictions(fmt.Sprintf("duplicate delayed transfer id %d", transfer.ID))
+		}
+		transferIDs[transfer.ID] = true
+	}
+	pendingAddrs := make(map[string]bool)
+	for _, pending := range data.PendingTransferRestrictions {
+		if err := pending.Validate(); err != nil {
+			return err
+		}
+		if pendingAddrs[string(pending.Address)] {
+			return types.ErrInvalidTransferRestrictions(fmt.Sprintf("duplicate pending transfer restrictions of %s", pending.Address))
+		}
+		pendingAddrs[string(pending.Address)] = true
+	}
 	return nil
 }
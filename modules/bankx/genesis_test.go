@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 )
@@ -20,6 +21,15 @@ func TestValidate(t *testing.T) {
 	require.Equal(t, errGenes.ValidateGenesis(), types.ErrInvalidLockCoinsFreeTime())
 	errGenes = bankx.NewGenesisState(bankx.NewParams(0, 0, -1))
 	require.Equal(t, errGenes.ValidateGenesis(), types.ErrInvalidLockCoinsFee())
+
+	pending := bankx.NewPendingTransferRestrictions(fromAddr, authx.TransferRestrictions{}, 100)
+	genes.PendingTransferRestrictions = []bankx.PendingTransferRestrictions{pending}
+	require.Nil(t, genes.ValidateGenesis())
+	genes.PendingTransferRestrictions = []bankx.PendingTransferRestrictions{pending, pending}
+	require.Error(t, genes.ValidateGenesis())
+	pending.EffectiveTime = 0
+	genes.PendingTransferRestrictions = []bankx.PendingTransferRestrictions{pending}
+	require.Error(t, genes.ValidateGenesis())
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -28,4 +38,11 @@ func TestInitGenesis(t *testing.T) {
 	bankx.InitGenesis(ctx, *bkx, genes)
 	gen := bankx.ExportGenesis(ctx, *bkx)
 	require.Equal(t, genes, gen)
+
+	genes.PendingTransferRestrictions = []bankx.PendingTransferRestrictions{
+		bankx.NewPendingTransferRestrictions(fromAddr, authx.NewTransferRestrictions(nil, nil, nil, 0), 100),
+	}
+	bankx.InitGenesis(ctx, *bkx, genes)
+	gen = bankx.ExportGenesis(ctx, *bkx)
+	require.Equal(t, genes, gen)
 }
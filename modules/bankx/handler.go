@@ -8,6 +8,7 @@ import (
 
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
 	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -24,6 +25,30 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgMultiSend(ctx, k, msg)
 		case types.MsgSupervisedSend:
 			return handleMsgSupervisedSend(ctx, k, msg)
+		case types.MsgVestingSend:
+			return handleMsgVestingSend(ctx, k, msg)
+		case types.MsgCreateEscrow:
+			return handleMsgCreateEscrow(ctx, k, msg)
+		case types.MsgResolveEscrow:
+			return handleMsgResolveEscrow(ctx, k, msg)
+		case types.MsgCreateHTLC:
+			return handleMsgCreateHTLC(ctx, k, msg)
+		case types.MsgClaimHTLC:
+			return handleMsgClaimHTLC(ctx, k, msg)
+		case types.MsgCreateRecurringPayment:
+			return handleMsgCreateRecurringPayment(ctx, k, msg)
+		case types.MsgCancelRecurringPayment:
+			return handleMsgCancelRecurringPayment(ctx, k, msg)
+		case types.MsgApprove:
+			return handleMsgApprove(ctx, k, msg)
+		case types.MsgTransferFrom:
+			return handleMsgTransferFrom(ctx, k, msg)
+		case types.MsgSetTransferRestrictions:
+			return handleMsgSetTransferRestrictions(ctx, k, msg)
+		case types.MsgCancelDelayedTransfer:
+			return handleMsgCancelDelayedTransfer(ctx, k, msg)
+		case types.MsgCancelTransferRestrictions:
+			return handleMsgCancelTransferRestrictions(ctx, k, msg)
 		default:
 			return dex.ErrUnknownRequest(ModuleName, msg)
 		}
@@ -97,6 +122,17 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 		return sdk.ErrInsufficientCoins("sender has insufficient coins for the transfer").Result()
 	}
 
+	needsDelay := k.NeedsTransferDelay(ctx, msg.FromAddress, amt)
+	if needsDelay && msg.UnlockTime != 0 {
+		return types.ErrInvalidTransferRestrictions("transfer needs a delay and can not be a locked send").Result()
+	}
+	// SendCoins applies the transfer restrictions to a normal send, the coins of the others are not sent by it
+	if needsDelay || msg.UnlockTime != 0 {
+		if err := k.ApplyTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, amt); err != nil {
+			return err.Result()
+		}
+	}
+
 	//check whether toAccount exist
 	amt, err := k.DeductActivationFee(ctx, msg.FromAddress, msg.ToAddress, amt)
 	if err != nil {
@@ -113,6 +149,9 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg types.MsgSend) sdk.Result {
 	if time != 0 {
 		return lockedSend(ctx, k, msg.FromAddress, msg.ToAddress, amt, time)
 	}
+	if needsDelay {
+		return delayedSend(ctx, k, msg.FromAddress, msg.ToAddress, amt)
+	}
 	return normalSend(ctx, k, msg.FromAddress, msg.ToAddress, amt)
 }
 
@@ -145,6 +184,32 @@ func lockedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt
 	}
 }
 
+// delayedSend holds the coins until the transfer delay of the sender has passed, the sender can cancel it meanwhile
+func delayedSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Result {
+	executeTime := ctx.BlockHeader().Time.Unix() + k.GetTransferRestrictions(ctx, fromAddr).TransferDelay
+	transfer := types.NewDelayedTransfer(fromAddr, toAddr, amt, executeTime)
+	id, err := k.CreateDelayedTransfer(ctx, transfer)
+	if err != nil {
+		return err.Result()
+	}
+	transfer.ID = id
+
+	fillMsgQueue(ctx, k, "delay_transfer", transfer)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelayTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
 func normalSend(ctx sdk.Context, k Keeper, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Result {
 	err := k.SendCoins(ctx, fromAddr, toAddr, amt)
 	if err != nil {
@@ -225,6 +290,9 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 		if !k.HasCoins(ctx, msg.FromAddress, amt) {
 			return sdk.ErrInsufficientCoins("sender has insufficient coin for the transfer").Result()
 		}
+		if err := k.ApplyUndelayedTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, amt); err != nil {
+			return err.Result()
+		}
 		if err := k.SendLockedCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Supervisor, amt, msg.UnlockTime, msg.Reward, true); err != nil {
 			return err.Result()
 		}
@@ -263,6 +331,453 @@ func handleMsgSupervisedSend(ctx sdk.Context, k Keeper, msg types.MsgSupervisedS
 	}
 }
 
+func handleMsgVestingSend(ctx sdk.Context, k Keeper, msg types.MsgVestingSend) sdk.Result {
+	if enabled := k.GetSendEnabled(ctx); !enabled {
+		return bank.ErrSendDisabled(types.CodeSpaceBankx).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	if k.IsSendForbidden(ctx, msg.Amount, msg.FromAddress) {
+		if denom, exist := k.IsTokensExist(ctx, msg.Amount); !exist {
+			return types.ErrInvalidTokenSymbol(denom).Result()
+		}
+		return types.ErrTokenForbiddenByOwner().Result()
+	}
+
+	if msg.StartTime < ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidVestingSchedule("Invalid Start Time:" +
+			fmt.Sprintf("%d < %d", msg.StartTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+
+	amt := msg.Amount
+	if !k.HasCoins(ctx, msg.FromAddress, amt) {
+		return sdk.ErrInsufficientCoins("sender has insufficient coins for the transfer").Result()
+	}
+	if err := k.ApplyUndelayedTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, amt); err != nil {
+		return err.Result()
+	}
+
+	amt, err := k.DeductActivationFee(ctx, msg.FromAddress, msg.ToAddress, amt)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := k.SendVestingCoins(ctx, msg.FromAddress, msg.ToAddress, amt,
+		msg.StartTime, msg.CliffTime, msg.Period, msg.Periods); err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "send_vesting_coins", types.VestingSendMsg{
+		FromAddress: msg.FromAddress,
+		ToAddress:   msg.ToAddress,
+		Amount:      amt,
+		StartTime:   msg.StartTime,
+		CliffTime:   msg.CliffTime,
+		Period:      msg.Period,
+		Periods:     msg.Periods,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCreateEscrow(ctx sdk.Context, k Keeper, msg types.MsgCreateEscrow) sdk.Result {
+	if enabled := k.GetSendEnabled(ctx); !enabled {
+		return bank.ErrSendDisabled(types.CodeSpaceBankx).Result()
+	}
+	for _, addr := range []sdk.AccAddress{msg.Seller, msg.Arbiter} {
+		if k.BlacklistedAddr(addr) {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", addr)).Result()
+		}
+	}
+	if msg.Deadline <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidEscrow("Invalid Deadline:" +
+			fmt.Sprintf("%d <= %d", msg.Deadline, ctx.BlockHeader().Time.Unix())).Result()
+	}
+	if denom, exist := k.IsTokensExist(ctx, msg.Amount.Add(msg.ArbiterFee)); !exist {
+		return types.ErrInvalidTokenSymbol(denom).Result()
+	}
+
+	// both the seller and the arbiter may be paid from the escrow, so both must accept coins from the buyer
+	total := msg.Amount.Add(msg.ArbiterFee)
+	if err := k.CheckTransferRestrictions(ctx, msg.Buyer, msg.Arbiter, total); err != nil {
+		return err.Result()
+	}
+	if err := k.ApplyUndelayedTransferRestrictions(ctx, msg.Buyer, msg.Seller, total); err != nil {
+		return err.Result()
+	}
+
+	escrow := msg.ToEscrow()
+	id, err := k.CreateEscrow(ctx, escrow)
+	if err != nil {
+		return err.Result()
+	}
+	escrow.ID = id
+
+	fillMsgQueue(ctx, k, "create_escrow", escrow)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Buyer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Seller.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgResolveEscrow(ctx sdk.Context, k Keeper, msg types.MsgResolveEscrow) sdk.Result {
+	result, err := k.ResolveEscrow(ctx, msg.Sender, msg.ID, msg.Decision, msg.SellerAmount)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "resolve_escrow", result)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeResolveEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", msg.ID)),
+			sdk.NewAttribute(types.AttributeKeyDecision, fmt.Sprintf("%d", msg.Decision)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCreateHTLC(ctx sdk.Context, k Keeper, msg types.MsgCreateHTLC) sdk.Result {
+	if enabled := k.GetSendEnabled(ctx); !enabled {
+		return bank.ErrSendDisabled(types.CodeSpaceBankx).Result()
+	}
+	if k.BlacklistedAddr(msg.Recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.Recipient)).Result()
+	}
+	if msg.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidHTLC("Invalid Expire Time:" +
+			fmt.Sprintf("%d <= %d", msg.ExpireTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+	if denom, exist := k.IsTokensExist(ctx, msg.Amount); !exist {
+		return types.ErrInvalidTokenSymbol(denom).Result()
+	}
+
+	if err := k.ApplyUndelayedTransferRestrictions(ctx, msg.Sender, msg.Recipient, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	htlc := msg.ToHTLC()
+	if err := k.CreateHTLC(ctx, htlc); err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "create_htlc", htlc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgClaimHTLC(ctx sdk.Context, k Keeper, msg types.MsgClaimHTLC) sdk.Result {
+	settlement, err := k.ClaimHTLC(ctx, msg.HashLock, msg.Secret)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "claim_htlc", settlement)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeClaimHTLC,
+			sdk.NewAttribute(types.AttributeKeyHashLock, msg.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeySecret, msg.Secret.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, settlement.Recipient.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCreateRecurringPayment(ctx sdk.Context, k Keeper, msg types.MsgCreateRecurringPayment) sdk.Result {
+	if k.BlacklistedAddr(msg.Recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.Recipient)).Result()
+	}
+	if msg.StartTime < ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidRecurringPayment("Invalid Start Time:" +
+			fmt.Sprintf("%d < %d", msg.StartTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+	if denom, exist := k.IsTokensExist(ctx, msg.Amount); !exist {
+		return types.ErrInvalidTokenSymbol(denom).Result()
+	}
+
+	payment := msg.ToRecurringPayment()
+	id, err := k.CreateRecurringPayment(ctx, payment)
+	if err != nil {
+		return err.Result()
+	}
+	payment.ID = id
+
+	fillMsgQueue(ctx, k, "create_recurring_payment", payment)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Payer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCreateRecurringPayment,
+			sdk.NewAttribute(types.AttributeKeyPaymentID, fmt.Sprintf("%d", payment.ID)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelRecurringPayment(ctx sdk.Context, k Keeper, msg types.MsgCancelRecurringPayment) sdk.Result {
+	if err := k.CancelRecurringPayment(ctx, msg.Payer, msg.ID); err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "cancel_recurring_payment", msg)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Payer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelRecurringPayment,
+			sdk.NewAttribute(types.AttributeKeyPaymentID, fmt.Sprintf("%d", msg.ID)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgApprove(ctx sdk.Context, k Keeper, msg types.MsgApprove) sdk.Result {
+	if msg.ExpireTime != 0 && msg.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.ErrInvalidAllowance("Invalid Expire Time:" +
+			fmt.Sprintf("%d <= %d", msg.ExpireTime, ctx.BlockHeader().Time.Unix())).Result()
+	}
+	if denom, exist := k.IsTokensExist(ctx, sdk.Coins{msg.Amount}); !exist {
+		return types.ErrInvalidTokenSymbol(denom).Result()
+	}
+
+	k.SetAllowance(ctx, msg.ToAllowance())
+
+	fillMsgQueue(ctx, k, "approve", msg.ToAllowance())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Owner.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeApprove,
+			sdk.NewAttribute(types.AttributeKeySpender, msg.Spender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgTransferFrom(ctx sdk.Context, k Keeper, msg types.MsgTransferFrom) sdk.Result {
+	if enabled := k.GetSendEnabled(ctx); !enabled {
+		return bank.ErrSendDisabled(types.CodeSpaceBankx).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	if k.IsSendForbidden(ctx, msg.Amount, msg.Owner) {
+		if denom, exist := k.IsTokensExist(ctx, msg.Amount); !exist {
+			return types.ErrInvalidTokenSymbol(denom).Result()
+		}
+		return types.ErrTokenForbiddenByOwner().Result()
+	}
+
+	if !k.HasCoins(ctx, msg.Owner, msg.Amount) {
+		return sdk.ErrInsufficientCoins("owner has insufficient coins for the transfer").Result()
+	}
+
+	if err := k.SpendAllowances(ctx, msg.Owner, msg.Spender, msg.Amount); err != nil {
+		return err.Result()
+	}
+	// the activation fee of a fresh recipient is paid out of the transferred amount, as in MsgSend
+	amt, err := k.DeductActivationFee(ctx, msg.Owner, msg.ToAddress, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// SendCoins applies the transfer restrictions
+	if err := k.SendCoins(ctx, msg.Owner, msg.ToAddress, amt); err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "transfer_from", msg)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Spender.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeTransferFrom,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSetTransferRestrictions(ctx sdk.Context, k Keeper, msg types.MsgSetTransferRestrictions) sdk.Result {
+	effectiveTime, err := k.SetTransferRestrictions(ctx, msg.Address, msg.ToTransferRestrictions())
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetTransferRestrictions,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, fmt.Sprintf("%d", effectiveTime)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelTransferRestrictions(ctx sdk.Context, k Keeper, msg types.MsgCancelTransferRestrictions) sdk.Result {
+	if err := k.CancelPendingTransferRestrictions(ctx, msg.Address); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelTransferRestrictions,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCancelDelayedTransfer(ctx sdk.Context, k Keeper, msg types.MsgCancelDelayedTransfer) sdk.Result {
+	settlement, err := k.CancelDelayedTransfer(ctx, msg.FromAddress, msg.ID)
+	if err != nil {
+		return err.Result()
+	}
+
+	fillMsgQueue(ctx, k, "cancel_delayed_transfer", settlement)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelDelayedTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, fmt.Sprintf("%d", msg.ID)),
+		),
+	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue,
+// "notify_unlock" is shared with authx and registered by it.
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register("send_lock_coins", 1, types.LockedSendMsg{})
+	reg.Register("send_vesting_coins", 1, types.VestingSendMsg{})
+	reg.Register("create_escrow", 1, types.Escrow{})
+	reg.Register("resolve_escrow", 1, types.EscrowResult{})
+	reg.Register("create_htlc", 1, types.HTLC{})
+	reg.Register("claim_htlc", 1, types.HTLCSettlement{})
+	reg.Register("refund_htlc", 1, types.HTLCSettlement{})
+	reg.Register("create_recurring_payment", 1, types.RecurringPayment{})
+	reg.Register("cancel_recurring_payment", 1, types.MsgCancelRecurringPayment{})
+	reg.Register("recurring_payment", 1, types.RecurringPaymentExecution{})
+	reg.Register("approve", 1, types.Allowance{})
+	reg.Register("transfer_from", 1, types.MsgTransferFrom{})
+	reg.Register("delay_transfer", 1, types.DelayedTransfer{})
+	reg.Register("cancel_delayed_transfer", 1, types.DelayedTransferSettlement{})
+	reg.Register("execute_delayed_transfer", 1, types.DelayedTransferSettlement{})
+}
+
 func fillMsgQueue(ctx sdk.Context, keeper Keeper, key string, msg interface{}) {
 	if keeper.MsgProducer.IsSubscribed(types.Topic) {
 		msgqueue.FillMsgs(ctx, key, msg)
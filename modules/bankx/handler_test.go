@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
+	"github.com/coinexchain/cet-sdk/modules/authx"
 	"github.com/coinexchain/cet-sdk/modules/bankx"
 	"github.com/coinexchain/cet-sdk/modules/bankx/internal/keeper"
 	bx "github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
@@ -175,6 +176,36 @@ func TestHandleMsgSetMemoRequiredAccountOK(t *testing.T) {
 	require.Equal(t, true, bkx.GetMemoRequired(ctx, myaddr))
 }
 
+func TestAnteCheckMemoRequired(t *testing.T) {
+	bkx, _, ctx := defaultContext()
+	require.NoError(t, bkx.AddCoins(ctx, toAddr, sdk.Coins{}))
+	require.NoError(t, bkx.SetMemoRequired(ctx, toAddr, true))
+
+	ah := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		return ctx, sdk.Result{}, false
+	}
+	ah2 := authx.WrapAnteHandler(ah, authx.AccountXKeeper{}, *bkx)
+
+	now := ctx.BlockHeader().Time.Unix()
+	msgs := []sdk.Msg{
+		bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1e8), 0),
+		bankx.NewMsgTransferFrom(myaddr, fromAddr, toAddr, dex.NewCetCoins(1e8)),
+		bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(1e8), now, now, 100, 1),
+	}
+	for _, msg := range msgs {
+		_, res, abort := ah2(ctx, auth.StdTx{Msgs: []sdk.Msg{msg}}, true)
+		require.True(t, abort)
+		require.Equal(t, bx.CodeMemoMissing, res.Code)
+		_, res, abort = ah2(ctx, auth.StdTx{Msgs: []sdk.Msg{msg}, Memo: "invoice 1"}, true)
+		require.False(t, abort)
+		require.True(t, res.IsOK())
+	}
+
+	// no memo is needed by the other recipients
+	_, _, abort := ah2(ctx, auth.StdTx{Msgs: []sdk.Msg{bankx.NewMsgSend(fromAddr, myaddr, dex.NewCetCoins(1e8), 0)}}, true)
+	require.False(t, abort)
+}
+
 func TestUnlockQueueNotAppend(t *testing.T) {
 	bkx, handle, ctx := defaultContext()
 
@@ -422,3 +453,438 @@ func TestHandleMsgSupervisedSendException(t *testing.T) {
 		require.Equal(t, tc.code, ret.Code)
 	}
 }
+
+func TestHandleMsgVestingSend(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+
+	fee := bkx.GetParams(ctx).LockCoinsFeePerDay
+	now := ctx.BlockHeader().Time.Unix()
+	lockFreeTime := now + bkx.GetParams(ctx).LockCoinsFreeTime/int64(time.Second)
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8+fee))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, toAddr, dex.NewCetCoins(1e8))
+	require.NoError(t, err)
+
+	msg := bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(4e8), now-1, 0, 100, 4)
+	res := handle(ctx, msg)
+	require.Equal(t, bx.CodeInvalidVestingSchedule, res.Code)
+
+	// the lock fee is charged on the end of the schedule
+	msg = bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(4e8), lockFreeTime-400, 0, 101, 4)
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(6e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+
+	locked := bkx.GetLockedCoins(ctx, toAddr)
+	require.Equal(t, 1, len(locked))
+	require.True(t, locked[0].IsVesting())
+	require.Equal(t, lockFreeTime+4, locked[0].UnlockTime)
+	require.Equal(t, lockFreeTime-299, locked[0].NextVestingTime())
+}
+
+func TestHandleMsgEscrow(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	arbiter := supervisor
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+
+	msg := bankx.NewMsgCreateEscrow(fromAddr, toAddr, arbiter, dex.NewCetCoins(3e8), dex.NewCetCoins(1e8), now, bankx.EscrowRefund)
+	res := handle(ctx, msg)
+	require.Equal(t, bx.CodeInvalidEscrow, res.Code)
+
+	// released by the buyer, the arbiter fee goes back to the buyer
+	msg.Deadline = now + 100
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(6e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	escrow, found := bkx.GetEscrow(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, toAddr, escrow.Seller)
+
+	res = handle(ctx, bankx.NewMsgResolveEscrow(toAddr, 1, bankx.EscrowRelease, nil))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = handle(ctx, bankx.NewMsgResolveEscrow(fromAddr, 1, bankx.EscrowRelease, nil))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	_, found = bkx.GetEscrow(ctx, 1)
+	require.False(t, found)
+
+	// split by the arbiter, who is paid the fee
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	res = handle(ctx, bankx.NewMsgResolveEscrow(fromAddr, 2, bankx.EscrowSplit, dex.NewCetCoins(1e8)))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = handle(ctx, bankx.NewMsgResolveEscrow(arbiter, 2, bankx.EscrowSplit, dex.NewCetCoins(4e8)))
+	require.Equal(t, bx.CodeInvalidEscrow, res.Code)
+	res = handle(ctx, bankx.NewMsgResolveEscrow(arbiter, 2, bankx.EscrowSplit, dex.NewCetCoins(1e8)))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(4e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, arbiter).AmountOf("cet"))
+
+	// settled with the default outcome after the deadline
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 1, len(bkx.GetEscrows(ctx, arbiter)))
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+99, 0)), *bkx)
+	require.Equal(t, 1, len(bkx.GetEscrows(ctx, nil)))
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+100, 0)), *bkx)
+	require.Equal(t, 0, len(bkx.GetEscrows(ctx, nil)))
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(4e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+}
+
+func TestHandleMsgEscrowTransferRestrictions(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	arbiter := supervisor
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, arbiter, sdk.Coins{})
+	require.NoError(t, err)
+	msg := bankx.NewMsgCreateEscrow(fromAddr, toAddr, arbiter, dex.NewCetCoins(3e8), dex.NewCetCoins(1e8), now+100, bankx.EscrowRefund)
+
+	// the arbiter fee counts against the daily send limit of the buyer
+	res := handle(ctx, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, dex.NewCetCoins(35e7), nil, 0))
+	require.True(t, res.IsOK())
+	res = handle(ctx, msg)
+	require.Equal(t, bx.CodeDailySendLimitExceeded, res.Code)
+	res = handle(ctx, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, nil, nil, 0))
+	require.True(t, res.IsOK())
+
+	// the arbiter must accept coins from the buyer
+	res = handle(ctx, bankx.NewMsgSetTransferRestrictions(arbiter, []sdk.AccAddress{myaddr}, nil, nil, 0))
+	require.True(t, res.IsOK())
+	res = handle(ctx, msg)
+	require.Equal(t, bx.CodeTransferNotWhitelisted, res.Code)
+	res = handle(ctx, bankx.NewMsgSetTransferRestrictions(arbiter, []sdk.AccAddress{fromAddr}, nil, nil, 0))
+	require.True(t, res.IsOK())
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(6e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+}
+
+func TestSettleExpiredEscrowFailed(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+	msg := bankx.NewMsgCreateEscrow(fromAddr, toAddr, supervisor, dex.NewCetCoins(3e8), dex.NewCetCoins(1e8), now+200, bankx.EscrowRefund)
+	require.True(t, handle(ctx, msg).IsOK())
+
+	// the module account can pay the seller but not the arbiter fee back to the buyer
+	escrow := bankx.NewEscrow(myaddr, owner, supervisor, dex.NewCetCoins(3e8), dex.NewCetCoins(2e8), now+100, bankx.EscrowRelease)
+	escrow.ID = 99
+	bkx.ImportGenesisEscrow(ctx, escrow)
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+100, 0)), *bkx)
+	require.True(t, bkx.GetCoins(ctx, owner).AmountOf("cet").IsZero())
+	_, found := bkx.GetEscrow(ctx, 99)
+	require.True(t, found)
+
+	// retried in the next block
+	require.NoError(t, bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(1e8)))
+	msg.Deadline = now + 300
+	require.True(t, handle(ctx, msg).IsOK())
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+101, 0)), *bkx)
+	_, found = bkx.GetEscrow(ctx, 99)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, owner).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(2e8), bkx.GetCoins(ctx, myaddr).AmountOf("cet"))
+}
+
+func TestHandleMsgHTLC(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+
+	secret := []byte("secret")
+	hashLock := bankx.HashSecret(secret)
+	msg := bankx.NewMsgCreateHTLC(fromAddr, toAddr, dex.NewCetCoins(3e8), hashLock, now)
+	res := handle(ctx, msg)
+	require.Equal(t, bx.CodeInvalidHTLC, res.Code)
+
+	msg.ExpireTime = now + 100
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	res = handle(ctx, msg)
+	require.Equal(t, bx.CodeInvalidHTLC, res.Code)
+
+	// claimed by anyone for the recipient
+	res = handle(ctx, bankx.NewMsgClaimHTLC(myaddr, hashLock, []byte("wrong")))
+	require.Equal(t, bx.CodeInvalidHTLC, res.Code)
+	res = handle(ctx, bankx.NewMsgClaimHTLC(myaddr, hashLock, secret))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	res = handle(ctx, bankx.NewMsgClaimHTLC(myaddr, hashLock, secret))
+	require.Equal(t, bx.CodeHTLCNotFound, res.Code)
+
+	// refunded after expiry
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 1, len(bkx.GetHTLCs(ctx, toAddr)))
+	expired := ctx.WithBlockTime(time.Unix(now+100, 0))
+	res = handle(expired, bankx.NewMsgClaimHTLC(myaddr, hashLock, secret))
+	require.Equal(t, bx.CodeInvalidHTLC, res.Code)
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+99, 0)), *bkx)
+	require.Equal(t, 1, len(bkx.GetHTLCs(ctx, nil)))
+	bankx.EndBlocker(expired, *bkx)
+	require.Equal(t, 0, len(bkx.GetHTLCs(ctx, nil)))
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+}
+
+func TestHandleMsgRecurringPayment(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+	hour := int64(bankx.MinRecurringPaymentInterval)
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(5e8))
+	require.NoError(t, err)
+
+	msg := bankx.NewMsgCreateRecurringPayment(fromAddr, toAddr, dex.NewCetCoins(2e8), now-1, hour, 3, 0)
+	res := handle(ctx, msg)
+	require.Equal(t, bx.CodeInvalidRecurringPayment, res.Code)
+
+	msg.StartTime = now + 100
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	require.Equal(t, 1, len(bkx.GetRecurringPayments(ctx, toAddr)))
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+
+	// not due yet
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+99, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+
+	// the activation fee of the fresh recipient is deducted from the first payment
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+100, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+100+hour, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+
+	// skipped when the payer can not afford it, and finished after the last one
+	bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+100+2*hour, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Equal(t, 0, len(bkx.GetRecurringPayments(ctx, nil)))
+
+	// cancelled after too many consecutive skips
+	msg.StartTime = now + 200
+	msg.Count = 10
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	for i := int64(0); i < bankx.MaxRecurringPaymentFailures; i++ {
+		require.Equal(t, 1, len(bkx.GetRecurringPayments(ctx, nil)))
+		bankx.EndBlocker(ctx.WithBlockTime(time.Unix(now+200+i*hour, 0)), *bkx)
+	}
+	require.Equal(t, 0, len(bkx.GetRecurringPayments(ctx, nil)))
+
+	// cancelled by the payer only
+	res = handle(ctx, msg)
+	require.True(t, res.IsOK())
+	id := bkx.GetRecurringPayments(ctx, fromAddr)[0].ID
+	res = handle(ctx, bankx.NewMsgCancelRecurringPayment(toAddr, id))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = handle(ctx, bankx.NewMsgCancelRecurringPayment(fromAddr, id))
+	require.True(t, res.IsOK())
+	require.Equal(t, 0, len(bkx.GetRecurringPayments(ctx, nil)))
+	res = handle(ctx, bankx.NewMsgCancelRecurringPayment(fromAddr, id))
+	require.Equal(t, bx.CodeRecurringPaymentNotFound, res.Code)
+
+	// limited number of payments per payer
+	for i := 0; i < bankx.MaxRecurringPaymentsPerPayer; i++ {
+		require.True(t, handle(ctx, msg).IsOK())
+	}
+	require.Equal(t, bx.CodeInvalidRecurringPayment, handle(ctx, msg).Code)
+	require.True(t, handle(ctx, bankx.NewMsgCancelRecurringPayment(fromAddr, id+1)).IsOK())
+	require.True(t, handle(ctx, msg).IsOK())
+}
+
+func TestHandleMsgTransferFrom(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, toAddr, sdk.Coins{})
+	require.NoError(t, err)
+
+	transfer := bankx.NewMsgTransferFrom(myaddr, fromAddr, toAddr, dex.NewCetCoins(3e8))
+	res := handle(ctx, transfer)
+	require.Equal(t, bx.CodeInsufficientAllowance, res.Code)
+
+	res = handle(ctx, bankx.NewMsgApprove(fromAddr, myaddr, dex.NewCetCoin(5e8), now))
+	require.Equal(t, bx.CodeInvalidAllowance, res.Code)
+	res = handle(ctx, bankx.NewMsgApprove(fromAddr, myaddr, dex.NewCetCoin(5e8), now+100))
+	require.True(t, res.IsOK())
+	require.Equal(t, 1, len(bkx.GetAllowances(ctx, myaddr)))
+
+	res = handle(ctx, transfer)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(7e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	allowance, _ := bkx.GetAllowance(ctx, fromAddr, myaddr, "cet")
+	require.Equal(t, sdk.NewInt(2e8), allowance.Amount.Amount)
+
+	// the allowance is not spent by a failed transfer
+	res = handle(ctx, transfer)
+	require.Equal(t, bx.CodeInsufficientAllowance, res.Code)
+	res = handle(ctx.WithBlockTime(time.Unix(now+100, 0)), bankx.NewMsgTransferFrom(myaddr, fromAddr, toAddr, dex.NewCetCoins(1e8)))
+	require.Equal(t, bx.CodeInsufficientAllowance, res.Code)
+
+	// the owner can still be forbidden to send the token
+	res = handle(ctx, bankx.NewMsgApprove(forbiddenAddr, myaddr, dex.NewCetCoin(5e8), 0))
+	require.True(t, res.IsOK())
+	err = bkx.AddCoins(ctx, forbiddenAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+	res = handle(ctx, bankx.NewMsgTransferFrom(myaddr, forbiddenAddr, toAddr, dex.NewCetCoins(1e8)))
+	require.Equal(t, bx.CodeTokenForbiddenByOwner, res.Code)
+
+	// a zero amount revokes the allowance
+	res = handle(ctx, bankx.NewMsgApprove(fromAddr, myaddr, dex.NewCetCoin(0), 0))
+	require.True(t, res.IsOK())
+	_, found := bkx.GetAllowance(ctx, fromAddr, myaddr, "cet")
+	require.False(t, found)
+
+	// the memo required by the recipient is checked by the ante handler
+	bkx.SetMemoRequired(ctx, toAddr, true)
+	require.Equal(t, bx.CodeMemoMissing, bkx.CheckMsg(ctx, transfer, "").Code())
+	require.Nil(t, bkx.CheckMsg(ctx, transfer, "memo"))
+}
+
+func TestHandleMsgTransferRestrictions(t *testing.T) {
+	bkx, handle, ctx := defaultContext()
+	now := ctx.BlockHeader().Time.Unix()
+
+	err := bkx.AddCoins(ctx, fromAddr, dex.NewCetCoins(10e8))
+	require.NoError(t, err)
+	err = bkx.AddCoins(ctx, toAddr, sdk.Coins{})
+	require.NoError(t, err)
+
+	// only the whitelisted addresses can send to the account
+	res := handle(ctx, bankx.NewMsgSetTransferRestrictions(toAddr, []sdk.AccAddress{myaddr}, nil, nil, 0))
+	require.True(t, res.IsOK())
+	send := bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(1e8), 0)
+	require.Equal(t, bx.CodeTransferNotWhitelisted, bkx.CheckMsg(ctx, send, "").Code())
+	res = handle(ctx, send)
+	require.Equal(t, bx.CodeTransferNotWhitelisted, res.Code)
+	res = handle(ctx, bankx.NewMsgMultiSend([]bank.Input{bank.NewInput(fromAddr, dex.NewCetCoins(1e8))},
+		[]bank.Output{bank.NewOutput(toAddr, dex.NewCetCoins(1e8))}))
+	require.Equal(t, bx.CodeTransferNotWhitelisted, res.Code)
+	res = handle(ctx, bankx.NewMsgSetTransferRestrictions(toAddr, []sdk.AccAddress{fromAddr}, nil, nil, 0))
+	require.True(t, res.IsOK())
+	require.Nil(t, bkx.CheckMsg(ctx, send, ""))
+	res = handle(ctx, send)
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(1e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+
+	// large transfers are delayed and all of them count against the daily send limit
+	res = handle(ctx, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, dex.NewCetCoins(4e8), dex.NewCetCoins(2e8), 100))
+	require.True(t, res.IsOK())
+	res = handle(ctx, send)
+	require.True(t, res.IsOK())
+	res = handle(ctx, bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(2e8), 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(6e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(2e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	transfers := bkx.GetDelayedTransfers(ctx, toAddr)
+	require.Equal(t, 1, len(transfers))
+	require.Equal(t, now+100, transfers[0].ExecuteTime)
+	res = handle(ctx, bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(2e8), 0))
+	require.Equal(t, bx.CodeDailySendLimitExceeded, res.Code)
+
+	// cancelled by the sender only
+	res = handle(ctx, bankx.NewMsgCancelDelayedTransfer(toAddr, transfers[0].ID))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	res = handle(ctx, bankx.NewMsgCancelDelayedTransfer(fromAddr, transfers[0].ID))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(8e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	res = handle(ctx, bankx.NewMsgCancelDelayedTransfer(fromAddr, transfers[0].ID))
+	require.Equal(t, bx.CodeDelayedTransferNotFound, res.Code)
+
+	// the limit is reset the next day, and the delayed transfer is executed when it is due
+	nextDay := ctx.WithBlockTime(time.Unix(now+authx.SecondsPerDay, 0))
+	res = handle(nextDay, bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(3e8), 0))
+	require.True(t, res.IsOK())
+	bankx.EndBlocker(nextDay.WithBlockTime(time.Unix(now+authx.SecondsPerDay+99, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(2e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	bankx.EndBlocker(nextDay.WithBlockTime(time.Unix(now+authx.SecondsPerDay+100, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, toAddr).AmountOf("cet"))
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, 0, len(bkx.GetDelayedTransfers(ctx, nil)))
+
+	// a spender can not make a transfer which needs a delay
+	res = handle(nextDay, bankx.NewMsgApprove(fromAddr, myaddr, dex.NewCetCoin(5e8), 0))
+	require.True(t, res.IsOK())
+	res = handle(nextDay, bankx.NewMsgTransferFrom(myaddr, fromAddr, toAddr, dex.NewCetCoins(2e8)))
+	require.Equal(t, bx.CodeInvalidTransferRestrictions, res.Code)
+
+	// neither can the other transfers which do not go through a plain send
+	day2 := now + 2*authx.SecondsPerDay
+	ctx2 := ctx.WithBlockTime(time.Unix(day2, 0))
+	res = handle(ctx2, bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(2e8), day2+1000))
+	require.Equal(t, bx.CodeInvalidTransferRestrictions, res.Code)
+	res = handle(ctx2, bankx.NewMsgVestingSend(fromAddr, toAddr, dex.NewCetCoins(2e8), day2, day2, 100, 1))
+	require.Equal(t, bx.CodeInvalidTransferRestrictions, res.Code)
+	res = handle(ctx2, bankx.NewMsgCreateEscrow(fromAddr, toAddr, myaddr, dex.NewCetCoins(2e8), nil, day2+100, bankx.EscrowRefund))
+	require.Equal(t, bx.CodeInvalidTransferRestrictions, res.Code)
+	res = handle(ctx2, bankx.NewMsgCreateRecurringPayment(fromAddr, toAddr, dex.NewCetCoins(2e8), day2+100, 3600, 1, 0))
+	require.True(t, res.IsOK())
+	bankx.EndBlocker(ctx2.WithBlockTime(time.Unix(day2+100, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx, fromAddr).AmountOf("cet"))
+	require.Equal(t, 0, len(bkx.GetDelayedTransfers(ctx, nil)))
+
+	// looser restrictions take effect after the current transfer delay, and can be cancelled until then
+	res = handle(ctx2, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, nil, nil, 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(100), bkx.GetTransferRestrictions(ctx2, fromAddr).TransferDelay)
+	pending, found := bkx.GetPendingTransferRestrictions(ctx2, fromAddr)
+	require.True(t, found)
+	require.Equal(t, day2+100, pending.EffectiveTime)
+	res = handle(ctx2, bankx.NewMsgCancelTransferRestrictions(fromAddr))
+	require.True(t, res.IsOK())
+	res = handle(ctx2, bankx.NewMsgCancelTransferRestrictions(fromAddr))
+	require.Equal(t, bx.CodeTransferRestrictionsNotPending, res.Code)
+	bankx.EndBlocker(ctx2.WithBlockTime(time.Unix(day2+100, 0)), *bkx)
+	require.Equal(t, int64(100), bkx.GetTransferRestrictions(ctx2, fromAddr).TransferDelay)
+
+	res = handle(ctx2, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, nil, nil, 0))
+	require.True(t, res.IsOK())
+	bankx.EndBlocker(ctx2.WithBlockTime(time.Unix(day2+99, 0)), *bkx)
+	require.Equal(t, int64(100), bkx.GetTransferRestrictions(ctx2, fromAddr).TransferDelay)
+	bankx.EndBlocker(ctx2.WithBlockTime(time.Unix(day2+100, 0)), *bkx)
+	require.Equal(t, authx.TransferRestrictions{}, bkx.GetTransferRestrictions(ctx2, fromAddr))
+	require.Equal(t, 0, len(bkx.GetAllPendingTransferRestrictions(ctx2)))
+
+	// tighter restrictions take effect at once
+	res = handle(ctx2, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, nil, dex.NewCetCoins(2e8), 100))
+	require.True(t, res.IsOK())
+	res = handle(ctx2, bankx.NewMsgSetTransferRestrictions(fromAddr, nil, dex.NewCetCoins(4e8), dex.NewCetCoins(1e8), 200))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(200), bkx.GetTransferRestrictions(ctx2, fromAddr).TransferDelay)
+	_, found = bkx.GetPendingTransferRestrictions(ctx2, fromAddr)
+	require.False(t, found)
+
+	// a delayed transfer is refunded if the recipient no longer accepts coins from the sender when it is due
+	received := bkx.GetCoins(ctx2, toAddr).AmountOf("cet")
+	res = handle(ctx2, bankx.NewMsgSend(fromAddr, toAddr, dex.NewCetCoins(2e8), 0))
+	require.True(t, res.IsOK())
+	require.Equal(t, sdk.NewInt(3e8), bkx.GetCoins(ctx2, fromAddr).AmountOf("cet"))
+	res = handle(ctx2, bankx.NewMsgSetTransferRestrictions(toAddr, []sdk.AccAddress{myaddr}, nil, nil, 0))
+	require.True(t, res.IsOK())
+	bankx.EndBlocker(ctx2.WithBlockTime(time.Unix(day2+200, 0)), *bkx)
+	require.Equal(t, sdk.NewInt(5e8), bkx.GetCoins(ctx2, fromAddr).AmountOf("cet"))
+	require.Equal(t, received, bkx.GetCoins(ctx2, toAddr).AmountOf("cet"))
+	require.Equal(t, 0, len(bkx.GetDelayedTransfers(ctx2, nil)))
+}
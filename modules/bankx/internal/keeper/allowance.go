@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+)
+
+func (k Keeper) GetAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, denom string) (allowance types.Allowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAllowanceKey(owner, spender, denom))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &allowance)
+	return allowance, true
+}
+
+// GetAllowances returns all the allowances, or only those granted or received by addr if it is not empty
+func (k Keeper) GetAllowances(ctx sdk.Context, addr sdk.AccAddress) []types.Allowance {
+	allowances := make([]types.Allowance, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AllowanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.Allowance
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &allowance)
+		if addr.Empty() || bytes.Equal(allowance.Owner, addr) || bytes.Equal(allowance.Spender, addr) {
+			allowances = append(allowances, allowance)
+		}
+	}
+	return allowances
+}
+
+// SetAllowance replaces the allowance of the spender on the denom, a zero amount removes it
+func (k Keeper) SetAllowance(ctx sdk.Context, allowance types.Allowance) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAllowanceKey(allowance.Owner, allowance.Spender, allowance.Amount.Denom)
+	if allowance.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(allowance))
+}
+
+// SpendAllowances deducts amt from the allowances of the spender on the owner's coins,
+// nothing is deducted unless every denom in amt is covered by an unexpired allowance.
+func (k Keeper) SpendAllowances(ctx sdk.Context, owner, spender sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	now := ctx.BlockHeader().Time.Unix()
+	allowances := make([]types.Allowance, 0, len(amt))
+	for _, coin := range amt {
+		allowance, found := k.GetAllowance(ctx, owner, spender, coin.Denom)
+		if !found || allowance.IsExpired(now) {
+			return types.ErrInsufficientAllowance(sdk.NewCoin(coin.Denom, sdk.ZeroInt()), coin)
+		}
+		if allowance.Amount.IsLT(coin) {
+			return types.ErrInsufficientAllowance(allowance.Amount, coin)
+		}
+		allowance.Amount = allowance.Amount.Sub(coin)
+		allowances = append(allowances, allowance)
+	}
+	for _, allowance := range allowances {
+		k.SetAllowance(ctx, allowance)
+	}
+	return nil
+}
+
+func (k Keeper) ImportGenesisAllowance(ctx sdk.Context, allowance types.Allowance) {
+	k.SetAllowance(ctx, allowance)
+}
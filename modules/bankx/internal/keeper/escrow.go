@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func (k Keeper) GetEscrow(ctx sdk.Context, id uint64) (escrow types.Escrow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEscrowKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &escrow)
+	return escrow, true
+}
+
+// GetEscrows returns all the escrows, or only those in which addr takes part if it is not empty
+func (k Keeper) GetEscrows(ctx sdk.Context, addr sdk.AccAddress) []types.Escrow {
+	escrows := make([]types.Escrow, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EscrowKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var escrow types.Escrow
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &escrow)
+		if addr.Empty() || escrow.IsParty(addr) {
+			escrows = append(escrows, escrow)
+		}
+	}
+	return escrows
+}
+
+func (k Keeper) GetNextEscrowID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextEscrowID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	ctx.KVStore(k.storeKey).Set(types.EscrowIDKey, bz)
+}
+
+// CreateEscrow moves the amount and the arbiter fee from the buyer to the bankx module account
+func (k Keeper) CreateEscrow(ctx sdk.Context, escrow types.Escrow) (uint64, sdk.Error) {
+	deposit := escrow.Amount.Add(escrow.ArbiterFee)
+	if k.IsSendForbidden(ctx, deposit, escrow.Buyer) {
+		return 0, types.ErrTokenForbiddenByOwner()
+	}
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, escrow.Buyer, types.ModuleName, deposit); err != nil {
+		return 0, err
+	}
+
+	escrow.ID = k.GetNextEscrowID(ctx)
+	k.SetNextEscrowID(ctx, escrow.ID+1)
+	k.setEscrow(ctx, escrow)
+	return escrow.ID, nil
+}
+
+// ResolveEscrow settles the escrow with the decision of sender, the buyer can only release it,
+// the seller can only refund it and the arbiter can make any decision.
+func (k Keeper) ResolveEscrow(ctx sdk.Context, sender sdk.AccAddress, id uint64,
+	decision byte, sellerAmount sdk.Coins) (types.EscrowResult, sdk.Error) {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return types.EscrowResult{}, types.ErrEscrowNotFound(id)
+	}
+
+	byArbiter := escrow.Arbiter.Equals(sender)
+	switch decision {
+	case types.EscrowRelease:
+		if !byArbiter && !escrow.Buyer.Equals(sender) {
+			return types.EscrowResult{}, sdk.ErrUnauthorized("only the buyer or the arbiter can release the escrow")
+		}
+		sellerAmount = escrow.Amount
+	case types.EscrowRefund:
+		if !byArbiter && !escrow.Seller.Equals(sender) {
+			return types.EscrowResult{}, sdk.ErrUnauthorized("only the seller or the arbiter can refund the escrow")
+		}
+		sellerAmount = sdk.Coins{}
+	case types.EscrowSplit:
+		if !byArbiter {
+			return types.EscrowResult{}, sdk.ErrUnauthorized("only the arbiter can split the escrow")
+		}
+		if !escrow.Amount.IsAllGTE(sellerAmount) {
+			return types.EscrowResult{}, types.ErrInvalidEscrow(fmt.Sprintf("seller amount %s exceeds escrow amount %s",
+				sellerAmount, escrow.Amount))
+		}
+	default:
+		return types.EscrowResult{}, types.ErrInvalidEscrow("invalid decision")
+	}
+
+	return k.settleEscrow(ctx, escrow, decision, sender, sellerAmount, byArbiter)
+}
+
+// SettleExpiredEscrows settles the escrows whose deadline is not later than time with their default outcome
+func (k Keeper) SettleExpiredEscrows(ctx sdk.Context, time int64) []types.EscrowResult {
+	store := ctx.KVStore(k.storeKey)
+	keys := dex.DueQueueKeys(store, types.EscrowQueueKey, sdk.PrefixEndBytes(types.GetEscrowQueueTimeKey(time)), 0)
+	results := make([]types.EscrowResult, 0, len(keys))
+	for _, key := range keys {
+		id := binary.BigEndian.Uint64(key[len(types.EscrowQueueKey)+8:])
+		escrow, found := k.GetEscrow(ctx, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		sellerAmount := sdk.Coins{}
+		if escrow.DefaultOutcome == types.EscrowRelease {
+			sellerAmount = escrow.Amount
+		}
+		// the escrow stays in the queue and is retried in the next block if any payment fails
+		cacheCtx, write := ctx.CacheContext()
+		result, err := k.settleEscrow(cacheCtx, escrow, escrow.DefaultOutcome, nil, sellerAmount, false)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to settle escrow %d: %s", id, err.Error()))
+			continue
+		}
+		write()
+		results = append(results, result)
+	}
+	return results
+}
+
+func (k Keeper) ImportGenesisEscrow(ctx sdk.Context, escrow types.Escrow) {
+	k.setEscrow(ctx, escrow)
+}
+
+// settleEscrow pays sellerAmount to the seller and the rest to the buyer, the arbiter fee
+// goes to the arbiter when it makes the decision and back to the buyer otherwise.
+func (k Keeper) settleEscrow(ctx sdk.Context, escrow types.Escrow, decision byte, decider sdk.AccAddress,
+	sellerAmount sdk.Coins, byArbiter bool) (types.EscrowResult, sdk.Error) {
+	buyerAmount := escrow.Amount.Sub(sellerAmount)
+	arbiterFee := sdk.Coins{}
+	if byArbiter {
+		arbiterFee = escrow.ArbiterFee
+	} else {
+		buyerAmount = buyerAmount.Add(escrow.ArbiterFee)
+	}
+
+	payments := []struct {
+		addr sdk.AccAddress
+		amt  sdk.Coins
+	}{
+		{escrow.Seller, sellerAmount},
+		{escrow.Buyer, buyerAmount},
+		{escrow.Arbiter, arbiterFee},
+	}
+	for _, p := range payments {
+		if p.amt.IsZero() {
+			continue
+		}
+		if err := k.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, p.addr, p.amt); err != nil {
+			return types.EscrowResult{}, err
+		}
+	}
+
+	k.removeEscrow(ctx, escrow)
+	return types.EscrowResult{
+		ID:           escrow.ID,
+		Decision:     decision,
+		Decider:      decider,
+		Buyer:        escrow.Buyer,
+		Seller:       escrow.Seller,
+		Arbiter:      escrow.Arbiter,
+		BuyerAmount:  buyerAmount,
+		SellerAmount: sellerAmount,
+		ArbiterFee:   arbiterFee,
+		Height:       ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Keeper) setEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEscrowKey(escrow.ID), k.cdc.MustMarshalBinaryBare(escrow))
+	store.Set(types.GetEscrowQueueKey(escrow.Deadline, escrow.ID), []byte{})
+}
+
+func (k Keeper) removeEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEscrowKey(escrow.ID))
+	store.Delete(types.GetEscrowQueueKey(escrow.Deadline, escrow.ID))
+}
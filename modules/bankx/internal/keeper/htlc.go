@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func (k Keeper) GetHTLC(ctx sdk.Context, hashLock []byte) (htlc types.HTLC, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHTLCKey(hashLock))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &htlc)
+	return htlc, true
+}
+
+// GetHTLCs returns all the HTLCs, or only those sent or received by addr if it is not empty
+func (k Keeper) GetHTLCs(ctx sdk.Context, addr sdk.AccAddress) []types.HTLC {
+	htlcs := make([]types.HTLC, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HTLCKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var htlc types.HTLC
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &htlc)
+		if addr.Empty() || bytes.Equal(htlc.Sender, addr) || bytes.Equal(htlc.Recipient, addr) {
+			htlcs = append(htlcs, htlc)
+		}
+	}
+	return htlcs
+}
+
+// CreateHTLC moves the amount from the sender to the bankx module account,
+// a hash lock can only be used by one HTLC at a time.
+func (k Keeper) CreateHTLC(ctx sdk.Context, htlc types.HTLC) sdk.Error {
+	if _, found := k.GetHTLC(ctx, htlc.HashLock); found {
+		return types.ErrInvalidHTLC(fmt.Sprintf("htlc %s already exists", htlc.HashLock))
+	}
+	if k.IsSendForbidden(ctx, htlc.Amount, htlc.Sender) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, htlc.Sender, types.ModuleName, htlc.Amount); err != nil {
+		return err
+	}
+	k.setHTLC(ctx, htlc)
+	return nil
+}
+
+// ClaimHTLC pays the HTLC to its recipient if the secret opens its hash lock before it expires
+func (k Keeper) ClaimHTLC(ctx sdk.Context, hashLock, secret []byte) (types.HTLCSettlement, sdk.Error) {
+	htlc, found := k.GetHTLC(ctx, hashLock)
+	if !found {
+		return types.HTLCSettlement{}, types.ErrHTLCNotFound(hashLock)
+	}
+	if htlc.ExpireTime <= ctx.BlockHeader().Time.Unix() {
+		return types.HTLCSettlement{}, types.ErrInvalidHTLC(fmt.Sprintf("htlc %s has expired", htlc.HashLock))
+	}
+	if !bytes.Equal(types.HashSecret(secret), hashLock) {
+		return types.HTLCSettlement{}, types.ErrInvalidHTLC("secret does not match the hash lock")
+	}
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, htlc.Recipient, htlc.Amount); err != nil {
+		return types.HTLCSettlement{}, err
+	}
+	k.removeHTLC(ctx, htlc)
+	return k.newHTLCSettlement(ctx, htlc, secret), nil
+}
+
+// RefundExpiredHTLCs refunds the HTLCs which expire not later than time to their senders
+func (k Keeper) RefundExpiredHTLCs(ctx sdk.Context, time int64) []types.HTLCSettlement {
+	store := ctx.KVStore(k.storeKey)
+	keys := dex.DueQueueKeys(store, types.HTLCQueueKey, sdk.PrefixEndBytes(types.GetHTLCQueueTimeKey(time)), 0)
+	settlements := make([]types.HTLCSettlement, 0, len(keys))
+	for _, key := range keys {
+		htlc, found := k.GetHTLC(ctx, key[len(types.HTLCQueueKey)+8:])
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		// the htlc stays in the queue and is retried in the next block if the refund fails
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.sk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, htlc.Sender, htlc.Amount); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to refund htlc %s: %s", htlc.HashLock, err.Error()))
+			continue
+		}
+		k.removeHTLC(cacheCtx, htlc)
+		write()
+		settlements = append(settlements, k.newHTLCSettlement(ctx, htlc, nil))
+	}
+	return settlements
+}
+
+func (k Keeper) ImportGenesisHTLC(ctx sdk.Context, htlc types.HTLC) {
+	k.setHTLC(ctx, htlc)
+}
+
+func (k Keeper) newHTLCSettlement(ctx sdk.Context, htlc types.HTLC, secret []byte) types.HTLCSettlement {
+	return types.HTLCSettlement{
+		HashLock:  htlc.HashLock,
+		Secret:    secret,
+		Sender:    htlc.Sender,
+		Recipient: htlc.Recipient,
+		Amount:    htlc.Amount,
+		Refunded:  secret == nil,
+		Height:    ctx.BlockHeight(),
+	}
+}
+
+func (k Keeper) setHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHTLCKey(htlc.HashLock), k.cdc.MustMarshalBinaryBare(htlc))
+	store.Set(types.GetHTLCQueueKey(htlc.ExpireTime, htlc.HashLock), []byte{})
+}
+
+func (k Keeper) removeHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHTLCKey(htlc.HashLock))
+	store.Delete(types.GetHTLCQueueKey(htlc.ExpireTime, htlc.HashLock))
+}
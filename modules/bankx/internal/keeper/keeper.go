@@ -5,6 +5,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -18,24 +19,30 @@ import (
 )
 
 type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
 	paramSubspace params.Subspace
 	axk           types.ExpectedAccountXKeeper
 	bk            bank.Keeper
 	ak            auth.AccountKeeper
+	accountKey    sdk.StoreKey
 	tk            types.ExpectedAssetStatusKeeper
 	sk            types.SupplyKeeper
 	MsgProducer   msgqueue.MsgSender
 }
 
-func NewKeeper(paramSubspace params.Subspace, axk authx.AccountXKeeper,
-	bk bank.BaseKeeper, ak auth.AccountKeeper,
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSubspace params.Subspace, axk authx.AccountXKeeper,
+	bk bank.BaseKeeper, ak auth.AccountKeeper, accountKey sdk.StoreKey,
 	tk types.ExpectedAssetStatusKeeper, sk types.SupplyKeeper, msgProducer msgqueue.MsgSender) Keeper {
 
 	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
 		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
 		axk:           axk,
 		bk:            bk,
 		ak:            ak,
+		accountKey:    accountKey,
 		tk:            tk,
 		sk:            sk,
 		MsgProducer:   msgProducer,
@@ -59,14 +66,28 @@ func (k Keeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bo
 	return acc.SpendableCoins(ctx.BlockTime()).IsAllGTE(amt)
 }
 
+// SendCoins applies the transfer restrictions of from and to before sending amt,
+// and rejects the transfer if it needs a transfer delay, which only MsgSend can make
 func (k Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	if k.IsSendForbidden(ctx, amt, from) {
 		return types.ErrTokenForbiddenByOwner()
 	}
+	if err := k.ApplyUndelayedTransferRestrictions(ctx, from, to, amt); err != nil {
+		return err
+	}
 	ret := k.bk.SendCoins(ctx, from, to, amt)
 	return ret
 }
 
+// SendCoinsUnrestricted sends amt without applying the transfer restrictions, it is used to settle
+// the trades and pay the rebates of market and bancorlite, which the counterparties have agreed to
+func (k Keeper) SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if k.IsSendForbidden(ctx, amt, from) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	return k.bk.SendCoins(ctx, from, to, amt)
+}
+
 func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sdk.AccAddress, amt sdk.Coins,
 	unlockTime int64, reward int64, isSupervised bool) sdk.Error {
 	if k.IsSendForbidden(ctx, amt, fromAddr) {
@@ -78,16 +99,8 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 		}
 	}
 
-	lockDuration := (unlockTime - ctx.BlockHeader().Time.Unix()) * int64(time.Second)
-	if lockDuration > k.GetParams(ctx).LockCoinsFreeTime && k.GetParams(ctx).LockCoinsFeePerDay > 0 {
-		exceededDays := (lockDuration-k.GetParams(ctx).LockCoinsFreeTime-1)/(24*int64(time.Hour)) + 1
-		if exceededDays > math.MaxInt64/k.GetParams(ctx).LockCoinsFeePerDay {
-			return types.ErrUnlockTime("Unlock time is too large")
-		}
-		lockCoinsFee := dex.NewCetCoins(k.GetParams(ctx).LockCoinsFeePerDay * exceededDays)
-		if err := k.DeductFee(ctx, fromAddr, lockCoinsFee); err != nil {
-			return err
-		}
+	if err := k.deductLockCoinsFee(ctx, fromAddr, unlockTime); err != nil {
+		return err
 	}
 
 	if err := k.SubtractCoins(ctx, fromAddr, amt); err != nil {
@@ -114,6 +127,59 @@ func (k Keeper) SendLockedCoins(ctx sdk.Context, fromAddr, toAddr, supervisor sd
 	return nil
 }
 
+// SendVestingCoins locks amt for toAddr, which is released in equal slices every period
+// seconds from startTime on, no slice is released before cliffTime.
+func (k Keeper) SendVestingCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+	startTime, cliffTime, period, periods int64) sdk.Error {
+	if k.IsSendForbidden(ctx, amt, fromAddr) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if k.ak.GetAccount(ctx, toAddr) == nil {
+		if err := k.AddCoins(ctx, toAddr, sdk.Coins{}); err != nil {
+			return err
+		}
+	}
+
+	if err := k.deductLockCoinsFee(ctx, fromAddr, startTime+period*periods); err != nil {
+		return err
+	}
+
+	if err := k.SubtractCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	ax := k.axk.GetOrCreateAccountX(ctx, toAddr)
+	var vestingCoin authx.LockedCoin
+	for _, coin := range amt {
+		vestingCoin = authx.NewVestingLockedCoin(coin.Denom, coin.Amount, startTime, cliffTime, period, periods)
+		ax.LockedCoins = append(ax.LockedCoins, vestingCoin)
+		if err := k.tk.UpdateTokenSendLock(ctx, coin.Denom, coin.Amount, true); err != nil {
+			return err
+		}
+	}
+	k.axk.SetAccountX(ctx, ax)
+
+	if !amt.Empty() {
+		k.axk.InsertUnlockedCoinsQueue(ctx, vestingCoin.NextVestingTime(), toAddr)
+	}
+	return nil
+}
+
+func (k Keeper) deductLockCoinsFee(ctx sdk.Context, fromAddr sdk.AccAddress, unlockTime int64) sdk.Error {
+	lockDuration := (unlockTime - ctx.BlockHeader().Time.Unix()) * int64(time.Second)
+	if lockDuration > k.GetParams(ctx).LockCoinsFreeTime && k.GetParams(ctx).LockCoinsFeePerDay > 0 {
+		exceededDays := (lockDuration-k.GetParams(ctx).LockCoinsFreeTime-1)/(24*int64(time.Hour)) + 1
+		if exceededDays > math.MaxInt64/k.GetParams(ctx).LockCoinsFeePerDay {
+			return types.ErrUnlockTime("Unlock time is too large")
+		}
+		lockCoinsFee := dex.NewCetCoins(k.GetParams(ctx).LockCoinsFeePerDay * exceededDays)
+		if err := k.DeductFee(ctx, fromAddr, lockCoinsFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) EarlierUnlockCoin(ctx sdk.Context, fromAddr, toAddr, supervisor sdk.AccAddress, amt *sdk.Coin,
 	unlockTime int64, reward int64, isReturned bool) (*authx.NotificationUnlock, sdk.Error) {
 	ax, ok := k.axk.GetAccountX(ctx, toAddr)
@@ -279,6 +345,18 @@ func (k Keeper) DeductActivationFeeForFreshAccounts(ctx sdk.Context, addrs []sdk
 	return nil
 }
 
+// PayActivationFeeForFreshAccounts - payer pays the activation fees of the fresh accounts, returns the total fee
+func (k Keeper) PayActivationFeeForFreshAccounts(ctx sdk.Context, payer sdk.AccAddress, addrs []sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	fee := dex.NewCetCoins(k.GetParams(ctx).ActivationFee * int64(len(addrs)))
+	if len(addrs) == 0 {
+		return fee, nil
+	}
+	if !k.HasCoins(ctx, payer, fee) {
+		return fee, types.ErrInsufficientCETForActivatingFee()
+	}
+	return fee, k.DeductFee(ctx, payer, fee)
+}
+
 func (k Keeper) DeductFee(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	return k.sk.SendCoinsFromAccountToModule(ctx, addr, auth.FeeCollectorName, amt)
 }
@@ -349,7 +427,11 @@ func (k Keeper) GetSendEnabled(ctx sdk.Context) bool {
 	return k.bk.GetSendEnabled(ctx)
 }
 
+// InputOutputCoins applies the transfer restrictions of a multi-send before making it
 func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	if err := k.ApplyMultiSendRestrictions(ctx, inputs, outputs); err != nil {
+		return err
+	}
 	return k.bk.InputOutputCoins(ctx, inputs, outputs)
 }
 
@@ -372,6 +454,71 @@ func (k Keeper) GetMemoRequired(ctx sdk.Context, addr sdk.AccAddress) bool {
 	return false
 }
 
+var _ authx.AnteHelper = Keeper{}
+
+// CheckMsg implements authx.AnteHelper, the ante handler is the only place where the memo is known.
+// It rejects the transfers of bankx without a memo to the recipients requiring one, and the
+// transfers against the restrictions of the sender or the recipient.
+func (k Keeper) CheckMsg(ctx sdk.Context, msg sdk.Msg, memo string) sdk.Error {
+	if err := k.checkMsgMemo(ctx, msg, memo); err != nil {
+		return err
+	}
+	return k.checkMsgTransferRestrictions(ctx, msg)
+}
+
+// checkMsgMemo returns ErrMemoMissing if the memo is empty while the recipient of msg requires one
+func (k Keeper) checkMsgMemo(ctx sdk.Context, msg sdk.Msg, memo string) sdk.Error {
+	if len(memo) != 0 {
+		return nil
+	}
+	var recipient sdk.AccAddress
+	switch msg := msg.(type) {
+	case types.MsgSend:
+		recipient = msg.ToAddress
+	case types.MsgVestingSend:
+		recipient = msg.ToAddress
+	case types.MsgTransferFrom:
+		recipient = msg.ToAddress
+	default:
+		return nil
+	}
+	if k.GetMemoRequired(ctx, recipient) {
+		return types.ErrMemoMissing()
+	}
+	return nil
+}
+
+// IterateTokenHolders - iterate the accounts holding denom in the order of address, with the liquid,
+// frozen and locked amounts of each holder. If after is not empty, the iteration seeks to the first
+// account after it in the account store, so that a page of holders does not scan the accounts before it.
+func (k Keeper) IterateTokenHolders(ctx sdk.Context, denom string, after sdk.AccAddress,
+	process func(addr sdk.AccAddress, liquid, frozen, locked sdk.Int) (stop bool)) {
+
+	start := auth.AddressStoreKeyPrefix
+	if !after.Empty() {
+		start = append(auth.AddressStoreKey(after), 0)
+	}
+	iter := ctx.KVStore(k.accountKey).Iterator(start, sdk.PrefixEndBytes(auth.AddressStoreKeyPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Key()[len(auth.AddressStoreKeyPrefix):])
+		acc := k.ak.GetAccount(ctx, addr)
+		liquid, frozen, locked := acc.GetCoins().AmountOf(denom), sdk.ZeroInt(), sdk.ZeroInt()
+		if accx, found := k.axk.GetAccountX(ctx, addr); found {
+			frozen = accx.FrozenCoins.AmountOf(denom)
+			for _, lockedCoin := range accx.GetLockedCoinsByDemon(denom) {
+				locked = locked.Add(lockedCoin.Coin.Amount)
+			}
+		}
+		if liquid.IsZero() && frozen.IsZero() && locked.IsZero() {
+			continue
+		}
+		if process(addr, liquid, frozen, locked) {
+			return
+		}
+	}
+}
+
 // only used by unit tests
 func (k Keeper) TotalAmountOfCoin(ctx sdk.Context, denom string) sdk.Int {
 	var (
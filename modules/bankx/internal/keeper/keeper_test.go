@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/coinexchain/cet-sdk/modules/asset"
@@ -31,6 +32,7 @@ func defaultContext() (keeper.Keeper, sdk.Context) {
 	app.AccountKeeper.SetAccount(ctx, supply.NewEmptyModuleAccount(asset.ModuleName, supply.Minter))
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.Coin{Denom: "abc", Amount: sdk.NewInt(10e10)}}))
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(10e10)}}))
+	app.AssetKeeper.SetParams(ctx, asset.DefaultParams())
 
 	_ = app.AssetKeeper.IssueToken(ctx, "abc", "abc", sdk.NewInt(100000000000), ownerAddr,
 		false, false, false, false,
@@ -229,3 +231,24 @@ func TestKeeper_SendCoins(t *testing.T) {
 	cs := bkx.GetTotalCoins(ctx, addr2)
 	require.Equal(t, coins, cs)
 }
+
+func TestKeeper_SendCoinsTransferRestrictions(t *testing.T) {
+	bkx, ctx := defaultContext()
+	coins := sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(10)))
+	addr2 := testutil.ToAccAddress("addr2")
+	_ = bkx.AddCoins(ctx, myaddr, coins.Add(coins))
+	_ = bkx.AddCoins(ctx, addr2, sdk.Coins{})
+	_, err := bkx.SetTransferRestrictions(ctx, addr2, authx.NewTransferRestrictions([]sdk.AccAddress{ownerAddr}, nil, nil, 0))
+	require.Nil(t, err)
+
+	err = bkx.SendCoins(ctx, myaddr, addr2, coins)
+	require.Equal(t, types.CodeTransferNotWhitelisted, err.Code())
+	err = bkx.InputOutputCoins(ctx, []bank.Input{bank.NewInput(myaddr, coins)}, []bank.Output{bank.NewOutput(addr2, coins)})
+	require.Equal(t, types.CodeTransferNotWhitelisted, err.Code())
+	require.True(t, bkx.GetTotalCoins(ctx, addr2).Empty())
+
+	// the settlement of trades and rebates is not restricted
+	err = bkx.SendCoinsUnrestricted(ctx, myaddr, addr2, coins)
+	require.Nil(t, err)
+	require.Equal(t, coins, bkx.GetTotalCoins(ctx, addr2))
+}
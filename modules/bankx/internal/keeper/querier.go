@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,14 @@ import (
 const (
 	QueryParameters = "parameters"
 	QueryBalances   = "balances"
+	QueryEscrows    = "escrows"
+	QueryEscrow     = "escrow"
+	QueryHTLCs      = "htlcs"
+	QueryHTLC       = "htlc"
+
+	QueryRecurringPayments = "recurring-payments"
+	QueryAllowances        = "allowances"
+	QueryDelayedTransfers  = "delayed-transfers"
 )
 
 // creates a querier for asset REST endpoints
@@ -25,6 +34,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryParameters(ctx, keeper)
 		case QueryBalances:
 			return queryBalances(ctx, keeper, req)
+		case QueryEscrows:
+			return queryEscrows(ctx, keeper, req)
+		case QueryEscrow:
+			return queryEscrow(ctx, keeper, req)
+		case QueryHTLCs:
+			return queryHTLCs(ctx, keeper, req)
+		case QueryHTLC:
+			return queryHTLC(ctx, keeper, req)
+		case QueryRecurringPayments:
+			return queryRecurringPayments(ctx, keeper, req)
+		case QueryAllowances:
+			return queryAllowances(ctx, keeper, req)
+		case QueryDelayedTransfers:
+			return queryDelayedTransfers(ctx, keeper, req)
 		default:
 			return nil, sdk.ErrUnknownRequest("query symbol : " + path[0])
 		}
@@ -82,3 +105,132 @@ func NewQueryAddrBalances(addr sdk.AccAddress) QueryAddrBalances {
 		Addr: addr,
 	}
 }
+
+func queryEscrows(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrBalances
+	if len(req.Data) != 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetEscrows(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryEscrow(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryEscrowParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	escrow, found := k.GetEscrow(ctx, params.ID)
+	if !found {
+		return nil, types.ErrEscrowNotFound(params.ID)
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, escrow)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+type QueryEscrowParam struct {
+	ID uint64 `json:"id"`
+}
+
+func NewQueryEscrowParam(id uint64) QueryEscrowParam {
+	return QueryEscrowParam{
+		ID: id,
+	}
+}
+
+func queryHTLCs(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrBalances
+	if len(req.Data) != 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetHTLCs(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryHTLC(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryHTLCParam
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	htlc, found := k.GetHTLC(ctx, params.HashLock)
+	if !found {
+		return nil, types.ErrHTLCNotFound(params.HashLock)
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, htlc)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryRecurringPayments(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrBalances
+	if len(req.Data) != 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetRecurringPayments(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryAllowances(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrBalances
+	if len(req.Data) != 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetAllowances(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+type QueryHTLCParam struct {
+	HashLock cmn.HexBytes `json:"hash_lock"`
+}
+
+func NewQueryHTLCParam(hashLock []byte) QueryHTLCParam {
+	return QueryHTLCParam{
+		HashLock: hashLock,
+	}
+}
+
+func queryDelayedTransfers(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, sdk.Error) {
+	var params QueryAddrBalances
+	if len(req.Data) != 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetDelayedTransfers(ctx, params.Addr))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+func (k Keeper) GetRecurringPayment(ctx sdk.Context, id uint64) (payment types.RecurringPayment, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRecurringPaymentKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &payment)
+	return payment, true
+}
+
+// GetRecurringPayments returns all the recurring payments, or only those paid or received by addr if it is not empty
+func (k Keeper) GetRecurringPayments(ctx sdk.Context, addr sdk.AccAddress) []types.RecurringPayment {
+	payments := make([]types.RecurringPayment, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RecurringPaymentKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var payment types.RecurringPayment
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &payment)
+		if addr.Empty() || payment.Payer.Equals(addr) || payment.Recipient.Equals(addr) {
+			payments = append(payments, payment)
+		}
+	}
+	return payments
+}
+
+func (k Keeper) GetNextRecurringPaymentID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RecurringPaymentIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextRecurringPaymentID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	ctx.KVStore(k.storeKey).Set(types.RecurringPaymentIDKey, bz)
+}
+
+// CreateRecurringPayment stores the payment, no coins are moved until it is due
+func (k Keeper) CreateRecurringPayment(ctx sdk.Context, payment types.RecurringPayment) (uint64, sdk.Error) {
+	if k.countRecurringPayments(ctx, payment.Payer) >= types.MaxRecurringPaymentsPerPayer {
+		return 0, types.ErrInvalidRecurringPayment(
+			fmt.Sprintf("a payer can have at most %d recurring payments", types.MaxRecurringPaymentsPerPayer))
+	}
+	payment.ID = k.GetNextRecurringPaymentID(ctx)
+	k.SetNextRecurringPaymentID(ctx, payment.ID+1)
+	k.setRecurringPayment(ctx, payment)
+	return payment.ID, nil
+}
+
+func (k Keeper) CancelRecurringPayment(ctx sdk.Context, payer sdk.AccAddress, id uint64) sdk.Error {
+	payment, found := k.GetRecurringPayment(ctx, id)
+	if !found {
+		return types.ErrRecurringPaymentNotFound(id)
+	}
+	if !payment.Payer.Equals(payer) {
+		return sdk.ErrUnauthorized("only the payer can cancel the recurring payment")
+	}
+	k.removeRecurringPayment(ctx, payment)
+	return nil
+}
+
+// ExecuteRecurringPayments makes the payments due not later than time, a payment which the payer can not
+// afford is skipped. Each payment is made at most once per call and is queued again for its next time.
+// At most MaxRecurringPaymentsPerBlock payments are made per call, the others stay queued for the next call.
+func (k Keeper) ExecuteRecurringPayments(ctx sdk.Context, time int64) []types.RecurringPaymentExecution {
+	store := ctx.KVStore(k.storeKey)
+	keys := dex.DueQueueKeys(store, types.RecurringPaymentQueueKey, sdk.PrefixEndBytes(types.GetRecurringPaymentQueueTimeKey(time)), types.MaxRecurringPaymentsPerBlock)
+	executions := make([]types.RecurringPaymentExecution, 0, len(keys))
+	for _, key := range keys {
+		store.Delete(key)
+		id := binary.BigEndian.Uint64(key[len(types.RecurringPaymentQueueKey)+8:])
+		payment, found := k.GetRecurringPayment(ctx, id)
+		if !found {
+			continue
+		}
+
+		execution := types.RecurringPaymentExecution{
+			ID:        payment.ID,
+			Payer:     payment.Payer,
+			Recipient: payment.Recipient,
+			Amount:    payment.Amount,
+			Height:    ctx.BlockHeight(),
+		}
+		execution.Reason = k.payRecurringPayment(ctx, payment)
+		execution.Executed = execution.Reason == ""
+
+		execution.Finished = payment.Advance(execution.Executed)
+		if execution.Finished {
+			k.removeRecurringPayment(ctx, payment)
+		} else {
+			k.setRecurringPayment(ctx, payment)
+		}
+		executions = append(executions, execution)
+	}
+	return executions
+}
+
+func (k Keeper) ImportGenesisRecurringPayment(ctx sdk.Context, payment types.RecurringPayment) {
+	k.setRecurringPayment(ctx, payment)
+}
+
+// payRecurringPayment returns the reason why the payment is skipped, or an empty string if it is made.
+// Like MsgSend, the activation fee of a fresh recipient is deducted from the payment, and the transfer
+// restrictions apply, except that a payment which needs a transfer delay is skipped instead.
+func (k Keeper) payRecurringPayment(ctx sdk.Context, payment types.RecurringPayment) string {
+	if !k.GetSendEnabled(ctx) {
+		return "send is disabled"
+	}
+	if !k.HasCoins(ctx, payment.Payer, payment.Amount) {
+		return "insufficient coins"
+	}
+	if k.IsSendForbidden(ctx, payment.Amount, payment.Payer) {
+		return "forbidden by token owner"
+	}
+	if k.NeedsTransferDelay(ctx, payment.Payer, payment.Amount) ||
+		k.CheckTransferRestrictions(ctx, payment.Payer, payment.Recipient, payment.Amount) != nil {
+		return "rejected by transfer restrictions"
+	}
+	// nothing is paid if any step fails, SendCoins counts the payment against the daily send limit
+	cacheCtx, write := ctx.CacheContext()
+	amt, err := k.DeductActivationFee(cacheCtx, payment.Payer, payment.Recipient, payment.Amount)
+	if err != nil {
+		return "insufficient coins for the activation fee"
+	}
+	if err := k.SendCoins(cacheCtx, payment.Payer, payment.Recipient, amt); err != nil {
+		return "send failed"
+	}
+	write()
+	return ""
+}
+
+func (k Keeper) countRecurringPayments(ctx sdk.Context, payer sdk.AccAddress) int {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetRecurringPaymentPayerPrefix(payer))
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) setRecurringPayment(ctx sdk.Context, payment types.RecurringPayment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRecurringPaymentKey(payment.ID), k.cdc.MustMarshalBinaryBare(payment))
+	store.Set(types.GetRecurringPaymentQueueKey(payment.NextTime, payment.ID), []byte{})
+	store.Set(types.GetRecurringPaymentPayerKey(payment.Payer, payment.ID), []byte{})
+}
+
+func (k Keeper) removeRecurringPayment(ctx sdk.Context, payment types.RecurringPayment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRecurringPaymentKey(payment.ID))
+	store.Delete(types.GetRecurringPaymentQueueKey(payment.NextTime, payment.ID))
+	store.Delete(types.GetRecurringPaymentPayerKey(payment.Payer, payment.ID))
+}
@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+	"github.com/coinexchain/cet-sdk/modules/bankx/internal/types"
+	dex "github.com/coinexchain/cet-sdk/types"
+)
+
+// SetTransferRestrictions replaces the transfer restrictions of addr and returns when they take effect.
+// Looser restrictions are pending until the current transfer delay has passed, so that whoever steals
+// the key of the account can not lift them at once, while tighter ones take effect immediately.
+// Either replaces the restrictions which are still pending.
+func (k Keeper) SetTransferRestrictions(ctx sdk.Context, addr sdk.AccAddress, restrictions authx.TransferRestrictions) (int64, sdk.Error) {
+	account := k.ak.GetAccount(ctx, addr)
+	if account == nil {
+		return 0, sdk.ErrUnknownAddress(fmt.Sprintf("account %s does not exist", addr))
+	}
+	if pending, found := k.GetPendingTransferRestrictions(ctx, addr); found {
+		k.removePendingTransferRestrictions(ctx, pending)
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	current := k.GetTransferRestrictions(ctx, addr)
+	if current.TransferDelay != 0 && current.IsLoosenedBy(restrictions) {
+		pending := types.NewPendingTransferRestrictions(addr, restrictions, now+current.TransferDelay)
+		k.setPendingTransferRestrictions(ctx, pending)
+		return pending.EffectiveTime, nil
+	}
+
+	k.applyTransferRestrictions(ctx, addr, restrictions)
+	return now, nil
+}
+
+func (k Keeper) applyTransferRestrictions(ctx sdk.Context, addr sdk.AccAddress, restrictions authx.TransferRestrictions) {
+	accountX := k.axk.GetOrCreateAccountX(ctx, addr)
+	accountX.TransferRestrictions = restrictions
+	k.axk.SetAccountX(ctx, accountX)
+}
+
+func (k Keeper) GetTransferRestrictions(ctx sdk.Context, addr sdk.AccAddress) authx.TransferRestrictions {
+	if accX, ok := k.axk.GetAccountX(ctx, addr); ok {
+		return accX.TransferRestrictions
+	}
+	return authx.TransferRestrictions{}
+}
+
+// CheckTransferRestrictions returns an error if to does not accept coins from from,
+// or if sending amt now exceeds the daily send limit of from
+func (k Keeper) CheckTransferRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if accX, ok := k.axk.GetAccountX(ctx, to); ok && !accX.CanReceiveFrom(from) {
+		return types.ErrTransferNotWhitelisted(from, to)
+	}
+	if accX, ok := k.axk.GetAccountX(ctx, from); ok && accX.ExceedsDailySendLimit(amt, ctx.BlockHeader().Time.Unix()) {
+		return types.ErrDailySendLimitExceeded(from)
+	}
+	return nil
+}
+
+// ApplyTransferRestrictions checks the transfer like CheckTransferRestrictions,
+// and counts amt against the daily send limit of from
+func (k Keeper) ApplyTransferRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := k.CheckTransferRestrictions(ctx, from, to, amt); err != nil {
+		return err
+	}
+	k.addDailySent(ctx, from, amt)
+	return nil
+}
+
+// ApplyMultiSendRestrictions applies the transfer restrictions to a multi-send, where every output
+// must accept coins from every input, and rejects it if any input needs a transfer delay
+func (k Keeper) ApplyMultiSendRestrictions(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) sdk.Error {
+	for _, in := range inputs {
+		if k.NeedsTransferDelay(ctx, in.Address, in.Coins) {
+			return types.ErrInvalidTransferRestrictions(
+				fmt.Sprintf("transfer from %s needs a delay and can not be a multi-send", in.Address))
+		}
+		for _, out := range outputs {
+			if err := k.CheckTransferRestrictions(ctx, in.Address, out.Address, in.Coins); err != nil {
+				return err
+			}
+		}
+		k.addDailySent(ctx, in.Address, in.Coins)
+	}
+	return nil
+}
+
+// ApplyUndelayedTransferRestrictions applies the transfer restrictions to a transfer which can not be
+// delayed, such as a locked, vesting or escrowed one, and rejects it if it needs a transfer delay
+func (k Keeper) ApplyUndelayedTransferRestrictions(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if k.NeedsTransferDelay(ctx, from, amt) {
+		return types.ErrInvalidTransferRestrictions(
+			fmt.Sprintf("transfer from %s needs a delay and can only be a plain send", from))
+	}
+	return k.ApplyTransferRestrictions(ctx, from, to, amt)
+}
+
+func (k Keeper) NeedsTransferDelay(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) bool {
+	if accX, ok := k.axk.GetAccountX(ctx, from); ok {
+		return accX.NeedsTransferDelay(amt)
+	}
+	return false
+}
+
+// checkMsgTransferRestrictions returns the error CheckTransferRestrictions would return for the transfers of msg,
+// so the ante handler rejects such transactions before they are included.
+func (k Keeper) checkMsgTransferRestrictions(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+	switch msg := msg.(type) {
+	case types.MsgSend:
+		return k.CheckTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	case types.MsgVestingSend:
+		return k.CheckTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	case types.MsgTransferFrom:
+		return k.CheckTransferRestrictions(ctx, msg.Owner, msg.ToAddress, msg.Amount)
+	case types.MsgSupervisedSend:
+		if msg.Operation != types.Create {
+			return nil
+		}
+		return k.CheckTransferRestrictions(ctx, msg.FromAddress, msg.ToAddress, sdk.NewCoins(msg.Amount))
+	case types.MsgMultiSend:
+		for _, in := range msg.Inputs {
+			for _, out := range msg.Outputs {
+				if err := k.CheckTransferRestrictions(ctx, in.Address, out.Address, in.Coins); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (k Keeper) addDailySent(ctx sdk.Context, from sdk.AccAddress, amt sdk.Coins) {
+	if accX, ok := k.axk.GetAccountX(ctx, from); ok && !accX.TransferRestrictions.DailySendLimit.Empty() {
+		accX.AddDailySent(amt, ctx.BlockHeader().Time.Unix())
+		k.axk.SetAccountX(ctx, accX)
+	}
+}
+
+func (k Keeper) GetPendingTransferRestrictions(ctx sdk.Context, addr sdk.AccAddress) (pending types.PendingTransferRestrictions, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingTransferRestrictionsKey(addr))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &pending)
+	return pending, true
+}
+
+// GetAllPendingTransferRestrictions returns the pending transfer restrictions of all the accounts
+func (k Keeper) GetAllPendingTransferRestrictions(ctx sdk.Context) []types.PendingTransferRestrictions {
+	pendings := make([]types.PendingTransferRestrictions, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingTransferRestrictionsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingTransferRestrictions
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &pending)
+		pendings = append(pendings, pending)
+	}
+	return pendings
+}
+
+// CancelPendingTransferRestrictions keeps the current transfer restrictions of addr
+func (k Keeper) CancelPendingTransferRestrictions(ctx sdk.Context, addr sdk.AccAddress) sdk.Error {
+	pending, found := k.GetPendingTransferRestrictions(ctx, addr)
+	if !found {
+		return types.ErrTransferRestrictionsNotPending(addr)
+	}
+	k.removePendingTransferRestrictions(ctx, pending)
+	return nil
+}
+
+// ApplyPendingTransferRestrictions makes the pending transfer restrictions due not later than time take effect
+func (k Keeper) ApplyPendingTransferRestrictions(ctx sdk.Context, time int64) []types.PendingTransferRestrictions {
+	store := ctx.KVStore(k.storeKey)
+	keys := dex.DueQueueKeys(store, types.PendingTransferRestrictionsQueueKey, sdk.PrefixEndBytes(types.GetPendingTransferRestrictionsQueueTimeKey(time)), 0)
+	applied := make([]types.PendingTransferRestrictions, 0, len(keys))
+	for _, key := range keys {
+		addr := sdk.AccAddress(key[len(types.PendingTransferRestrictionsQueueKey)+8:])
+		pending, found := k.GetPendingTransferRestrictions(ctx, addr)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		k.applyTransferRestrictions(ctx, addr, pending.Restrictions)
+		k.removePendingTransferRestrictions(ctx, pending)
+		applied = append(applied, pending)
+	}
+	return applied
+}
+
+func (k Keeper) ImportGenesisPendingTransferRestrictions(ctx sdk.Context, pending types.PendingTransferRestrictions) {
+	k.setPendingTransferRestrictions(ctx, pending)
+}
+
+func (k Keeper) setPendingTransferRestrictions(ctx sdk.Context, pending types.PendingTransferRestrictions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingTransferRestrictionsKey(pending.Address), k.cdc.MustMarshalBinaryBare(pending))
+	store.Set(types.GetPendingTransferRestrictionsQueueKey(pending.EffectiveTime, pending.Address), []byte{})
+}
+
+func (k Keeper) removePendingTransferRestrictions(ctx sdk.Context, pending types.PendingTransferRestrictions) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingTransferRestrictionsKey(pending.Address))
+	store.Delete(types.GetPendingTransferRestrictionsQueueKey(pending.EffectiveTime, pending.Address))
+}
+
+func (k Keeper) GetDelayedTransfer(ctx sdk.Context, id uint64) (transfer types.DelayedTransfer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelayedTransferKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return transfer, true
+}
+
+// GetDelayedTransfers returns all the delayed transfers, or only those sent or received by addr if it is not empty
+func (k Keeper) GetDelayedTransfers(ctx sdk.Context, addr sdk.AccAddress) []types.DelayedTransfer {
+	transfers := make([]types.DelayedTransfer, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelayedTransferKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.DelayedTransfer
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &transfer)
+		if addr.Empty() || transfer.FromAddress.Equals(addr) || transfer.ToAddress.Equals(addr) {
+			transfers = append(transfers, transfer)
+		}
+	}
+	return transfers
+}
+
+func (k Keeper) GetNextDelayedTransferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DelayedTransferIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextDelayedTransferID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	ctx.KVStore(k.storeKey).Set(types.DelayedTransferIDKey, bz)
+}
+
+// CreateDelayedTransfer moves the amount from the sender to the bankx module account until the transfer is executed
+func (k Keeper) CreateDelayedTransfer(ctx sdk.Context, transfer types.DelayedTransfer) (uint64, sdk.Error) {
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, transfer.FromAddress, types.ModuleName, transfer.Amount); err != nil {
+		return 0, err
+	}
+	transfer.ID = k.GetNextDelayedTransferID(ctx)
+	k.SetNextDelayedTransferID(ctx, transfer.ID+1)
+	k.setDelayedTransfer(ctx, transfer)
+	return transfer.ID, nil
+}
+
+// CancelDelayedTransfer refunds a delayed transfer which has not been executed to its sender
+func (k Keeper) CancelDelayedTransfer(ctx sdk.Context, from sdk.AccAddress, id uint64) (types.DelayedTransferSettlement, sdk.Error) {
+	transfer, found := k.GetDelayedTransfer(ctx, id)
+	if !found {
+		return types.DelayedTransferSettlement{}, types.ErrDelayedTransferNotFound(id)
+	}
+	if !transfer.FromAddress.Equals(from) {
+		return types.DelayedTransferSettlement{}, sdk.ErrUnauthorized("only the sender can cancel the delayed transfer")
+	}
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, transfer.FromAddress, transfer.Amount); err != nil {
+		return types.DelayedTransferSettlement{}, err
+	}
+	k.removeDelayedTransfer(ctx, transfer)
+	return k.newDelayedTransferSettlement(ctx, transfer, true), nil
+}
+
+// ExecuteDelayedTransfers pays the delayed transfers due not later than time to their recipients,
+// a transfer which is no longer allowed when it is due is refunded to its sender instead
+func (k Keeper) ExecuteDelayedTransfers(ctx sdk.Context, time int64) []types.DelayedTransferSettlement {
+	store := ctx.KVStore(k.storeKey)
+	keys := dex.DueQueueKeys(store, types.DelayedTransferQueueKey, sdk.PrefixEndBytes(types.GetDelayedTransferQueueTimeKey(time)), 0)
+	settlements := make([]types.DelayedTransferSettlement, 0, len(keys))
+	for _, key := range keys {
+		id := binary.BigEndian.Uint64(key[len(types.DelayedTransferQueueKey)+8:])
+		transfer, found := k.GetDelayedTransfer(ctx, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		// the transfer stays in the queue and is retried in the next block if it fails
+		cacheCtx, write := ctx.CacheContext()
+		recipient, refunded := transfer.ToAddress, false
+		if err := k.checkDelayedTransfer(cacheCtx, transfer); err != nil {
+			ctx.Logger().Info(fmt.Sprintf("refund delayed transfer %d: %s", transfer.ID, err.Error()))
+			recipient, refunded = transfer.FromAddress, true
+		}
+		if err := k.sk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, recipient, transfer.Amount); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to execute delayed transfer %d: %s", transfer.ID, err.Error()))
+			continue
+		}
+		k.removeDelayedTransfer(cacheCtx, transfer)
+		write()
+		settlements = append(settlements, k.newDelayedTransferSettlement(ctx, transfer, refunded))
+	}
+	return settlements
+}
+
+// checkDelayedTransfer checks whether a due delayed transfer is still allowed. Its amount has been
+// counted against the daily send limit of the sender when it was queued, so only the other checks are repeated.
+func (k Keeper) checkDelayedTransfer(ctx sdk.Context, transfer types.DelayedTransfer) sdk.Error {
+	if k.IsSendForbidden(ctx, transfer.Amount, transfer.FromAddress) {
+		return types.ErrTokenForbiddenByOwner()
+	}
+	if k.BlacklistedAddr(transfer.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", transfer.ToAddress))
+	}
+	if accX, ok := k.axk.GetAccountX(ctx, transfer.ToAddress); ok && !accX.CanReceiveFrom(transfer.FromAddress) {
+		return types.ErrTransferNotWhitelisted(transfer.FromAddress, transfer.ToAddress)
+	}
+	return nil
+}
+
+func (k Keeper) ImportGenesisDelayedTransfer(ctx sdk.Context, transfer types.DelayedTransfer) {
+	k.setDelayedTransfer(ctx, transfer)
+}
+
+func (k Keeper) newDelayedTransferSettlement(ctx sdk.Context, transfer types.DelayedTransfer, cancelled bool) types.DelayedTransferSettlement {
+	return types.DelayedTransferSettlement{
+		ID:          transfer.ID,
+		FromAddress: transfer.FromAddress,
+		ToAddress:   transfer.ToAddress,
+		Amount:      transfer.Amount,
+		Cancelled:   cancelled,
+		Height:      ctx.BlockHeight(),
+	}
+}
+
+func (k Keeper) setDelayedTransfer(ctx sdk.Context, transfer types.DelayedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelayedTransferKey(transfer.ID), k.cdc.MustMarshalBinaryBare(transfer))
+	store.Set(types.GetDelayedTransferQueueKey(transfer.ExecuteTime, transfer.ID), []byte{})
+}
+
+func (k Keeper) removeDelayedTransfer(ctx sdk.Context, transfer types.DelayedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelayedTransferKey(transfer.ID))
+	store.Delete(types.GetDelayedTransferQueueKey(transfer.ExecuteTime, transfer.ID))
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Allowance lets the spender transfer at most Amount of the owner's coins of its denom,
+// until ExpireTime if it is not zero.
+type Allowance struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	Spender    sdk.AccAddress `json:"spender"`
+	Amount     sdk.Coin       `json:"amount"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewAllowance(owner, spender sdk.AccAddress, amount sdk.Coin, expireTime int64) Allowance {
+	return Allowance{
+		Owner:      owner,
+		Spender:    spender,
+		Amount:     amount,
+		ExpireTime: expireTime,
+	}
+}
+
+func (a Allowance) Validate() sdk.Error {
+	if a.Owner.Empty() || a.Spender.Empty() {
+		return sdk.ErrInvalidAddress("missing owner or spender address")
+	}
+	if a.Owner.Equals(a.Spender) {
+		return ErrInvalidAllowance("owner and spender must be different")
+	}
+	if !a.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("allowance amount is invalid: " + a.Amount.String())
+	}
+	if a.ExpireTime < 0 || a.ExpireTime > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidAllowance("invalid expire time")
+	}
+	return nil
+}
+
+// IsExpired returns whether the allowance can no longer be spent at the time
+func (a Allowance) IsExpired(time int64) bool {
+	return a.ExpireTime != 0 && a.ExpireTime <= time
+}
+
+func (a Allowance) String() string {
+	return fmt.Sprintf(`Allowance:
+  Owner:      %s
+  Spender:    %s
+  Amount:     %s
+  ExpireTime: %d`,
+		a.Owner, a.Spender, a.Amount, a.ExpireTime)
+}
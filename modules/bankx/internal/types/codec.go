@@ -17,4 +17,16 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "bankx/MsgSend", nil)
 	cdc.RegisterConcrete(MsgMultiSend{}, "bankx/MsgMultiSend", nil)
 	cdc.RegisterConcrete(MsgSupervisedSend{}, "bankx/MsgSupervisedSend", nil)
+	cdc.RegisterConcrete(MsgVestingSend{}, "bankx/MsgVestingSend", nil)
+	cdc.RegisterConcrete(MsgCreateEscrow{}, "bankx/MsgCreateEscrow", nil)
+	cdc.RegisterConcrete(MsgResolveEscrow{}, "bankx/MsgResolveEscrow", nil)
+	cdc.RegisterConcrete(MsgCreateHTLC{}, "bankx/MsgCreateHTLC", nil)
+	cdc.RegisterConcrete(MsgClaimHTLC{}, "bankx/MsgClaimHTLC", nil)
+	cdc.RegisterConcrete(MsgCreateRecurringPayment{}, "bankx/MsgCreateRecurringPayment", nil)
+	cdc.RegisterConcrete(MsgCancelRecurringPayment{}, "bankx/MsgCancelRecurringPayment", nil)
+	cdc.RegisterConcrete(MsgApprove{}, "bankx/MsgApprove", nil)
+	cdc.RegisterConcrete(MsgTransferFrom{}, "bankx/MsgTransferFrom", nil)
+	cdc.RegisterConcrete(MsgSetTransferRestrictions{}, "bankx/MsgSetTransferRestrictions", nil)
+	cdc.RegisterConcrete(MsgCancelDelayedTransfer{}, "bankx/MsgCancelDelayedTransfer", nil)
+	cdc.RegisterConcrete(MsgCancelTransferRestrictions{}, "bankx/MsgCancelTransferRestrictions", nil)
 }
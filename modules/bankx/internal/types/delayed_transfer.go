@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
+)
+
+// DelayedTransfer is a transfer from an account with a transfer delay, the coins are held in the
+// bankx module account until ExecuteTime, the sender can cancel it before then to get them back.
+type DelayedTransfer struct {
+	ID          uint64         `json:"id"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	ExecuteTime int64          `json:"execute_time"`
+}
+
+func NewDelayedTransfer(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, executeTime int64) DelayedTransfer {
+	return DelayedTransfer{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		ExecuteTime: executeTime,
+	}
+}
+
+func (t DelayedTransfer) Validate() sdk.Error {
+	if t.FromAddress.Empty() || t.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender or recipient address")
+	}
+	if !t.Amount.IsValid() || !t.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("delayed transfer amount is invalid: " + t.Amount.String())
+	}
+	if t.ExecuteTime <= 0 || t.ExecuteTime > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidTransferRestrictions("invalid execute time")
+	}
+	return nil
+}
+
+func (t DelayedTransfer) String() string {
+	return fmt.Sprintf(`DelayedTransfer %d:
+  FromAddress: %s
+  ToAddress:   %s
+  Amount:      %s
+  ExecuteTime: %d`,
+		t.ID, t.FromAddress, t.ToAddress, t.Amount, t.ExecuteTime)
+}
+
+// DelayedTransferSettlement records that a delayed transfer was executed, or cancelled by the sender
+type DelayedTransferSettlement struct {
+	ID          uint64         `json:"id"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	Cancelled   bool           `json:"cancelled"`
+	Height      int64          `json:"height"`
+}
+
+// PendingTransferRestrictions are transfer restrictions looser than the current ones of the account,
+// they take effect after the current transfer delay, and the account can cancel them before then.
+type PendingTransferRestrictions struct {
+	Address       sdk.AccAddress             `json:"address"`
+	Restrictions  authx.TransferRestrictions `json:"restrictions"`
+	EffectiveTime int64                      `json:"effective_time"`
+}
+
+func NewPendingTransferRestrictions(addr sdk.AccAddress, restrictions authx.TransferRestrictions, effectiveTime int64) PendingTransferRestrictions {
+	return PendingTransferRestrictions{
+		Address:       addr,
+		Restrictions:  restrictions,
+		EffectiveTime: effectiveTime,
+	}
+}
+
+func (p PendingTransferRestrictions) Validate() sdk.Error {
+	if p.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if err := p.Restrictions.Validate(); err != nil {
+		return ErrInvalidTransferRestrictions(err.Error())
+	}
+	if p.EffectiveTime <= 0 || p.EffectiveTime > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidTransferRestrictions("invalid effective time")
+	}
+	return nil
+}
@@ -22,6 +22,20 @@ const (
 	CodeRewardExceedsAmount             sdk.CodeType = 312
 	CodeLockedCoinNotFound              sdk.CodeType = 313
 	CodeInvalidTokenSymbol              sdk.CodeType = 314
+	CodeInvalidVestingSchedule          sdk.CodeType = 315
+	CodeInvalidEscrow                   sdk.CodeType = 316
+	CodeEscrowNotFound                  sdk.CodeType = 317
+	CodeInvalidHTLC                     sdk.CodeType = 318
+	CodeHTLCNotFound                    sdk.CodeType = 319
+	CodeInvalidRecurringPayment         sdk.CodeType = 320
+	CodeRecurringPaymentNotFound        sdk.CodeType = 321
+	CodeInvalidAllowance                sdk.CodeType = 322
+	CodeInsufficientAllowance           sdk.CodeType = 323
+	CodeInvalidTransferRestrictions     sdk.CodeType = 324
+	CodeTransferNotWhitelisted          sdk.CodeType = 325
+	CodeDailySendLimitExceeded          sdk.CodeType = 326
+	CodeDelayedTransferNotFound         sdk.CodeType = 327
+	CodeTransferRestrictionsNotPending  sdk.CodeType = 328
 )
 
 func ErrMemoMissing() sdk.Error {
@@ -79,3 +93,59 @@ func ErrLockedCoinNotFound() sdk.Error {
 func ErrInvalidTokenSymbol(symbol string) sdk.Error {
 	return sdk.NewError(CodeSpaceBankx, CodeInvalidTokenSymbol, "%s token not exist", symbol)
 }
+
+func ErrInvalidVestingSchedule(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidVestingSchedule, msg)
+}
+
+func ErrInvalidEscrow(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidEscrow, msg)
+}
+
+func ErrEscrowNotFound(id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeEscrowNotFound, "escrow %d not found", id)
+}
+
+func ErrInvalidHTLC(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidHTLC, msg)
+}
+
+func ErrHTLCNotFound(hashLock []byte) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeHTLCNotFound, "htlc %X not found", hashLock)
+}
+
+func ErrInvalidRecurringPayment(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidRecurringPayment, msg)
+}
+
+func ErrRecurringPaymentNotFound(id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeRecurringPaymentNotFound, "recurring payment %d not found", id)
+}
+
+func ErrInvalidAllowance(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidAllowance, msg)
+}
+
+func ErrInsufficientAllowance(allowance, amount sdk.Coin) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInsufficientAllowance, "allowance %s is less than %s", allowance, amount)
+}
+
+func ErrInvalidTransferRestrictions(msg string) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeInvalidTransferRestrictions, msg)
+}
+
+func ErrTransferNotWhitelisted(from, to sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeTransferNotWhitelisted, "%s does not accept coins from %s", to, from)
+}
+
+func ErrDailySendLimitExceeded(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeDailySendLimitExceeded, "daily send limit of %s is exceeded", addr)
+}
+
+func ErrDelayedTransferNotFound(id uint64) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeDelayedTransferNotFound, "delayed transfer %d not found", id)
+}
+
+func ErrTransferRestrictionsNotPending(addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(CodeSpaceBankx, CodeTransferRestrictionsNotPending, "%s has no pending transfer restrictions", addr)
+}
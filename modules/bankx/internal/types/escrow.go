@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// decisions on an escrow
+const (
+	EscrowRelease byte = 0
+	EscrowRefund  byte = 1
+	EscrowSplit   byte = 2
+)
+
+// Escrow holds the buyer's coins in the bankx module account until they are released to
+// the seller, refunded to the buyer or split between them. The arbiter fee is paid only
+// when the arbiter decides, otherwise it goes back to the buyer. When no decision is made
+// before the deadline, the escrow is settled with its default outcome.
+type Escrow struct {
+	ID             uint64         `json:"id"`
+	Buyer          sdk.AccAddress `json:"buyer"`
+	Seller         sdk.AccAddress `json:"seller"`
+	Arbiter        sdk.AccAddress `json:"arbiter"`
+	Amount         sdk.Coins      `json:"amount"`
+	ArbiterFee     sdk.Coins      `json:"arbiter_fee"`
+	Deadline       int64          `json:"deadline"`
+	DefaultOutcome byte           `json:"default_outcome"`
+}
+
+func NewEscrow(buyer, seller, arbiter sdk.AccAddress, amount, arbiterFee sdk.Coins, deadline int64, defaultOutcome byte) Escrow {
+	return Escrow{
+		Buyer:          buyer,
+		Seller:         seller,
+		Arbiter:        arbiter,
+		Amount:         amount,
+		ArbiterFee:     arbiterFee,
+		Deadline:       deadline,
+		DefaultOutcome: defaultOutcome,
+	}
+}
+
+func (e Escrow) Validate() sdk.Error {
+	if e.Buyer.Empty() || e.Seller.Empty() || e.Arbiter.Empty() {
+		return sdk.ErrInvalidAddress("missing buyer, seller or arbiter address")
+	}
+	if e.Buyer.Equals(e.Seller) || e.Arbiter.Equals(e.Buyer) || e.Arbiter.Equals(e.Seller) {
+		return ErrInvalidEscrow("buyer, seller and arbiter must be different")
+	}
+	if !e.Amount.IsValid() || !e.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("escrow amount is invalid: " + e.Amount.String())
+	}
+	if !e.ArbiterFee.IsValid() {
+		return sdk.ErrInvalidCoins("arbiter fee is invalid: " + e.ArbiterFee.String())
+	}
+	if e.Deadline <= 0 || e.Deadline > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidEscrow("invalid deadline")
+	}
+	if e.DefaultOutcome != EscrowRelease && e.DefaultOutcome != EscrowRefund {
+		return ErrInvalidEscrow("default outcome must be release or refund")
+	}
+	return nil
+}
+
+// IsParty returns whether the address is the buyer, seller or arbiter of the escrow
+func (e Escrow) IsParty(addr sdk.AccAddress) bool {
+	return bytes.Equal(e.Buyer, addr) || bytes.Equal(e.Seller, addr) || bytes.Equal(e.Arbiter, addr)
+}
+
+func (e Escrow) String() string {
+	return fmt.Sprintf(`Escrow %d:
+  Buyer:          %s
+  Seller:         %s
+  Arbiter:        %s
+  Amount:         %s
+  ArbiterFee:     %s
+  Deadline:       %d
+  DefaultOutcome: %d`,
+		e.ID, e.Buyer, e.Seller, e.Arbiter, e.Amount, e.ArbiterFee, e.Deadline, e.DefaultOutcome)
+}
+
+// EscrowResult records how an escrow was settled
+type EscrowResult struct {
+	ID           uint64         `json:"id"`
+	Decision     byte           `json:"decision"`
+	Decider      sdk.AccAddress `json:"decider,omitempty"`
+	Buyer        sdk.AccAddress `json:"buyer"`
+	Seller       sdk.AccAddress `json:"seller"`
+	Arbiter      sdk.AccAddress `json:"arbiter"`
+	BuyerAmount  sdk.Coins      `json:"buyer_amount"`
+	SellerAmount sdk.Coins      `json:"seller_amount"`
+	ArbiterFee   sdk.Coins      `json:"arbiter_fee"`
+	Height       int64          `json:"height"`
+}
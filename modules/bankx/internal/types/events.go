@@ -1,11 +1,39 @@
 package types
 
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer      = "transfer"
+	EventTypeCreateEscrow  = "create_escrow"
+	EventTypeResolveEscrow = "resolve_escrow"
+	EventTypeCreateHTLC    = "create_htlc"
+	EventTypeClaimHTLC     = "claim_htlc"
+	EventTypeRefundHTLC    = "refund_htlc"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
-	AttributeKeyAmount    = "amount"
+	EventTypeCreateRecurringPayment = "create_recurring_payment"
+	EventTypeCancelRecurringPayment = "cancel_recurring_payment"
+	EventTypeRecurringPayment       = "recurring_payment"
+
+	EventTypeApprove      = "approve"
+	EventTypeTransferFrom = "transfer_from"
+
+	EventTypeSetTransferRestrictions    = "set_transfer_restrictions"
+	EventTypeCancelTransferRestrictions = "cancel_transfer_restrictions"
+	EventTypeDelayTransfer              = "delay_transfer"
+	EventTypeCancelDelayedTransfer      = "cancel_delayed_transfer"
+	EventTypeExecuteDelayedTransfer     = "execute_delayed_transfer"
+
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeySender        = "sender"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyEscrowID      = "escrow_id"
+	AttributeKeyDecision      = "decision"
+	AttributeKeyHashLock      = "hash_lock"
+	AttributeKeySecret        = "secret"
+	AttributeKeyPaymentID     = "payment_id"
+	AttributeKeyExecuted      = "executed"
+	AttributeKeyOwner         = "owner"
+	AttributeKeySpender       = "spender"
+	AttributeKeyTransferID    = "transfer_id"
+	AttributeKeyEffectiveTime = "effective_time"
 
 	AttributeValueCategory = ModuleName
 )
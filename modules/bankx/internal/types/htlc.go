@@ -0,0 +1,80 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	HashLockSize    = sha256.Size
+	MaxSecretLength = 64
+)
+
+// HTLC is a hash time-locked transfer, the coins are held in the bankx module account
+// until they are claimed for the recipient by revealing the preimage of HashLock, or
+// refunded to the sender at ExpireTime.
+type HTLC struct {
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coins      `json:"amount"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewHTLC(sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, expireTime int64) HTLC {
+	return HTLC{
+		HashLock:   hashLock,
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+		ExpireTime: expireTime,
+	}
+}
+
+func (h HTLC) Validate() sdk.Error {
+	if h.Sender.Empty() || h.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing sender or recipient address")
+	}
+	if !h.Amount.IsValid() || !h.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("htlc amount is invalid: " + h.Amount.String())
+	}
+	if len(h.HashLock) != HashLockSize {
+		return ErrInvalidHTLC(fmt.Sprintf("hash lock must be %d bytes", HashLockSize))
+	}
+	if h.ExpireTime <= 0 || h.ExpireTime > math.MaxInt64/int64(time.Second) {
+		return ErrInvalidHTLC("invalid expire time")
+	}
+	return nil
+}
+
+func (h HTLC) String() string {
+	return fmt.Sprintf(`HTLC %s:
+  Sender:     %s
+  Recipient:  %s
+  Amount:     %s
+  ExpireTime: %d`,
+		h.HashLock, h.Sender, h.Recipient, h.Amount, h.ExpireTime)
+}
+
+// HashSecret returns the hash lock opened by the secret
+func HashSecret(secret []byte) []byte {
+	hash := sha256.Sum256(secret)
+	return hash[:]
+}
+
+// HTLCSettlement records that an HTLC was claimed with Secret, or refunded to the sender
+type HTLCSettlement struct {
+	HashLock  cmn.HexBytes   `json:"hash_lock"`
+	Secret    cmn.HexBytes   `json:"secret,omitempty"`
+	Sender    sdk.AccAddress `json:"sender"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coins      `json:"amount"`
+	Refunded  bool           `json:"refunded"`
+	Height    int64          `json:"height"`
+}
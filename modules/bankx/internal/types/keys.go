@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName        = "bankx"
 	StoreKey          = ModuleName
@@ -11,3 +17,126 @@ const (
 
 	Topic = ModuleName
 )
+
+var (
+	EscrowKey      = []byte{0x01}
+	EscrowQueueKey = []byte{0x02}
+	EscrowIDKey    = []byte{0x03}
+	HTLCKey        = []byte{0x04}
+	HTLCQueueKey   = []byte{0x05}
+
+	RecurringPaymentKey      = []byte{0x06}
+	RecurringPaymentQueueKey = []byte{0x07}
+	RecurringPaymentIDKey    = []byte{0x08}
+	RecurringPaymentPayerKey = []byte{0x0D}
+
+	AllowanceKey = []byte{0x09}
+
+	DelayedTransferKey      = []byte{0x0A}
+	DelayedTransferQueueKey = []byte{0x0B}
+	DelayedTransferIDKey    = []byte{0x0C}
+
+	PendingTransferRestrictionsKey      = []byte{0x0E}
+	PendingTransferRestrictionsQueueKey = []byte{0x0F}
+)
+
+// GetEscrowKey - EscrowKey | id
+func GetEscrowKey(id uint64) []byte {
+	return append(append([]byte{}, EscrowKey...), uint64ToBytes(id)...)
+}
+
+// GetEscrowQueueKey - EscrowQueueKey | deadline | id
+func GetEscrowQueueKey(deadline int64, id uint64) []byte {
+	return append(GetEscrowQueueTimeKey(deadline), uint64ToBytes(id)...)
+}
+
+// GetEscrowQueueTimeKey - EscrowQueueKey | deadline
+func GetEscrowQueueTimeKey(deadline int64) []byte {
+	return append(append([]byte{}, EscrowQueueKey...), uint64ToBytes(uint64(deadline))...)
+}
+
+// GetHTLCKey - HTLCKey | hashLock
+func GetHTLCKey(hashLock []byte) []byte {
+	return append(append([]byte{}, HTLCKey...), hashLock...)
+}
+
+// GetHTLCQueueKey - HTLCQueueKey | expireTime | hashLock
+func GetHTLCQueueKey(expireTime int64, hashLock []byte) []byte {
+	return append(GetHTLCQueueTimeKey(expireTime), hashLock...)
+}
+
+// GetHTLCQueueTimeKey - HTLCQueueKey | expireTime
+func GetHTLCQueueTimeKey(expireTime int64) []byte {
+	return append(append([]byte{}, HTLCQueueKey...), uint64ToBytes(uint64(expireTime))...)
+}
+
+// GetRecurringPaymentKey - RecurringPaymentKey | id
+func GetRecurringPaymentKey(id uint64) []byte {
+	return append(append([]byte{}, RecurringPaymentKey...), uint64ToBytes(id)...)
+}
+
+// GetRecurringPaymentQueueKey - RecurringPaymentQueueKey | nextTime | id
+func GetRecurringPaymentQueueKey(nextTime int64, id uint64) []byte {
+	return append(GetRecurringPaymentQueueTimeKey(nextTime), uint64ToBytes(id)...)
+}
+
+// GetRecurringPaymentQueueTimeKey - RecurringPaymentQueueKey | nextTime
+func GetRecurringPaymentQueueTimeKey(nextTime int64) []byte {
+	return append(append([]byte{}, RecurringPaymentQueueKey...), uint64ToBytes(uint64(nextTime))...)
+}
+
+// GetRecurringPaymentPayerKey - RecurringPaymentPayerKey | payer | id
+func GetRecurringPaymentPayerKey(payer sdk.AccAddress, id uint64) []byte {
+	return append(GetRecurringPaymentPayerPrefix(payer), uint64ToBytes(id)...)
+}
+
+// GetRecurringPaymentPayerPrefix - RecurringPaymentPayerKey | payer
+func GetRecurringPaymentPayerPrefix(payer sdk.AccAddress) []byte {
+	return append(append([]byte{}, RecurringPaymentPayerKey...), payer...)
+}
+
+// GetAllowanceKey - AllowanceKey | owner | spender | denom
+func GetAllowanceKey(owner, spender sdk.AccAddress, denom string) []byte {
+	return append(append(GetOwnerAllowanceKey(owner), spender...), denom...)
+}
+
+// GetOwnerAllowanceKey - AllowanceKey | owner
+func GetOwnerAllowanceKey(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, AllowanceKey...), owner...)
+}
+
+// GetDelayedTransferKey - DelayedTransferKey | id
+func GetDelayedTransferKey(id uint64) []byte {
+	return append(append([]byte{}, DelayedTransferKey...), uint64ToBytes(id)...)
+}
+
+// GetDelayedTransferQueueKey - DelayedTransferQueueKey | executeTime | id
+func GetDelayedTransferQueueKey(executeTime int64, id uint64) []byte {
+	return append(GetDelayedTransferQueueTimeKey(executeTime), uint64ToBytes(id)...)
+}
+
+// GetDelayedTransferQueueTimeKey - DelayedTransferQueueKey | executeTime
+func GetDelayedTransferQueueTimeKey(executeTime int64) []byte {
+	return append(append([]byte{}, DelayedTransferQueueKey...), uint64ToBytes(uint64(executeTime))...)
+}
+
+// GetPendingTransferRestrictionsKey - PendingTransferRestrictionsKey | address
+func GetPendingTransferRestrictionsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, PendingTransferRestrictionsKey...), addr...)
+}
+
+// GetPendingTransferRestrictionsQueueKey - PendingTransferRestrictionsQueueKey | effectiveTime | address
+func GetPendingTransferRestrictionsQueueKey(effectiveTime int64, addr sdk.AccAddress) []byte {
+	return append(GetPendingTransferRestrictionsQueueTimeKey(effectiveTime), addr...)
+}
+
+// GetPendingTransferRestrictionsQueueTimeKey - PendingTransferRestrictionsQueueKey | effectiveTime
+func GetPendingTransferRestrictionsQueueTimeKey(effectiveTime int64) []byte {
+	return append(append([]byte{}, PendingTransferRestrictionsQueueKey...), uint64ToBytes(uint64(effectiveTime))...)
+}
+
+func uint64ToBytes(n uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, n)
+	return bz
+}
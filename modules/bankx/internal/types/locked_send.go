@@ -32,3 +32,13 @@ func NewSupervisedSendMsg(fromAddr, toAddr, supervisor sdk.AccAddress, amount sd
 		Reward:      reward,
 	}
 }
+
+type VestingSendMsg struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	StartTime   int64          `json:"start_time"`
+	CliffTime   int64          `json:"cliff_time,omitempty"`
+	Period      int64          `json:"period"`
+	Periods     int64          `json:"periods"`
+}
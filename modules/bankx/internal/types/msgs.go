@@ -1,11 +1,17 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
 	"math"
 	"time"
 
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/coinexchain/cet-sdk/modules/authx"
 )
 
 var _ sdk.Msg = MsgSetMemoRequired{}
@@ -271,3 +277,577 @@ func (msg MsgSupervisedSend) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+var _ sdk.Msg = MsgVestingSend{}
+
+// MsgVestingSend locks the coins for the recipient, which are released in Periods equal
+// slices, one every Period seconds from StartTime on, no slice is released before CliffTime.
+type MsgVestingSend struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	Amount      sdk.Coins      `json:"amount"`
+	StartTime   int64          `json:"start_time"`
+	CliffTime   int64          `json:"cliff_time"`
+	Period      int64          `json:"period"`
+	Periods     int64          `json:"periods"`
+}
+
+func NewMsgVestingSend(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, cliffTime, period, periods int64) MsgVestingSend {
+	return MsgVestingSend{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		StartTime:   startTime,
+		CliffTime:   cliffTime,
+		Period:      period,
+		Periods:     periods,
+	}
+}
+
+func (msg *MsgVestingSend) SetAccAddress(addr sdk.AccAddress) {
+	msg.FromAddress = addr
+}
+
+func (msg MsgVestingSend) Route() string { return RouterKey }
+
+func (msg MsgVestingSend) Type() string { return "vesting_send" }
+
+func (msg MsgVestingSend) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("send amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("send amount must be positive")
+	}
+	if msg.StartTime <= 0 {
+		return ErrInvalidVestingSchedule("start time must be positive")
+	}
+	if msg.Period <= 0 || msg.Periods <= 0 {
+		return ErrInvalidVestingSchedule("period and periods must be positive")
+	}
+	maxTime := math.MaxInt64 / int64(time.Second)
+	if msg.StartTime > maxTime || msg.Period > (maxTime-msg.StartTime)/msg.Periods {
+		return ErrInvalidVestingSchedule("end time is too large")
+	}
+	endTime := msg.StartTime + msg.Period*msg.Periods
+	if msg.CliffTime != 0 && (msg.CliffTime < msg.StartTime || msg.CliffTime > endTime) {
+		return ErrInvalidVestingSchedule("cliff time must be between start time and end time")
+	}
+
+	return nil
+}
+
+func (msg MsgVestingSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgVestingSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+var _ sdk.Msg = MsgCreateEscrow{}
+
+type MsgCreateEscrow struct {
+	Buyer          sdk.AccAddress `json:"buyer"`
+	Seller         sdk.AccAddress `json:"seller"`
+	Arbiter        sdk.AccAddress `json:"arbiter"`
+	Amount         sdk.Coins      `json:"amount"`
+	ArbiterFee     sdk.Coins      `json:"arbiter_fee"`
+	Deadline       int64          `json:"deadline"`
+	DefaultOutcome byte           `json:"default_outcome"`
+}
+
+func NewMsgCreateEscrow(buyer, seller, arbiter sdk.AccAddress, amount, arbiterFee sdk.Coins,
+	deadline int64, defaultOutcome byte) MsgCreateEscrow {
+	return MsgCreateEscrow{
+		Buyer:          buyer,
+		Seller:         seller,
+		Arbiter:        arbiter,
+		Amount:         amount,
+		ArbiterFee:     arbiterFee,
+		Deadline:       deadline,
+		DefaultOutcome: defaultOutcome,
+	}
+}
+
+func (msg *MsgCreateEscrow) SetAccAddress(addr sdk.AccAddress) {
+	msg.Buyer = addr
+}
+
+func (msg MsgCreateEscrow) Route() string { return RouterKey }
+
+func (msg MsgCreateEscrow) Type() string { return "create_escrow" }
+
+func (msg MsgCreateEscrow) ValidateBasic() sdk.Error {
+	return msg.ToEscrow().Validate()
+}
+
+func (msg MsgCreateEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Buyer}
+}
+
+func (msg MsgCreateEscrow) ToEscrow() Escrow {
+	return NewEscrow(msg.Buyer, msg.Seller, msg.Arbiter, msg.Amount, msg.ArbiterFee, msg.Deadline, msg.DefaultOutcome)
+}
+
+var _ sdk.Msg = MsgResolveEscrow{}
+
+// MsgResolveEscrow releases an escrow to the seller (by the buyer or the arbiter), refunds it
+// to the buyer (by the seller or the arbiter) or splits it (by the arbiter only), in which
+// case SellerAmount goes to the seller and the rest to the buyer.
+type MsgResolveEscrow struct {
+	Sender       sdk.AccAddress `json:"sender"`
+	ID           uint64         `json:"id"`
+	Decision     byte           `json:"decision"`
+	SellerAmount sdk.Coins      `json:"seller_amount,omitempty"`
+}
+
+func NewMsgResolveEscrow(sender sdk.AccAddress, id uint64, decision byte, sellerAmount sdk.Coins) MsgResolveEscrow {
+	return MsgResolveEscrow{
+		Sender:       sender,
+		ID:           id,
+		Decision:     decision,
+		SellerAmount: sellerAmount,
+	}
+}
+
+func (msg *MsgResolveEscrow) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+func (msg MsgResolveEscrow) Route() string { return RouterKey }
+
+func (msg MsgResolveEscrow) Type() string { return "resolve_escrow" }
+
+func (msg MsgResolveEscrow) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.Decision > EscrowSplit {
+		return ErrInvalidEscrow("invalid decision")
+	}
+	if msg.Decision != EscrowSplit && !msg.SellerAmount.Empty() {
+		return ErrInvalidEscrow("seller amount is only used by split")
+	}
+	if !msg.SellerAmount.IsValid() {
+		return sdk.ErrInvalidCoins("seller amount is invalid: " + msg.SellerAmount.String())
+	}
+	return nil
+}
+
+func (msg MsgResolveEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgResolveEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgCreateHTLC{}
+
+type MsgCreateHTLC struct {
+	Sender     sdk.AccAddress `json:"sender"`
+	Recipient  sdk.AccAddress `json:"recipient"`
+	Amount     sdk.Coins      `json:"amount"`
+	HashLock   cmn.HexBytes   `json:"hash_lock"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewMsgCreateHTLC(sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, expireTime int64) MsgCreateHTLC {
+	return MsgCreateHTLC{
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+		HashLock:   hashLock,
+		ExpireTime: expireTime,
+	}
+}
+
+func (msg *MsgCreateHTLC) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+func (msg MsgCreateHTLC) Route() string { return RouterKey }
+
+func (msg MsgCreateHTLC) Type() string { return "create_htlc" }
+
+func (msg MsgCreateHTLC) ValidateBasic() sdk.Error {
+	return msg.ToHTLC().Validate()
+}
+
+func (msg MsgCreateHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgCreateHTLC) ToHTLC() HTLC {
+	return NewHTLC(msg.Sender, msg.Recipient, msg.Amount, msg.HashLock, msg.ExpireTime)
+}
+
+var _ sdk.Msg = MsgClaimHTLC{}
+
+// MsgClaimHTLC reveals the secret of an HTLC, anyone can send it
+// and the coins always go to the recipient of the HTLC.
+type MsgClaimHTLC struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	HashLock cmn.HexBytes   `json:"hash_lock"`
+	Secret   cmn.HexBytes   `json:"secret"`
+}
+
+func NewMsgClaimHTLC(sender sdk.AccAddress, hashLock, secret []byte) MsgClaimHTLC {
+	return MsgClaimHTLC{
+		Sender:   sender,
+		HashLock: hashLock,
+		Secret:   secret,
+	}
+}
+
+func (msg *MsgClaimHTLC) SetAccAddress(addr sdk.AccAddress) {
+	msg.Sender = addr
+}
+
+func (msg MsgClaimHTLC) Route() string { return RouterKey }
+
+func (msg MsgClaimHTLC) Type() string { return "claim_htlc" }
+
+func (msg MsgClaimHTLC) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.HashLock) != HashLockSize {
+		return ErrInvalidHTLC(fmt.Sprintf("hash lock must be %d bytes", HashLockSize))
+	}
+	if len(msg.Secret) == 0 || len(msg.Secret) > MaxSecretLength {
+		return ErrInvalidHTLC(fmt.Sprintf("secret must be 1 to %d bytes", MaxSecretLength))
+	}
+	if !bytes.Equal(HashSecret(msg.Secret), msg.HashLock) {
+		return ErrInvalidHTLC("secret does not match the hash lock")
+	}
+	return nil
+}
+
+func (msg MsgClaimHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgClaimHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+var _ sdk.Msg = MsgCreateRecurringPayment{}
+
+// MsgCreateRecurringPayment authorizes paying Amount to the recipient every Interval seconds from
+// StartTime on, Count times or until EndTime, a zero Count or EndTime means no such limit.
+type MsgCreateRecurringPayment struct {
+	Payer     sdk.AccAddress `json:"payer"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coins      `json:"amount"`
+	StartTime int64          `json:"start_time"`
+	Interval  int64          `json:"interval"`
+	Count     int64          `json:"count"`
+	EndTime   int64          `json:"end_time"`
+}
+
+func NewMsgCreateRecurringPayment(payer, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime, interval, count, endTime int64) MsgCreateRecurringPayment {
+	return MsgCreateRecurringPayment{
+		Payer:     payer,
+		Recipient: recipient,
+		Amount:    amount,
+		StartTime: startTime,
+		Interval:  interval,
+		Count:     count,
+		EndTime:   endTime,
+	}
+}
+
+func (msg *MsgCreateRecurringPayment) SetAccAddress(addr sdk.AccAddress) {
+	msg.Payer = addr
+}
+
+func (msg MsgCreateRecurringPayment) Route() string { return RouterKey }
+
+func (msg MsgCreateRecurringPayment) Type() string { return "create_recurring_payment" }
+
+func (msg MsgCreateRecurringPayment) ValidateBasic() sdk.Error {
+	return msg.ToRecurringPayment().Validate()
+}
+
+func (msg MsgCreateRecurringPayment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCreateRecurringPayment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+func (msg MsgCreateRecurringPayment) ToRecurringPayment() RecurringPayment {
+	return NewRecurringPayment(msg.Payer, msg.Recipient, msg.Amount, msg.StartTime, msg.Interval, msg.Count, msg.EndTime)
+}
+
+var _ sdk.Msg = MsgCancelRecurringPayment{}
+
+type MsgCancelRecurringPayment struct {
+	Payer sdk.AccAddress `json:"payer"`
+	ID    uint64         `json:"id"`
+}
+
+func NewMsgCancelRecurringPayment(payer sdk.AccAddress, id uint64) MsgCancelRecurringPayment {
+	return MsgCancelRecurringPayment{
+		Payer: payer,
+		ID:    id,
+	}
+}
+
+func (msg *MsgCancelRecurringPayment) SetAccAddress(addr sdk.AccAddress) {
+	msg.Payer = addr
+}
+
+func (msg MsgCancelRecurringPayment) Route() string { return RouterKey }
+
+func (msg MsgCancelRecurringPayment) Type() string { return "cancel_recurring_payment" }
+
+func (msg MsgCancelRecurringPayment) ValidateBasic() sdk.Error {
+	if msg.Payer.Empty() {
+		return sdk.ErrInvalidAddress("missing payer address")
+	}
+	return nil
+}
+
+func (msg MsgCancelRecurringPayment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelRecurringPayment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Payer}
+}
+
+var _ sdk.Msg = MsgApprove{}
+
+// MsgApprove sets the allowance of the spender on the owner's coins of Amount's denom,
+// a zero Amount revokes it and a zero ExpireTime means it never expires.
+type MsgApprove struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	Spender    sdk.AccAddress `json:"spender"`
+	Amount     sdk.Coin       `json:"amount"`
+	ExpireTime int64          `json:"expire_time"`
+}
+
+func NewMsgApprove(owner, spender sdk.AccAddress, amount sdk.Coin, expireTime int64) MsgApprove {
+	return MsgApprove{
+		Owner:      owner,
+		Spender:    spender,
+		Amount:     amount,
+		ExpireTime: expireTime,
+	}
+}
+
+func (msg *MsgApprove) SetAccAddress(addr sdk.AccAddress) {
+	msg.Owner = addr
+}
+
+func (msg MsgApprove) Route() string { return RouterKey }
+
+func (msg MsgApprove) Type() string { return "approve" }
+
+func (msg MsgApprove) ValidateBasic() sdk.Error {
+	return msg.ToAllowance().Validate()
+}
+
+func (msg MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApprove) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgApprove) ToAllowance() Allowance {
+	return NewAllowance(msg.Owner, msg.Spender, msg.Amount, msg.ExpireTime)
+}
+
+var _ sdk.Msg = MsgTransferFrom{}
+
+// MsgTransferFrom sends Amount from the owner to ToAddress, spending the allowances of the spender
+type MsgTransferFrom struct {
+	Spender   sdk.AccAddress `json:"spender"`
+	Owner     sdk.AccAddress `json:"owner"`
+	ToAddress sdk.AccAddress `json:"to_address"`
+	Amount    sdk.Coins      `json:"amount"`
+}
+
+func NewMsgTransferFrom(spender, owner, toAddr sdk.AccAddress, amount sdk.Coins) MsgTransferFrom {
+	return MsgTransferFrom{
+		Spender:   spender,
+		Owner:     owner,
+		ToAddress: toAddr,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgTransferFrom) SetAccAddress(addr sdk.AccAddress) {
+	msg.Spender = addr
+}
+
+func (msg MsgTransferFrom) Route() string { return RouterKey }
+
+func (msg MsgTransferFrom) Type() string { return "transfer_from" }
+
+func (msg MsgTransferFrom) ValidateBasic() sdk.Error {
+	if msg.Spender.Empty() || msg.Owner.Empty() || msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing spender, owner or recipient address")
+	}
+	if msg.Spender.Equals(msg.Owner) {
+		return ErrInvalidAllowance("owner and spender must be different")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("transfer amount is invalid: " + msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg MsgTransferFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgTransferFrom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Spender}
+}
+
+var _ sdk.Msg = MsgSetTransferRestrictions{}
+
+// MsgSetTransferRestrictions replaces the transfer restrictions of the account, empty values turn them off.
+type MsgSetTransferRestrictions struct {
+	Address          sdk.AccAddress   `json:"address"`
+	ReceiveWhitelist []sdk.AccAddress `json:"receive_whitelist"`
+	DailySendLimit   sdk.Coins        `json:"daily_send_limit"`
+	DelayThreshold   sdk.Coins        `json:"delay_threshold"`
+	TransferDelay    int64            `json:"transfer_delay"`
+}
+
+func NewMsgSetTransferRestrictions(addr sdk.AccAddress, receiveWhitelist []sdk.AccAddress,
+	dailySendLimit, delayThreshold sdk.Coins, transferDelay int64) MsgSetTransferRestrictions {
+	return MsgSetTransferRestrictions{
+		Address:          addr,
+		ReceiveWhitelist: receiveWhitelist,
+		DailySendLimit:   dailySendLimit,
+		DelayThreshold:   delayThreshold,
+		TransferDelay:    transferDelay,
+	}
+}
+
+func (msg *MsgSetTransferRestrictions) SetAccAddress(addr sdk.AccAddress) {
+	msg.Address = addr
+}
+
+func (msg MsgSetTransferRestrictions) Route() string { return RouterKey }
+
+func (msg MsgSetTransferRestrictions) Type() string { return "set_transfer_restrictions" }
+
+func (msg MsgSetTransferRestrictions) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if err := msg.ToTransferRestrictions().Validate(); err != nil {
+		return ErrInvalidTransferRestrictions(err.Error())
+	}
+	return nil
+}
+
+func (msg MsgSetTransferRestrictions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetTransferRestrictions) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+func (msg MsgSetTransferRestrictions) ToTransferRestrictions() authx.TransferRestrictions {
+	return authx.NewTransferRestrictions(msg.ReceiveWhitelist, msg.DailySendLimit, msg.DelayThreshold, msg.TransferDelay)
+}
+
+var _ sdk.Msg = MsgCancelDelayedTransfer{}
+
+type MsgCancelDelayedTransfer struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	ID          uint64         `json:"id"`
+}
+
+func NewMsgCancelDelayedTransfer(fromAddr sdk.AccAddress, id uint64) MsgCancelDelayedTransfer {
+	return MsgCancelDelayedTransfer{
+		FromAddress: fromAddr,
+		ID:          id,
+	}
+}
+
+func (msg *MsgCancelDelayedTransfer) SetAccAddress(addr sdk.AccAddress) {
+	msg.FromAddress = addr
+}
+
+func (msg MsgCancelDelayedTransfer) Route() string { return RouterKey }
+
+func (msg MsgCancelDelayedTransfer) Type() string { return "cancel_delayed_transfer" }
+
+func (msg MsgCancelDelayedTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	return nil
+}
+
+func (msg MsgCancelDelayedTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelDelayedTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+var _ sdk.Msg = MsgCancelTransferRestrictions{}
+
+// MsgCancelTransferRestrictions cancels the looser transfer restrictions of the account which have not taken effect.
+type MsgCancelTransferRestrictions struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+func NewMsgCancelTransferRestrictions(addr sdk.AccAddress) MsgCancelTransferRestrictions {
+	return MsgCancelTransferRestrictions{
+		Address: addr,
+	}
+}
+
+func (msg *MsgCancelTransferRestrictions) SetAccAddress(addr sdk.AccAddress) {
+	msg.Address = addr
+}
+
+func (msg MsgCancelTransferRestrictions) Route() string { return RouterKey }
+
+func (msg MsgCancelTransferRestrictions) Type() string { return "cancel_transfer_restrictions" }
+
+func (msg MsgCancelTransferRestrictions) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	return nil
+}
+
+func (msg MsgCancelTransferRestrictions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgCancelTransferRestrictions) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
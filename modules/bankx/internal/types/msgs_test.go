@@ -232,3 +232,135 @@ func TestMsgSupervisedSend_Type(t *testing.T) {
 	require.True(t, len(msg.Type()) > 0)
 	require.Equal(t, ModuleName, msg.Route())
 }
+
+func TestMsgVestingSend_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := dex.NewCetCoins(100)
+	amtInvalid := sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-100)}}
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 0, 100, 4)},
+		{Valid: true, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 1400, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(nil, recipient, amt, 1000, 0, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, nil, amt, 1000, 0, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amtInvalid, 1000, 0, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 0, 0, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 0, 0, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 0, 100, 0)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 999, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 1401, 100, 4)},
+		{Valid: false, Msg: NewMsgVestingSend(sender, recipient, amt, 1000, 0, 0x0FFFFFFFFFFFFFFF, 4)},
+	})
+}
+
+func TestMsgCreateEscrow_ValidateBasic(t *testing.T) {
+	buyer := sdk.AccAddress([]byte("buyer"))
+	seller := sdk.AccAddress([]byte("seller"))
+	arbiter := sdk.AccAddress([]byte("arbiter"))
+	amt := dex.NewCetCoins(100)
+	fee := dex.NewCetCoins(1)
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgCreateEscrow(buyer, seller, arbiter, amt, fee, 1000, EscrowRefund)},
+		{Valid: true, Msg: NewMsgCreateEscrow(buyer, seller, arbiter, amt, nil, 1000, EscrowRelease)},
+		{Valid: false, Msg: NewMsgCreateEscrow(nil, seller, arbiter, amt, fee, 1000, EscrowRefund)},
+		{Valid: false, Msg: NewMsgCreateEscrow(buyer, buyer, arbiter, amt, fee, 1000, EscrowRefund)},
+		{Valid: false, Msg: NewMsgCreateEscrow(buyer, seller, seller, amt, fee, 1000, EscrowRefund)},
+		{Valid: false, Msg: NewMsgCreateEscrow(buyer, seller, arbiter, nil, fee, 1000, EscrowRefund)},
+		{Valid: false, Msg: NewMsgCreateEscrow(buyer, seller, arbiter, amt, fee, 0, EscrowRefund)},
+		{Valid: false, Msg: NewMsgCreateEscrow(buyer, seller, arbiter, amt, fee, 1000, EscrowSplit)},
+	})
+}
+
+func TestMsgResolveEscrow_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgResolveEscrow(sender, 1, EscrowRelease, nil)},
+		{Valid: true, Msg: NewMsgResolveEscrow(sender, 1, EscrowSplit, dex.NewCetCoins(1))},
+		{Valid: false, Msg: NewMsgResolveEscrow(nil, 1, EscrowRelease, nil)},
+		{Valid: false, Msg: NewMsgResolveEscrow(sender, 1, EscrowRefund, dex.NewCetCoins(1))},
+		{Valid: false, Msg: NewMsgResolveEscrow(sender, 1, 3, nil)},
+	})
+}
+
+func TestMsgHTLC_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := dex.NewCetCoins(100)
+	secret := []byte("secret")
+	hashLock := HashSecret(secret)
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgCreateHTLC(sender, recipient, amt, hashLock, 1000)},
+		{Valid: false, Msg: NewMsgCreateHTLC(nil, recipient, amt, hashLock, 1000)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, nil, amt, hashLock, 1000)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, nil, hashLock, 1000)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amt, secret, 1000)},
+		{Valid: false, Msg: NewMsgCreateHTLC(sender, recipient, amt, hashLock, 0)},
+		{Valid: true, Msg: NewMsgClaimHTLC(sender, hashLock, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(nil, hashLock, secret)},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, hashLock, []byte("wrong"))},
+		{Valid: false, Msg: NewMsgClaimHTLC(sender, hashLock, nil)},
+	})
+}
+
+func TestMsgRecurringPayment_ValidateBasic(t *testing.T) {
+	payer := sdk.AccAddress([]byte("payer"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := dex.NewCetCoins(100)
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 3600, 12, 0)},
+		{Valid: true, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 3600, 0, 2000)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(nil, recipient, amt, 1000, 3600, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, payer, amt, 1000, 3600, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, nil, 1000, 3600, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 0, 3600, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 0, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 3599, 12, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 3600, 0, 0)},
+		{Valid: false, Msg: NewMsgCreateRecurringPayment(payer, recipient, amt, 1000, 3600, 0, 999)},
+		{Valid: true, Msg: NewMsgCancelRecurringPayment(payer, 1)},
+		{Valid: false, Msg: NewMsgCancelRecurringPayment(nil, 1)},
+	})
+}
+
+func TestMsgAllowance_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	spender := sdk.AccAddress([]byte("spender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgApprove(owner, spender, dex.NewCetCoin(100), 0)},
+		{Valid: true, Msg: NewMsgApprove(owner, spender, dex.NewCetCoin(0), 1000)},
+		{Valid: false, Msg: NewMsgApprove(nil, spender, dex.NewCetCoin(100), 0)},
+		{Valid: false, Msg: NewMsgApprove(owner, owner, dex.NewCetCoin(100), 0)},
+		{Valid: false, Msg: NewMsgApprove(owner, spender, sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-1)}, 0)},
+		{Valid: false, Msg: NewMsgApprove(owner, spender, dex.NewCetCoin(100), -1)},
+		{Valid: true, Msg: NewMsgTransferFrom(spender, owner, recipient, dex.NewCetCoins(100))},
+		{Valid: false, Msg: NewMsgTransferFrom(spender, owner, nil, dex.NewCetCoins(100))},
+		{Valid: false, Msg: NewMsgTransferFrom(owner, owner, recipient, dex.NewCetCoins(100))},
+		{Valid: false, Msg: NewMsgTransferFrom(spender, owner, recipient, nil)},
+	})
+}
+
+func TestMsgTransferRestrictions_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	sender := sdk.AccAddress([]byte("sender"))
+
+	testutil.ValidateBasic(t, []testutil.TestCase{
+		{Valid: true, Msg: NewMsgSetTransferRestrictions(addr, nil, nil, nil, 0)},
+		{Valid: true, Msg: NewMsgSetTransferRestrictions(addr, []sdk.AccAddress{sender}, dex.NewCetCoins(100), dex.NewCetCoins(10), 3600)},
+		{Valid: false, Msg: NewMsgSetTransferRestrictions(nil, nil, nil, nil, 0)},
+		{Valid: false, Msg: NewMsgSetTransferRestrictions(addr, []sdk.AccAddress{nil}, nil, nil, 0)},
+		{Valid: false, Msg: NewMsgSetTransferRestrictions(addr, nil, sdk.Coins{sdk.Coin{Denom: "cet", Amount: sdk.NewInt(-1)}}, nil, 0)},
+		{Valid: false, Msg: NewMsgSetTransferRestrictions(addr, nil, nil, dex.NewCetCoins(10), 0)},
+		{Valid: false, Msg: NewMsgSetTransferRestrictions(addr, nil, nil, nil, -1)},
+		{Valid: true, Msg: NewMsgCancelDelayedTransfer(sender, 1)},
+		{Valid: false, Msg: NewMsgCancelDelayedTransfer(nil, 1)},
+		{Valid: true, Msg: NewMsgCancelTransferRestrictions(addr)},
+		{Valid: false, Msg: NewMsgCancelTransferRestrictions(nil)},
+	})
+}
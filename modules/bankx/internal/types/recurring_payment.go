@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinRecurringPaymentInterval is the minimum interval in seconds between two payments
+	MinRecurringPaymentInterval = 3600
+	// MaxRecurringPaymentsPerPayer is the maximum number of the active payments of a payer
+	MaxRecurringPaymentsPerPayer = 16
+	// MaxRecurringPaymentFailures is the number of consecutive skipped payments to cancel a recurring payment
+	MaxRecurringPaymentFailures = 3
+	// MaxRecurringPaymentsPerBlock is the maximum number of due payments made in a block, the others wait for the next block
+	MaxRecurringPaymentsPerBlock = 256
+)
+
+// RecurringPayment sends Amount from the payer to the recipient every Interval seconds from
+// NextTime on, until RemainingCount payments are made or EndTime is passed. A zero
+// RemainingCount or EndTime means no such limit, but at least one of them must be set.
+// It is cancelled after MaxRecurringPaymentFailures consecutive payments are skipped.
+type RecurringPayment struct {
+	ID             uint64         `json:"id"`
+	Payer          sdk.AccAddress `json:"payer"`
+	Recipient      sdk.AccAddress `json:"recipient"`
+	Amount         sdk.Coins      `json:"amount"`
+	Interval       int64          `json:"interval"`
+	NextTime       int64          `json:"next_time"`
+	RemainingCount int64          `json:"remaining_count"`
+	EndTime        int64          `json:"end_time"`
+	FailureCount   int64          `json:"failure_count"`
+}
+
+func NewRecurringPayment(payer, recipient sdk.AccAddress, amount sdk.Coins, startTime, interval, count, endTime int64) RecurringPayment {
+	return RecurringPayment{
+		Payer:          payer,
+		Recipient:      recipient,
+		Amount:         amount,
+		Interval:       interval,
+		NextTime:       startTime,
+		RemainingCount: count,
+		EndTime:        endTime,
+	}
+}
+
+func (p RecurringPayment) Validate() sdk.Error {
+	if p.Payer.Empty() || p.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing payer or recipient address")
+	}
+	if p.Payer.Equals(p.Recipient) {
+		return ErrInvalidRecurringPayment("payer and recipient must be different")
+	}
+	if !p.Amount.IsValid() || !p.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins("payment amount is invalid: " + p.Amount.String())
+	}
+	maxTime := math.MaxInt64 / int64(time.Second)
+	if p.Interval < MinRecurringPaymentInterval || p.Interval > maxTime {
+		return ErrInvalidRecurringPayment(fmt.Sprintf("interval must be at least %d seconds", MinRecurringPaymentInterval))
+	}
+	if p.NextTime <= 0 || p.NextTime > maxTime {
+		return ErrInvalidRecurringPayment("invalid start time")
+	}
+	if p.RemainingCount < 0 || p.EndTime < 0 || p.EndTime > maxTime {
+		return ErrInvalidRecurringPayment("invalid count or end time")
+	}
+	if p.RemainingCount == 0 && p.EndTime == 0 {
+		return ErrInvalidRecurringPayment("count or end time must be set")
+	}
+	if p.EndTime != 0 && p.EndTime < p.NextTime {
+		return ErrInvalidRecurringPayment("end time must not be earlier than start time")
+	}
+	if p.FailureCount < 0 || p.FailureCount >= MaxRecurringPaymentFailures {
+		return ErrInvalidRecurringPayment("invalid failure count")
+	}
+	return nil
+}
+
+// Advance moves the payment to its next time and returns whether it is finished,
+// executed tells whether the due payment is made or skipped
+func (p *RecurringPayment) Advance(executed bool) bool {
+	if executed {
+		p.FailureCount = 0
+	} else {
+		p.FailureCount++
+		if p.FailureCount >= MaxRecurringPaymentFailures {
+			return true
+		}
+	}
+	if p.RemainingCount > 0 {
+		p.RemainingCount--
+		if p.RemainingCount == 0 {
+			return true
+		}
+	}
+	if p.NextTime > math.MaxInt64/int64(time.Second)-p.Interval {
+		return true
+	}
+	p.NextTime += p.Interval
+	return p.EndTime != 0 && p.NextTime > p.EndTime
+}
+
+func (p RecurringPayment) String() string {
+	return fmt.Sprintf(`RecurringPayment %d:
+  Payer:          %s
+  Recipient:      %s
+  Amount:         %s
+  Interval:       %d
+  NextTime:       %d
+  RemainingCount: %d
+  EndTime:        %d
+  FailureCount:   %d`,
+		p.ID, p.Payer, p.Recipient, p.Amount, p.Interval, p.NextTime, p.RemainingCount, p.EndTime, p.FailureCount)
+}
+
+// RecurringPaymentExecution records a due payment, which is skipped with Reason
+// when the payer can not pay it. The payment is Finished after its last time or
+// when it is cancelled for too many consecutive skips.
+type RecurringPaymentExecution struct {
+	ID        uint64         `json:"id"`
+	Payer     sdk.AccAddress `json:"payer"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    sdk.Coins      `json:"amount"`
+	Executed  bool           `json:"executed"`
+	Reason    string         `json:"reason,omitempty"`
+	Finished  bool           `json:"finished"`
+	Height    int64          `json:"height"`
+}
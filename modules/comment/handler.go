@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/coinexchain/cet-sdk/modules/comment/internal/types"
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	dex "github.com/coinexchain/cet-sdk/types"
 )
 
@@ -20,6 +21,11 @@ func NewHandler(k Keeper) sdk.Handler {
 	}
 }
 
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register(types.TokenCommentKey, 1, types.TokenComment{})
+}
+
 func handleMsgCommentToken(ctx sdk.Context, k Keeper, msg types.MsgCommentToken) sdk.Result {
 	if !k.IsTokenExists(ctx, msg.Token) {
 		return types.ErrNoSuchAsset().Result()
@@ -24,18 +24,22 @@ const (
 )
 
 var (
-	NewBaseKeeper       = keepers.NewKeeper
-	DefaultParams       = types.DefaultParams
-	DecToBigEndianBytes = types.DecToBigEndianBytes
-	ValidateOrderID     = types.ValidateOrderID
-	IsValidTradingPair  = types.IsValidTradingPair
-	ModuleCdc           = types.ModuleCdc
-	GetSymbol           = dex.GetSymbol
-	SplitSymbol         = dex.SplitSymbol
+	NewBaseKeeper           = keepers.NewKeeper
+	PrometheusMetrics       = keepers.PrometheusMetrics
+	NopMetrics              = keepers.NopMetrics
+	DefaultParams           = types.DefaultParams
+	DecToBigEndianBytes     = types.DecToBigEndianBytes
+	ValidateOrderID         = types.ValidateOrderID
+	IsValidTradingPair      = types.IsValidTradingPair
+	ModuleCdc               = types.ModuleCdc
+	RegisterMsgQueueSchemas = types.RegisterMsgQueueSchemas
+	GetSymbol               = dex.GetSymbol
+	SplitSymbol             = dex.SplitSymbol
 )
 
 type (
 	Keeper                  = keepers.Keeper
+	Metrics                 = keepers.Metrics
 	Order                   = types.Order
 	MarketInfo              = types.MarketInfo
 	Params                  = types.Params
@@ -22,6 +22,7 @@ type InfoForDeal struct {
 	changedOrders map[string]*types.Order
 	lastPrice     sdk.Dec
 	context       sdk.Context
+	deals         int
 }
 
 // returns true when a buyer's frozen money is not enough to buy LeftStock.
@@ -99,9 +100,9 @@ func (wo *WrappedOrder) Deal(otherSide match.OrderForTrade, amount int64, price
 	ctx := wo.infoForDeal.context
 	// exchange the coins
 	wo.infoForDeal.bxKeeper.UnFreezeCoins(ctx, seller.Sender, stockCoins)
-	wo.infoForDeal.bxKeeper.SendCoins(ctx, seller.Sender, buyer.Sender, stockCoins)
+	wo.infoForDeal.bxKeeper.SendCoinsUnrestricted(ctx, seller.Sender, buyer.Sender, stockCoins)
 	wo.infoForDeal.bxKeeper.UnFreezeCoins(ctx, buyer.Sender, moneyCoins)
-	wo.infoForDeal.bxKeeper.SendCoins(ctx, buyer.Sender, seller.Sender, moneyCoins)
+	wo.infoForDeal.bxKeeper.SendCoinsUnrestricted(ctx, buyer.Sender, seller.Sender, moneyCoins)
 
 	// record the changed orders for further processing
 	wo.infoForDeal.changedOrders[buyer.OrderID()] = buyer
@@ -109,6 +110,7 @@ func (wo *WrappedOrder) Deal(otherSide match.OrderForTrade, amount int64, price
 
 	// record the last executed price, which will be stored in MarketInfo
 	wo.infoForDeal.lastPrice = price
+	wo.infoForDeal.deals++
 
 	if wo.infoForDeal.msgSender.IsSubscribed(types.Topic) {
 		SendFillMsg(ctx, seller, buyer, amount, moneyAmountInt64, price, ctx.BlockHeight())
@@ -198,19 +200,22 @@ func chargeOrderFeatureFee(ctx sdk.Context, order *types.Order, freeTimeBlocks i
 }
 
 func chargeFee(ctx sdk.Context, fee int64, userAddr sdk.AccAddress, keeper types.Keeper) {
-	var (
-		rebateAmount int64
-		refereeAddr  sdk.AccAddress
-	)
-	refereeAddr = keeper.GetRefereeAddr(ctx, userAddr)
-	if refereeAddr != nil {
-		rebateAmount = calRebateAmount(ctx, fee, keeper)
+	referees := keeper.GetReferralChain(ctx, userAddr)
+	ratios := keeper.GetRebateRatios(ctx)
+	ratioBase := keeper.GetRebateRatioBase(ctx)
+	total := fee
+	for i, refereeAddr := range referees {
+		rebateAmount := sdk.NewInt(total).MulRaw(ratios[i]).QuoRaw(ratioBase).Int64()
+		if rebateAmount <= 0 {
+			continue
+		}
 		fee -= rebateAmount
-	}
-	if rebateAmount > 0 {
-		if err := keeper.SendCoins(ctx, userAddr, refereeAddr, dex.NewCetCoins(rebateAmount)); err != nil {
+		rebate := dex.NewCetCoins(rebateAmount)
+		if err := keeper.SendCoinsUnrestricted(ctx, userAddr, refereeAddr, rebate); err != nil {
 			ctx.Logger().Error("%s", err.Error())
+			continue
 		}
+		keeper.AddRebateEarned(ctx, refereeAddr, rebate)
 	}
 	if err := keeper.SubtractFeeAndCollectFee(ctx, userAddr, fee); err != nil {
 		//should not reach this clause in production
@@ -237,7 +242,15 @@ func filterCandidates(ctx sdk.Context, asKeeper types.ExpectedAssetStatusKeeper,
 	return ordersOut
 }
 
-func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, keeper keepers.Keeper, dataHash []byte, currHeight int64) (map[string]*types.Order, sdk.Dec) {
+func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, keeper keepers.Keeper, dataHash []byte, currHeight int64, stats *matchStats) (map[string]*types.Order, sdk.Dec) {
+	start := time.Now()
+	defer func() {
+		seconds := time.Since(start).Seconds()
+		keeper.GetMetrics().MarketMatchTime.Observe(seconds)
+		stats.seconds += seconds
+		stats.markets++
+	}()
+
 	orderKeeper := keepers.NewOrderKeeper(keeper.GetMarketKey(), symbol, types.ModuleCdc)
 	asKeeper := keeper.GetAssetKeeper()
 	bxKeeper := keeper.GetBankxKeeper()
@@ -274,6 +287,8 @@ func runMatch(ctx sdk.Context, midPrice sdk.Dec, ratio int64, symbol string, kee
 	}
 	// call the match engine
 	match.Match(highPrice, midPrice, lowPrice, bidList, askList)
+	stats.orders += len(infoForDeal.changedOrders)
+	stats.deals += infoForDeal.deals
 
 	// both dealt orders and IOC order need further processing
 	ordersForUpdate := infoForDeal.changedOrders
@@ -335,6 +350,8 @@ func removeExpiredMarket(ctx sdk.Context, keeper keepers.Keeper, marketParams *t
 }
 
 func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
+	var stats matchStats
+	defer stats.report(keeper.GetMetrics())
 	marketParams := keeper.GetParams(ctx)
 
 	chainID := ctx.ChainID()
@@ -385,7 +402,7 @@ func EndBlocker(ctx sdk.Context, keeper keepers.Keeper) /*sdk.Tags*/ {
 		symbol := mi.GetSymbol()
 		dataHash := ctx.BlockHeader().DataHash
 		ratio := marketParams.MaxExecutedPriceChangeRatio
-		oUpdate, newPrice := runMatch(ctx, mi.LastExecutedPrice, ratio, symbol, keeper, dataHash, currHeight)
+		oUpdate, newPrice := runMatch(ctx, mi.LastExecutedPrice, ratio, symbol, keeper, dataHash, currHeight, &stats)
 		newPrices[idx] = newPrice
 		ordersForUpdateList[idx] = oUpdate
 	}
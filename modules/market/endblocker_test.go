@@ -246,7 +246,13 @@ func TestDelist(t *testing.T) {
 	orders[9].TradingPair = "bsv/usdt"
 	cetKeeper.Add(ctx, orders[9])
 
+	testMetrics := newTestMetrics()
+	keeper.SetMetrics(testMetrics)
 	EndBlocker(ctx, keeper)
+	require.EqualValues(t, 2, testMetrics.MarketsProcessed.(*testGauge).value)
+	require.EqualValues(t, 6, testMetrics.OrdersMatched.(*testGauge).value)
+	require.EqualValues(t, 4, testMetrics.Deals.(*testGauge).value)
+	require.True(t, testMetrics.MatchTime.(*testGauge).value > 0)
 	gKeeper := keepers.NewGlobalOrderKeeper(keys.marketKey, msgCdc)
 	allOrders := gKeeper.GetAllOrders(ctx)
 	subList := []int{4, 6, 9, 8}
@@ -1055,4 +1061,12 @@ func TestChargeFee(t *testing.T) {
 	require.EqualValues(t, fmt.Sprintf("addr : %s, fee : %d", from, 9900), keeper.records[1])
 	keeper.cleanRecord()
 
+	// 1% to the referee and 0.5% to the referee's referee
+	keeper.rebateRatios = []int64{100, 50}
+	chain := keeper.GetReferralChain(ctx, from)
+	chargeFee(ctx, 10000, from, keeper)
+	require.EqualValues(t, fmt.Sprintf("send 100 cet from %s to %s", from.String(), chain[0].String()), keeper.records[0])
+	require.EqualValues(t, fmt.Sprintf("send 50 cet from %s to %s", from.String(), chain[1].String()), keeper.records[1])
+	require.EqualValues(t, fmt.Sprintf("addr : %s, fee : %d", from, 9850), keeper.records[2])
+	keeper.cleanRecord()
 }
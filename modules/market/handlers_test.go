@@ -157,8 +157,9 @@ func prepareAssetKeeper(t *testing.T, keys storeKeys, cdc *codec.Codec, ctx sdk.
 		keys.assetCapKey,
 	)
 	bkx := bankx.NewKeeper(
+		cdc, sdk.NewKVStoreKey(bankx.StoreKey),
 		params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(bankx.DefaultParamspace),
-		axk, bk, ak, ask,
+		axk, bk, ak, keys.authCapKey, ask,
 		sk,
 		msgqueue.NewProducer(nil),
 	)
@@ -168,6 +169,8 @@ func prepareAssetKeeper(t *testing.T, keys storeKeys, cdc *codec.Codec, ctx sdk.
 		params.NewKeeper(cdc, keys.keyParams, keys.tkeyParams, params.DefaultCodespace).Subspace(asset.DefaultParamspace),
 		bkx,
 		sk,
+		sk,
+		msgqueue.NewProducer(nil),
 	)
 	tk.SetParams(ctx, asset.DefaultParams())
 
@@ -241,7 +244,7 @@ func prepareBankxKeeper(keys storeKeys, cdc *codec.Codec, ctx sdk.Context) types
 
 	axk := authx.NewKeeper(cdc, keys.authxKey, paramsKeeper.Subspace(authx.DefaultParamspace), sk, ak, bk, "")
 	ask := asset.NewBaseTokenKeeper(cdc, keys.assetCapKey)
-	bxkKeeper := bankx.NewKeeper(paramsKeeper.Subspace("bankx"), axk, bk, ak, ask, sk, producer)
+	bxkKeeper := bankx.NewKeeper(cdc, sdk.NewKVStoreKey(bankx.StoreKey), paramsKeeper.Subspace("bankx"), axk, bk, ak, keys.authCapKey, ask, sk, producer)
 	bk.SetSendEnabled(ctx, true)
 	bxkKeeper.SetParams(ctx, bankx.DefaultParams())
 
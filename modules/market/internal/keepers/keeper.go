@@ -31,6 +31,7 @@ type Keeper struct {
 	msgProducer   msgqueue.MsgSender
 	ak            auth.AccountKeeper
 	authX         types.ExpectedAuthXKeeper
+	metrics       *Metrics
 }
 
 func NewKeeper(key sdk.StoreKey, axkVal types.ExpectedAssetStatusKeeper,
@@ -49,9 +50,19 @@ func NewKeeper(key sdk.StoreKey, axkVal types.ExpectedAssetStatusKeeper,
 		msgProducer:   msgKeeperVal,
 		ak:            ak,
 		authX:         authX,
+		metrics:       NopMetrics(),
 	}
 }
 
+// SetMetrics sets the metrics which EndBlocker reports the matching stats to.
+func (k *Keeper) SetMetrics(m *Metrics) {
+	k.metrics = m
+}
+
+func (k Keeper) GetMetrics() *Metrics {
+	return k.metrics
+}
+
 func (k Keeper) GetMarketsWithNewlyAddedOrder(ctx sdk.Context) []string {
 	store := ctx.KVStore(k.marketKey)
 	iter := store.Iterator(NewlyAddedKeyPrefix, NewlyAddedKeyEnd)
@@ -92,8 +103,8 @@ func (k Keeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int6
 	return k.bnk.DeductInt64CetFee(ctx, addr, amt)
 }
 
-func (k Keeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	return k.bnk.SendCoins(ctx, from, to, amt)
+func (k Keeper) SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return k.bnk.SendCoinsUnrestricted(ctx, from, to, amt)
 }
 
 func (k Keeper) UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error {
@@ -160,6 +171,18 @@ func (k Keeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return k.authX.GetRebateRatioBase(ctx)
 }
 
+func (k Keeper) GetRebateRatios(ctx sdk.Context) []int64 {
+	return k.authX.GetRebateRatios(ctx)
+}
+
+func (k Keeper) GetReferralChain(ctx sdk.Context, accAddr sdk.AccAddress) []sdk.AccAddress {
+	return k.authX.GetReferralChain(ctx, accAddr)
+}
+
+func (k Keeper) AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins) {
+	k.authX.AddRebateEarned(ctx, referee, amt)
+}
+
 // -----------------------------------------------------------------------------
 // Params
 
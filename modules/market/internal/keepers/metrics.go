@@ -0,0 +1,82 @@
+package keepers
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "market"
+)
+
+// Metrics contains the matching stats of the last block handled by EndBlocker.
+type Metrics struct {
+	// Number of markets matched.
+	MarketsProcessed metrics.Gauge
+	// Number of orders which got deals.
+	OrdersMatched metrics.Gauge
+	// Number of deals.
+	Deals metrics.Gauge
+	// Time spent in runMatch for all the markets, in seconds.
+	MatchTime metrics.Gauge
+	// Histogram of the time spent in runMatch for one market.
+	MarketMatchTime metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		MarketsProcessed: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "markets_processed",
+			Help:      "Number of markets matched in the last block.",
+		}, labels).With(labelsAndValues...),
+		OrdersMatched: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "orders_matched",
+			Help:      "Number of orders which got deals in the last block.",
+		}, labels).With(labelsAndValues...),
+		Deals: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "deals",
+			Help:      "Number of deals in the last block.",
+		}, labels).With(labelsAndValues...),
+		MatchTime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "match_time_seconds",
+			Help:      "Time spent matching the orders of the last block.",
+		}, labels).With(labelsAndValues...),
+		MarketMatchTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "market_match_time_seconds",
+			Help:      "Time spent matching the orders of one market.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		MarketsProcessed: discard.NewGauge(),
+		OrdersMatched:    discard.NewGauge(),
+		Deals:            discard.NewGauge(),
+		MatchTime:        discard.NewGauge(),
+		MarketMatchTime:  discard.NewHistogram(),
+	}
+}
@@ -16,10 +16,10 @@ type Keeper interface {
 type ExpectedBankxKeeper interface {
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt int64) sdk.Error
-	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool                          // to check whether have sufficient coins in special address
-	SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error // to tranfer coins
-	FreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                   // freeze some coins when creating orders
-	UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                 // unfreeze coins and then orders can be executed
+	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool                                      // to check whether have sufficient coins in special address
+	SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error // to settle deals and pay rebates
+	FreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                               // freeze some coins when creating orders
+	UnFreezeCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) sdk.Error                             // unfreeze coins and then orders can be executed
 }
 
 // Asset Keeper will implement the interface
@@ -39,4 +39,7 @@ type ExpectedAuthXKeeper interface {
 	GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress
 	GetRebateRatio(ctx sdk.Context) int64
 	GetRebateRatioBase(ctx sdk.Context) int64
+	GetRebateRatios(ctx sdk.Context) []int64
+	GetReferralChain(ctx sdk.Context, accAddr sdk.AccAddress) []sdk.AccAddress
+	AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins)
 }
@@ -2,8 +2,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 )
 
+// RegisterMsgQueueSchemas registers the payloads this module sends to msgqueue
+func RegisterMsgQueueSchemas(reg *msgcodec.Registry) {
+	reg.Register(CreateMarketInfoKey, 1, MsgCreateTradingPair{})
+	reg.Register(CreateOrderInfoKey, 1, CreateOrderInfo{})
+	reg.Register(FillOrderInfoKey, 1, FillOrderInfo{})
+	reg.Register(CancelOrderInfoKey, 1, CancelOrderInfo{})
+}
+
 type CreateMarketInfo struct {
 	Stock          string `json:"stock"`
 	Money          string `json:"money"`
@@ -0,0 +1,20 @@
+package market
+
+import (
+	"github.com/coinexchain/cet-sdk/modules/market/internal/keepers"
+)
+
+// matchStats accumulates the stats of a block before they are reported
+type matchStats struct {
+	markets int
+	orders  int
+	deals   int
+	seconds float64
+}
+
+func (s *matchStats) report(m *keepers.Metrics) {
+	m.MarketsProcessed.Set(float64(s.markets))
+	m.OrdersMatched.Set(float64(s.orders))
+	m.Deals.Set(float64(s.deals))
+	m.MatchTime.Set(s.seconds)
+}
@@ -0,0 +1,24 @@
+package market
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+)
+
+type testGauge struct {
+	value float64
+}
+
+func (g *testGauge) With(labelValues ...string) metrics.Gauge { return g }
+func (g *testGauge) Set(value float64)                        { g.value = value }
+func (g *testGauge) Add(delta float64)                        { g.value += delta }
+
+func newTestMetrics() *Metrics {
+	return &Metrics{
+		MarketsProcessed: &testGauge{},
+		OrdersMatched:    &testGauge{},
+		Deals:            &testGauge{},
+		MatchTime:        &testGauge{},
+		MarketMatchTime:  discard.NewHistogram(),
+	}
+}
@@ -10,7 +10,8 @@ import (
 )
 
 type mockKeeper struct {
-	records []string
+	records      []string
+	rebateRatios []int64
 }
 
 func (k *mockKeeper) GetRefereeAddr(ctx sdk.Context, accAddr sdk.AccAddress) sdk.AccAddress {
@@ -26,6 +27,25 @@ func (k *mockKeeper) GetRebateRatio(ctx sdk.Context) int64 {
 func (k *mockKeeper) GetRebateRatioBase(ctx sdk.Context) int64 {
 	return 10000
 }
+func (k *mockKeeper) GetRebateRatios(ctx sdk.Context) []int64 {
+	if len(k.rebateRatios) == 0 {
+		return []int64{k.GetRebateRatio(ctx)}
+	}
+	return k.rebateRatios
+}
+func (k *mockKeeper) GetReferralChain(ctx sdk.Context, accAddr sdk.AccAddress) []sdk.AccAddress {
+	chain := []sdk.AccAddress{k.GetRefereeAddr(ctx, accAddr)}
+	for len(chain) < len(k.GetRebateRatios(ctx)) {
+		addr, err := sdk.AccAddressFromHex(fmt.Sprintf("01234567890123456789012345678901234000%02d", 12+len(chain)))
+		if err != nil {
+			panic("generate address failed")
+		}
+		chain = append(chain, addr)
+	}
+	return chain
+}
+func (k *mockKeeper) AddRebateEarned(ctx sdk.Context, referee sdk.AccAddress, amt sdk.Coins) {
+}
 func (k *mockKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	panic("implement me")
 }
@@ -35,7 +55,7 @@ func (k *mockKeeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress, amt
 func (k *mockKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
 	panic("implement me")
 }
-func (k *mockKeeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+func (k *mockKeeper) SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	k.records = append(k.records, fmt.Sprintf("send %s %s from %s to %s",
 		amt[0].Amount.String(), amt[0].Denom, from.String(), to.String()))
 	return nil
@@ -100,7 +120,7 @@ func (k *mocBankxKeeper) DeductInt64CetFee(ctx sdk.Context, addr sdk.AccAddress,
 func (k *mocBankxKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
 	return true
 }
-func (k *mocBankxKeeper) SendCoins(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
+func (k *mocBankxKeeper) SendCoinsUnrestricted(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, amt sdk.Coins) sdk.Error {
 	k.records = append(k.records, fmt.Sprintf("send %s %s from %s to %s",
 		amt[0].Amount.String(), amt[0].Denom, from.String(), to.String()))
 	return nil
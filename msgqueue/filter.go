@@ -0,0 +1,110 @@
+package msgqueue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// A filter expression selects the messages sent to a writer, it's made of
+// clauses joined by '&', all of which must match:
+//
+//	key:fill_order_info|del_order_info   the key is one of the values
+//	addr:coinex1...|coinex1...           the payload mentions one of the addresses
+//	trading_pair:abc/cet                 the top-level JSON field equals one of the values
+//
+// e.g. kafka:broker1;filter=key:fill_order_info&trading_pair:abc/cet
+// The height_info messages always match, dir and prune writers rely on them.
+
+const (
+	FilterClauseKey  = "key"
+	FilterClauseAddr = "addr"
+)
+
+var _ MsgWriter = filterMsgWriter{}
+
+type filterClause struct {
+	name   string
+	values []string
+}
+
+type MsgFilter struct {
+	clauses []filterClause
+}
+
+func ParseMsgFilter(expr string) (*MsgFilter, error) {
+	f := &MsgFilter{}
+	for _, clause := range strings.Split(expr, "&") {
+		nv := strings.SplitN(clause, ":", 2)
+		if len(nv) != 2 || len(nv[0]) == 0 || len(nv[1]) == 0 {
+			return nil, fmt.Errorf("invalid filter clause: %s", clause)
+		}
+		f.clauses = append(f.clauses, filterClause{name: nv[0], values: strings.Split(nv[1], "|")})
+	}
+	return f, nil
+}
+
+func (f *MsgFilter) Match(k, v []byte) bool {
+	if string(k) == HeightInfoKey {
+		return true
+	}
+	var fields map[string]json.RawMessage
+	for _, c := range f.clauses {
+		switch c.name {
+		case FilterClauseKey:
+			if !c.matchAny(func(val string) bool { return val == string(k) }) {
+				return false
+			}
+		case FilterClauseAddr:
+			if !c.matchAny(func(val string) bool { return bytes.Contains(v, []byte(val)) }) {
+				return false
+			}
+		default:
+			if fields == nil {
+				if err := json.Unmarshal(v, &fields); err != nil {
+					return false
+				}
+			}
+			raw, ok := fields[c.name]
+			if !ok {
+				return false
+			}
+			field := string(raw)
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil {
+				field = s
+			}
+			if !c.matchAny(func(val string) bool { return val == field }) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c filterClause) matchAny(match func(val string) bool) bool {
+	for _, val := range c.values {
+		if match(val) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterMsgWriter drops the messages which don't match its filter.
+type filterMsgWriter struct {
+	MsgWriter
+	filter *MsgFilter
+}
+
+func NewFilterMsgWriter(w MsgWriter, filter *MsgFilter) MsgWriter {
+	return filterMsgWriter{MsgWriter: w, filter: filter}
+}
+
+func (w filterMsgWriter) WriteKV(k, v []byte) error {
+	if !w.filter.Match(k, v) {
+		return nil
+	}
+	return w.MsgWriter.WriteKV(k, v)
+}
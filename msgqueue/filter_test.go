@@ -0,0 +1,56 @@
+package msgqueue
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMsgFilter(t *testing.T) {
+	_, err := ParseMsgFilter("key")
+	require.Error(t, err)
+	_, err = ParseMsgFilter("key:")
+	require.Error(t, err)
+	_, err = ParseMsgFilter("key:a&:b")
+	require.Error(t, err)
+
+	f, err := ParseMsgFilter("key:fill_order_info|del_order_info&trading_pair:abc/cet")
+	require.NoError(t, err)
+	require.True(t, f.Match([]byte("fill_order_info"), []byte(`{"trading_pair":"abc/cet","height":3}`)))
+	require.True(t, f.Match([]byte("del_order_info"), []byte(`{"trading_pair":"abc/cet"}`)))
+	require.False(t, f.Match([]byte("fill_order_info"), []byte(`{"trading_pair":"xyz/cet"}`)))
+	require.False(t, f.Match([]byte("fill_order_info"), []byte(`{"height":3}`)))
+	require.False(t, f.Match([]byte("create_order_info"), []byte(`{"trading_pair":"abc/cet"}`)))
+	require.False(t, f.Match([]byte("fill_order_info"), []byte(`not json`)))
+	require.True(t, f.Match([]byte(HeightInfoKey), []byte(`{"height":3}`)))
+
+	f, err = ParseMsgFilter("height:3|4")
+	require.NoError(t, err)
+	require.True(t, f.Match([]byte("fill_order_info"), []byte(`{"height":4}`)))
+	require.False(t, f.Match([]byte("fill_order_info"), []byte(`{"height":5}`)))
+
+	f, err = ParseMsgFilter("addr:coinex1aaa|coinex1bbb")
+	require.NoError(t, err)
+	require.True(t, f.Match([]byte("send_lock_coins"), []byte(`{"from_address":"coinex1bbb"}`)))
+	require.True(t, f.Match([]byte("fill_order_info"), []byte(`{"order_id":"coinex1aaa-12"}`)))
+	require.False(t, f.Match([]byte("fill_order_info"), []byte(`{"order_id":"coinex1ccc-12"}`)))
+}
+
+func TestFilterMsgWriter(t *testing.T) {
+	defer os.Remove("messages.txt")
+	_, err := createMsgWriter("file:messages.txt;filter=key")
+	require.Error(t, err)
+
+	w, err := createMsgWriter("file:messages.txt;filter=key:fill_order_info")
+	require.NoError(t, err)
+	require.Equal(t, "file", w.String())
+	require.NoError(t, w.WriteKV([]byte("fill_order_info"), []byte("{}")))
+	require.NoError(t, w.WriteKV([]byte("del_order_info"), []byte("{}")))
+	require.NoError(t, w.Close())
+
+	data, err := ioutil.ReadFile("messages.txt")
+	require.NoError(t, err)
+	require.Equal(t, "fill_order_info#{}\r\n", string(data))
+}
@@ -0,0 +1,88 @@
+package msgqueue
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "msgqueue"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of messages written, labeled by key and writer.
+	MsgsWritten metrics.Counter
+	// Number of messages dropped by the filter of a writer, labeled by writer.
+	MsgsFiltered metrics.Counter
+	// Histogram of the time spent writing a message, retries included, labeled by writer.
+	WriteLatency metrics.Histogram
+	// Number of retried writes, labeled by writer.
+	WriteRetries metrics.Counter
+	// Number of backup files kept in the directory of a dir or prune writer, labeled by dir.
+	SpoolDepth metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		MsgsWritten: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "msgs_written",
+			Help:      "Number of messages written.",
+		}, append(labels, "key", "writer")).With(labelsAndValues...),
+		MsgsFiltered: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "msgs_filtered",
+			Help:      "Number of messages dropped by the filter of a writer.",
+		}, append(labels, "writer")).With(labelsAndValues...),
+		WriteLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "write_latency_seconds",
+			Help:      "Time spent writing a message, retries included.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 4, 10),
+		}, append(labels, "writer")).With(labelsAndValues...),
+		WriteRetries: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "write_retries",
+			Help:      "Number of retried writes.",
+		}, append(labels, "writer")).With(labelsAndValues...),
+		SpoolDepth: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "spool_depth",
+			Help:      "Number of backup files kept in the directory of a dir or prune writer.",
+		}, append(labels, "dir")).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		MsgsWritten:  discard.NewCounter(),
+		MsgsFiltered: discard.NewCounter(),
+		WriteLatency: discard.NewHistogram(),
+		WriteRetries: discard.NewCounter(),
+		SpoolDepth:   discard.NewGauge(),
+	}
+}
+
+func (m *Metrics) updateSpoolDepth(dir string) {
+	if indexes, err := GetFileIndexesInDir(dir); err == nil {
+		m.SpoolDepth.With("dir", dir).Set(float64(len(indexes)))
+	}
+}
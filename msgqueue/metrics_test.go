@@ -0,0 +1,69 @@
+package msgqueue
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// testCounter records the values added for every set of labels
+type testCounter struct {
+	values map[string]float64
+	labels []string
+}
+
+func (c *testCounter) With(labelValues ...string) metrics.Counter {
+	return &testCounter{values: c.values, labels: append(c.labels, labelValues...)}
+}
+
+func (c *testCounter) Add(delta float64) {
+	c.values[strings.Join(c.labels, ",")] += delta
+}
+
+type testGauge struct {
+	values map[string]float64
+	labels []string
+}
+
+func (g *testGauge) With(labelValues ...string) metrics.Gauge {
+	return &testGauge{values: g.values, labels: append(g.labels, labelValues...)}
+}
+
+func (g *testGauge) Set(value float64) {
+	g.values[strings.Join(g.labels, ",")] = value
+}
+
+func (g *testGauge) Add(delta float64) {
+	g.values[strings.Join(g.labels, ",")] += delta
+}
+
+func TestProducerMetrics(t *testing.T) {
+	m := NopMetrics()
+	written := &testCounter{values: make(map[string]float64)}
+	spool := &testGauge{values: make(map[string]float64)}
+	filtered := &testCounter{values: make(map[string]float64)}
+	m.MsgsWritten = written
+	m.MsgsFiltered = filtered
+	m.SpoolDepth = spool
+
+	defer os.Remove("messages.txt")
+	defer os.RemoveAll("tmpm")
+	defer os.RemoveAll("tmpp")
+	p := newProducer([]string{"file:messages.txt", "dir:tmpm;filter=key:foo", "prune:tmpp;filter=key:baz"}, "bank", true, nil, m)
+	p.SendMsg([]byte("foo"), []byte("bar"))
+	p.SendMsg([]byte("foo"), []byte("bar"))
+	p.SendMsg([]byte("baz"), []byte("bar"))
+	p.Close()
+
+	require.Equal(t, map[string]float64{
+		"key,foo,writer,file": 2,
+		"key,baz,writer,file": 1,
+		"key,foo,writer,dir":  2,
+		"key,baz,writer,dir":  1,
+	}, written.values)
+	require.Equal(t, map[string]float64{"writer,dir": 3}, filtered.values)
+	require.Equal(t, map[string]float64{"dir,tmpm": 1, "dir,tmpp": 1}, spool.values)
+}
@@ -11,6 +11,9 @@ import (
 
 const FILEHEIGHT = 10000
 
+// HeightInfoKey is the key of the message written at the beginning of every block
+const HeightInfoKey = "height_info"
+
 type NewHeightInfo struct {
 	ChainID       string       `json:"chain_id"`
 	Height        int64        `json:"height"`
@@ -39,9 +42,14 @@ func NewRegulateWriteDir(dir string) (*RegulateWriteDir, error) {
 	return rgw, nil
 }
 
+// SetMetrics sets the metrics which the underlying dir writer reports its spool depth to.
+func (r *RegulateWriteDir) SetMetrics(m *Metrics) {
+	r.MsgWriter.(*dirMsgWriter).SetMetrics(m)
+}
+
 func (r *RegulateWriteDir) timeToNewFile() func(k, v []byte) bool {
 	return func(k, v []byte) bool {
-		if string(k) == ("height_info") {
+		if string(k) == HeightInfoKey {
 			var info NewHeightInfo
 			if err := json.Unmarshal(v, &info); err != nil {
 				panic(fmt.Sprintf("json unmarshal height_info failed; err: %s\n", err.Error()))
@@ -11,10 +11,33 @@ type MsgWriter interface {
 	String() string
 }
 
+// metricsSetter is implemented by the writers which report more metrics than the producer does,
+// e.g. the dir writers report the depth of their spool
+type metricsSetter interface {
+	SetMetrics(m *Metrics)
+}
+
 // kafka:broker1,broker2,broker3
 // file:path/to/file
 // os:stdout
+//
+// Options can be appended to the config, separated by ';':
+// dir:path/to/dir;encoding=amino
+// prune:path/to/dir;compress=zstd;footer=true
+// kafka:broker1;filter=key:fill_order_info&trading_pair:abc/cet
 func createMsgWriter(cfg string) (MsgWriter, error) {
+	cfg, opts, err := parseWriterOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	w, err := createBaseMsgWriter(cfg)
+	if err != nil {
+		return w, err
+	}
+	return wrapMsgWriter(w, opts)
+}
+
+func createBaseMsgWriter(cfg string) (MsgWriter, error) {
 	if cfg == "nop" {
 		return NewNopMsgWriter(), nil
 	} else if strings.HasPrefix(cfg, CfgPrefixKafka) {
@@ -37,3 +60,86 @@ func createMsgWriter(cfg string) (MsgWriter, error) {
 	}
 	return nil, fmt.Errorf("unsupported config: %s", cfg)
 }
+
+func parseWriterOptions(cfg string) (string, map[string]string, error) {
+	parts := strings.Split(cfg, CfgOptionSeparator)
+	opts := make(map[string]string, len(parts)-1)
+	for _, part := range parts[1:] {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			return "", nil, fmt.Errorf("invalid option %s in config: %s", part, cfg)
+		}
+		opts[kv[0]] = kv[1]
+	}
+	return parts[0], opts, nil
+}
+
+func wrapMsgWriter(w MsgWriter, opts map[string]string) (MsgWriter, error) {
+	for opt, val := range opts {
+		var err error
+		switch opt {
+		case CfgOptionEncoding, CfgOptionFilter:
+		case CfgOptionCompress:
+			err = configDirMsgWriter(w, opt, func(dw *dirMsgWriter) error {
+				return dw.SetCompression(val)
+			})
+		case CfgOptionFooter:
+			err = configDirMsgWriter(w, opt, func(dw *dirMsgWriter) error {
+				if val != "true" {
+					return nil
+				}
+				return dw.EnableFooter()
+			})
+		default:
+			err = fmt.Errorf("unsupported option: %s=%s", opt, val)
+		}
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+	}
+	if encoding, ok := opts[CfgOptionEncoding]; ok {
+		switch encoding {
+		case EncodingJSON:
+		case EncodingAmino:
+			w = NewEncodingMsgWriter(w)
+		default:
+			w.Close()
+			return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+		}
+	}
+	// the filter is evaluated on the JSON payloads, before encoding
+	if expr, ok := opts[CfgOptionFilter]; ok {
+		filter, err := ParseMsgFilter(expr)
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+		w = NewFilterMsgWriter(w, filter)
+	}
+	return w, nil
+}
+
+// unwrapMsgWriter returns the writer wrapped by the encoding and filter options
+func unwrapMsgWriter(w MsgWriter) MsgWriter {
+	for {
+		switch ww := w.(type) {
+		case filterMsgWriter:
+			w = ww.MsgWriter
+		case encodingMsgWriter:
+			w = ww.MsgWriter
+		default:
+			return w
+		}
+	}
+}
+
+func configDirMsgWriter(w MsgWriter, opt string, config func(dw *dirMsgWriter) error) error {
+	switch w := unwrapMsgWriter(w).(type) {
+	case *dirMsgWriter:
+		return config(w)
+	case *RegulateWriteDir:
+		return config(w.MsgWriter.(*dirMsgWriter))
+	}
+	return fmt.Errorf("option %s is only supported by dir writers", opt)
+}
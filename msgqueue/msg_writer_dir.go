@@ -2,8 +2,10 @@ package msgqueue
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 )
 
 const (
@@ -22,6 +24,14 @@ type dirMsgWriter struct {
 	fileIndex     int
 	dir           string
 	timeNewFile   func(k, v []byte) bool
+	compression   string
+	stats         *SegmentStats
+	metrics       *Metrics
+
+	// the closed segments are compressed in the background, off the block commit path
+	compressing sync.WaitGroup
+	errMu       sync.Mutex
+	compressErr error
 }
 
 func NewDirMsgWriter(dir string, cb GetFilePathAndFileIndexFromDirCb) (MsgWriter, error) {
@@ -42,6 +52,7 @@ func NewDirMsgWriter(dir string, cb GetFilePathAndFileIndexFromDirCb) (MsgWriter
 		fileIndex:     fileIndex,
 		dir:           dir,
 		haveWriteSize: fileSize,
+		metrics:       NopMetrics(),
 	}
 	diw.timeNewFile = diw.timeToNewFile()
 	return diw, nil
@@ -49,7 +60,7 @@ func NewDirMsgWriter(dir string, cb GetFilePathAndFileIndexFromDirCb) (MsgWriter
 
 func (w *dirMsgWriter) WriteKV(k, v []byte) error {
 	if w.timeNewFile(k, v) {
-		if err := w.Close(); err != nil {
+		if err := w.closeSegment(); err != nil {
 			return err
 		}
 		file, err := openFile(GetFileName(w.dir, w.fileIndex+1))
@@ -59,21 +70,85 @@ func (w *dirMsgWriter) WriteKV(k, v []byte) error {
 		w.WriteCloser = file
 		w.fileIndex++
 		w.haveWriteSize = 0
+		w.metrics.updateSpoolDepth(w.dir)
+		if w.stats != nil {
+			w.stats = NewSegmentStats()
+		}
+	}
+	line, err := w.writeLine(k, v)
+	if err != nil {
+		return err
 	}
+	if w.stats != nil {
+		w.stats.Add(line, k, v)
+	}
+	return nil
+}
+
+func (w *dirMsgWriter) writeLine(k, v []byte) ([]byte, error) {
 	buferr := bytes.NewBuffer(nil)
 	buferr.Write(k)
 	buferr.Write([]byte("#"))
 	buferr.Write(v)
 	buferr.Write([]byte("\r\n"))
 	if _, err := w.WriteCloser.Write(buferr.Bytes()); err != nil {
-		return err
+		return nil, err
 	}
 	w.haveWriteSize += len(k) + len(v) + 3
+	return buferr.Bytes(), nil
+}
+
+// closeSegment closes the current file before rotation, writing its footer
+// and starting its compression when configured.
+func (w *dirMsgWriter) closeSegment() error {
+	if w.stats != nil {
+		footer, err := json.Marshal(w.stats.Footer())
+		if err != nil {
+			return err
+		}
+		if _, err := w.writeLine([]byte(SegmentFooterKey), footer); err != nil {
+			return err
+		}
+	}
+	if err := w.WriteCloser.Close(); err != nil {
+		return err
+	}
+	if len(w.compression) != 0 {
+		w.compressInBackground(GetFileName(w.dir, w.fileIndex), w.compression)
+	}
 	return nil
 }
 
+// compressInBackground compresses the closed segment at filePath without blocking the writer,
+// the plain file stays readable until its compressed version is complete.
+func (w *dirMsgWriter) compressInBackground(filePath string, compression string) {
+	w.compressing.Add(1)
+	go func() {
+		defer w.compressing.Done()
+		if err := compressSegment(filePath, compression); err != nil {
+			w.errMu.Lock()
+			w.compressErr = fmt.Errorf("compress %s failed: %s", filePath, err.Error())
+			w.errMu.Unlock()
+		}
+	}()
+}
+
+// waitCompression waits for the pending compressions and returns the last error of them
+func (w *dirMsgWriter) waitCompression() error {
+	w.compressing.Wait()
+	w.errMu.Lock()
+	defer w.errMu.Unlock()
+	err := w.compressErr
+	w.compressErr = nil
+	return err
+}
+
 func (w *dirMsgWriter) Close() error {
-	return w.WriteCloser.Close()
+	err := w.WriteCloser.Close()
+	if cerr := w.waitCompression(); err == nil {
+		err = cerr
+	}
+	return err
 }
 
 func (w *dirMsgWriter) String() string {
@@ -84,6 +159,31 @@ func (w *dirMsgWriter) SetTimeToNewFile(cb func(k, v []byte) bool) {
 	w.timeNewFile = cb
 }
 
+// SetMetrics sets the metrics which the writer reports its spool depth to.
+func (w *dirMsgWriter) SetMetrics(m *Metrics) {
+	w.metrics = m
+	w.metrics.updateSpoolDepth(w.dir)
+}
+
+// SetCompression makes the writer compress the files it closes on rotation.
+func (w *dirMsgWriter) SetCompression(compression string) error {
+	if _, ok := compressExts[compression]; !ok {
+		return fmt.Errorf("unsupported compression: %s", compression)
+	}
+	w.compression = compression
+	return nil
+}
+
+// EnableFooter makes the writer append a SegmentFooter to the files it closes on rotation.
+func (w *dirMsgWriter) EnableFooter() error {
+	stats, err := scanSegmentStats(GetFileName(w.dir, w.fileIndex))
+	if err != nil {
+		return err
+	}
+	w.stats = stats
+	return nil
+}
+
 func (w *dirMsgWriter) timeToNewFile() func(k, v []byte) bool {
 	return func(k, v []byte) bool {
 		return len(k)+len(v)+3+w.haveWriteSize > MaxFileSize
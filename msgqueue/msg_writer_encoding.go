@@ -0,0 +1,43 @@
+package msgqueue
+
+import (
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
+)
+
+var _ MsgWriter = encodingMsgWriter{}
+
+var schemaRegistry = msgcodec.NewRegistry()
+
+// SetSchemaRegistry sets the schemas used by the writers configured with
+// the amino encoding. It must be called before the first message is sent.
+func SetSchemaRegistry(reg *msgcodec.Registry) {
+	schemaRegistry = reg
+}
+
+// encodingMsgWriter converts the JSON payloads of the keys registered in
+// schemaRegistry into binary frames before writing them.
+type encodingMsgWriter struct {
+	MsgWriter
+	text bool
+}
+
+func NewEncodingMsgWriter(w MsgWriter) MsgWriter {
+	_, isKafka := w.(kafkaMsgWriter)
+	return encodingMsgWriter{MsgWriter: w, text: !isKafka}
+}
+
+func (w encodingMsgWriter) WriteKV(k, v []byte) error {
+	key := string(k)
+	if _, ok := schemaRegistry.Latest(key); ok {
+		encode := schemaRegistry.Encode
+		if w.text {
+			encode = schemaRegistry.EncodeText
+		}
+		// A payload which doesn't match its schema is kept as JSON, which
+		// the decoder accepts as well, because retrying can't fix it.
+		if bz, err := encode(key, v); err == nil {
+			v = bz
+		}
+	}
+	return w.MsgWriter.WriteKV(k, v)
+}
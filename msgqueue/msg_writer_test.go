@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 )
 
 func TestCreateMsgWriter(t *testing.T) {
@@ -34,6 +39,17 @@ func TestCreateMsgWriter(t *testing.T) {
 	w, err = createMsgWriter("db:mongo")
 	require.Error(t, err)
 
+	w, err = createMsgWriter("nop;encoding=amino")
+	require.NoError(t, err)
+	require.Equal(t, "nop", w.String())
+
+	_, err = createMsgWriter("nop;encoding=xml")
+	require.Error(t, err)
+	_, err = createMsgWriter("nop;level=3")
+	require.Error(t, err)
+	_, err = createMsgWriter("nop;amino")
+	require.Error(t, err)
+
 	w, err = createMsgWriter("dir:tmp")
 	require.NoError(t, err)
 	defer os.RemoveAll("tmp")
@@ -139,3 +155,35 @@ func TestNewPipeMsgWriter(t *testing.T) {
 		}
 	}()
 }
+
+type testPriceInfo struct {
+	Price sdk.Dec `json:"price"`
+}
+
+func TestEncodingMsgWriter(t *testing.T) {
+	reg := msgcodec.NewRegistry()
+	reg.Register("price_info", 1, testPriceInfo{})
+	SetSchemaRegistry(reg)
+	defer SetSchemaRegistry(msgcodec.NewRegistry())
+
+	defer os.Remove("messages.txt")
+	w, err := createMsgWriter("file:messages.txt;encoding=amino")
+	require.NoError(t, err)
+	require.Equal(t, "file", w.String())
+	require.NoError(t, w.WriteKV([]byte("price_info"), []byte(`{"price":"1.500000000000000000"}`)))
+	require.NoError(t, w.WriteKV([]byte("height_info"), []byte(`{"height":1}`)))
+	require.NoError(t, w.Close())
+
+	file, err := os.Open("messages.txt")
+	require.NoError(t, err)
+	defer file.Close()
+	scan := bufio.NewScanner(file)
+	require.True(t, scan.Scan())
+	kv := strings.SplitN(scan.Text(), "#", 2)
+	require.Equal(t, "price_info", kv[0])
+	msg, err := reg.DecodeText(kv[0], []byte(kv[1]))
+	require.NoError(t, err)
+	require.Equal(t, &testPriceInfo{Price: sdk.NewDecWithPrec(15, 1)}, msg)
+	require.True(t, scan.Scan())
+	require.Equal(t, `height_info#{"height":1}`, scan.Text())
+}
@@ -0,0 +1,165 @@
+// Package msgcodec implements the compact binary encoding of msgqueue payloads.
+//
+// A binary payload is a frame made of a one byte magic number, a two bytes
+// big-endian schema version and the amino binary encoding of the message:
+//
+//	| 0xCE | version (uint16) | amino bare bytes |
+//
+// Writers using a line-based framing (file, dir, pipe, stdout) store the
+// frame in base64 so that it never contains the '#' and "\r\n" separators.
+// Keys without a registered schema are always left as JSON.
+package msgcodec
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	Magic     byte = 0xce
+	HeaderLen      = 3
+)
+
+type schema struct {
+	version uint16
+	typ     reflect.Type
+}
+
+// Registry maps a msgqueue key to the versioned types of its payload.
+// The node and the consumers must register the same schemas.
+type Registry struct {
+	cdc     *codec.Codec
+	schemas map[string]map[uint16]schema
+	latest  map[string]uint16
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		cdc:     codec.New(),
+		schemas: make(map[string]map[uint16]schema),
+		latest:  make(map[string]uint16),
+	}
+}
+
+// Codec returns the amino codec used for the payloads, interfaces carried by
+// the messages must be registered on it.
+func (r *Registry) Codec() *codec.Codec {
+	return r.cdc
+}
+
+// Register binds the version of the key's schema to the type of proto.
+// The greatest registered version of a key is used when encoding.
+func (r *Registry) Register(key string, version uint16, proto interface{}) {
+	typ := reflect.TypeOf(proto)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	versions, ok := r.schemas[key]
+	if !ok {
+		versions = make(map[uint16]schema)
+		r.schemas[key] = versions
+	}
+	if _, ok := versions[version]; ok {
+		panic(fmt.Sprintf("schema of %s version %d is already registered", key, version))
+	}
+	versions[version] = schema{version: version, typ: typ}
+	if latest, ok := r.latest[key]; !ok || version > latest {
+		r.latest[key] = version
+	}
+}
+
+// Latest returns the version used to encode the payloads of key.
+func (r *Registry) Latest(key string) (version uint16, ok bool) {
+	version, ok = r.latest[key]
+	return
+}
+
+// Encode converts the JSON payload of key into a binary frame.
+func (r *Registry) Encode(key string, jsonBz []byte) ([]byte, error) {
+	version, ok := r.latest[key]
+	if !ok {
+		return nil, fmt.Errorf("no schema registered for key %s", key)
+	}
+	ptr := reflect.New(r.schemas[key][version].typ)
+	if err := json.Unmarshal(jsonBz, ptr.Interface()); err != nil {
+		return nil, err
+	}
+	payload, err := r.cdc.MarshalBinaryBare(ptr.Elem().Interface())
+	if err != nil {
+		return nil, err
+	}
+	frame := make([]byte, HeaderLen, HeaderLen+len(payload))
+	frame[0] = Magic
+	binary.BigEndian.PutUint16(frame[1:HeaderLen], version)
+	return append(frame, payload...), nil
+}
+
+// EncodeText is Encode followed by base64, for the line-based writers.
+func (r *Registry) EncodeText(key string, jsonBz []byte) ([]byte, error) {
+	frame, err := r.Encode(key, jsonBz)
+	if err != nil {
+		return nil, err
+	}
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(frame)))
+	base64.StdEncoding.Encode(text, frame)
+	return text, nil
+}
+
+// Decode returns a pointer to the message carried by bz, which can be a
+// binary frame or a legacy JSON payload. JSON payloads are decoded with the
+// latest schema of key.
+func (r *Registry) Decode(key string, bz []byte) (interface{}, error) {
+	if !IsFrame(bz) {
+		version, ok := r.latest[key]
+		if !ok {
+			return nil, fmt.Errorf("no schema registered for key %s", key)
+		}
+		ptr := reflect.New(r.schemas[key][version].typ)
+		if err := json.Unmarshal(bz, ptr.Interface()); err != nil {
+			return nil, err
+		}
+		return ptr.Interface(), nil
+	}
+
+	version := FrameVersion(bz)
+	s, ok := r.schemas[key][version]
+	if !ok {
+		return nil, fmt.Errorf("no schema registered for key %s version %d", key, version)
+	}
+	ptr := reflect.New(s.typ)
+	if err := r.cdc.UnmarshalBinaryBare(bz[HeaderLen:], ptr.Interface()); err != nil {
+		return nil, err
+	}
+	return ptr.Interface(), nil
+}
+
+// DecodeText is the counterpart of EncodeText, JSON payloads are accepted as well.
+func (r *Registry) DecodeText(key string, text []byte) (interface{}, error) {
+	if len(text) != 0 && text[0] == '{' {
+		return r.Decode(key, text)
+	}
+	frame := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(frame, text)
+	if err != nil {
+		return nil, err
+	}
+	if !IsFrame(frame[:n]) {
+		return nil, fmt.Errorf("invalid frame of key %s", key)
+	}
+	return r.Decode(key, frame[:n])
+}
+
+// IsFrame reports whether bz is a binary frame rather than a JSON payload.
+func IsFrame(bz []byte) bool {
+	return len(bz) >= HeaderLen && bz[0] == Magic
+}
+
+// FrameVersion returns the schema version stored in the header of the frame.
+func FrameVersion(frame []byte) uint16 {
+	return binary.BigEndian.Uint16(frame[1:HeaderLen])
+}
@@ -0,0 +1,86 @@
+package msgcodec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type fillInfoV1 struct {
+	OrderID   string  `json:"order_id"`
+	FillPrice sdk.Dec `json:"fill_price"`
+}
+
+type fillInfoV2 struct {
+	OrderID   string  `json:"order_id"`
+	FillPrice sdk.Dec `json:"fill_price"`
+	Height    int64   `json:"height"`
+}
+
+func TestEncodeAndDecode(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register("fill_order_info", 1, fillInfoV1{})
+	reg.Register("fill_order_info", 2, &fillInfoV2{})
+	require.Panics(t, func() { reg.Register("fill_order_info", 2, fillInfoV2{}) })
+
+	version, ok := reg.Latest("fill_order_info")
+	require.True(t, ok)
+	require.EqualValues(t, 2, version)
+	_, ok = reg.Latest("height_info")
+	require.False(t, ok)
+
+	info := fillInfoV2{OrderID: "addr-1", FillPrice: sdk.NewDecWithPrec(12345, 3), Height: 100}
+	jsonBz, err := json.Marshal(info)
+	require.NoError(t, err)
+
+	frame, err := reg.Encode("fill_order_info", jsonBz)
+	require.NoError(t, err)
+	require.True(t, IsFrame(frame))
+	require.False(t, IsFrame(jsonBz))
+	require.EqualValues(t, 2, FrameVersion(frame))
+	require.True(t, len(frame) < len(jsonBz))
+
+	msg, err := reg.Decode("fill_order_info", frame)
+	require.NoError(t, err)
+	require.Equal(t, &info, msg)
+
+	// legacy JSON payloads are decoded with the latest schema
+	msg, err = reg.Decode("fill_order_info", jsonBz)
+	require.NoError(t, err)
+	require.Equal(t, &info, msg)
+
+	text, err := reg.EncodeText("fill_order_info", jsonBz)
+	require.NoError(t, err)
+	require.NotContains(t, string(text), "#")
+	msg, err = reg.DecodeText("fill_order_info", text)
+	require.NoError(t, err)
+	require.Equal(t, &info, msg)
+	msg, err = reg.DecodeText("fill_order_info", jsonBz)
+	require.NoError(t, err)
+	require.Equal(t, &info, msg)
+
+	_, err = reg.Encode("height_info", jsonBz)
+	require.Error(t, err)
+	_, err = reg.Decode("height_info", frame)
+	require.Error(t, err)
+}
+
+func TestDecodeOldVersion(t *testing.T) {
+	old := NewRegistry()
+	old.Register("fill_order_info", 1, fillInfoV1{})
+	info := fillInfoV1{OrderID: "addr-1", FillPrice: sdk.NewDec(3)}
+	jsonBz, err := json.Marshal(info)
+	require.NoError(t, err)
+	frame, err := old.Encode("fill_order_info", jsonBz)
+	require.NoError(t, err)
+
+	reg := NewRegistry()
+	reg.Register("fill_order_info", 1, fillInfoV1{})
+	reg.Register("fill_order_info", 2, fillInfoV2{})
+	msg, err := reg.Decode("fill_order_info", frame)
+	require.NoError(t, err)
+	require.Equal(t, &info, msg)
+}
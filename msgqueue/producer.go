@@ -25,6 +25,17 @@ const (
 	CfgPrefixDir   = "dir:"
 	CfgNamedPipe   = "pipe:"
 	CfgPrefixPrune = "prune:"
+
+	CfgOptionSeparator = ";"
+	CfgOptionEncoding  = "encoding"
+	CfgOptionCompress  = "compress"
+	CfgOptionFooter    = "footer"
+	CfgOptionFilter    = "filter"
+)
+
+const (
+	EncodingJSON  = "json"
+	EncodingAmino = "amino"
 )
 
 const RetryNum = math.MaxInt64
@@ -42,20 +53,31 @@ type producer struct {
 	subTopics  map[string]struct{}
 	msgWriters []MsgWriter
 	log        log.Logger
+	metrics    *Metrics
 }
 
 func NewProducer(log log.Logger) MsgSender {
+	return NewProducerWithMetrics(log, NopMetrics())
+}
+
+// NewProducerWithMetrics returns a producer which reports to metrics how its writers perform.
+func NewProducerWithMetrics(log log.Logger, metrics *Metrics) MsgSender {
 	brokers := viper.GetStringSlice(FlagBrokers)
 	topics := viper.GetString(FlagTopics)
 	featureToggle := viper.GetBool(FlagFeatureToggle)
-	return NewProducerFromConfig(brokers, topics, featureToggle, log)
+	return newProducer(brokers, topics, featureToggle, log, metrics)
 }
 
 func NewProducerFromConfig(brokers []string, topics string, featureToggle bool, log log.Logger) MsgSender {
+	return newProducer(brokers, topics, featureToggle, log, NopMetrics())
+}
+
+func newProducer(brokers []string, topics string, featureToggle bool, log log.Logger, metrics *Metrics) MsgSender {
 	p := producer{
 		subTopics:  make(map[string]struct{}),
 		msgWriters: nil,
 		log:        log,
+		metrics:    metrics,
 	}
 
 	p.init(brokers, topics, featureToggle)
@@ -87,6 +109,9 @@ func (p *producer) init(brokers []string, topics string, featureToggle bool) {
 				p.log.Error(fmt.Sprintf("create msgWrite : %s failed, err : %s\n", broker, err.Error()))
 			}
 		} else {
+			if ms, ok := unwrapMsgWriter(msgWriter).(metricsSetter); ok {
+				ms.SetMetrics(p.metrics)
+			}
 			p.msgWriters = append(p.msgWriters, msgWriter)
 			if p.log != nil {
 				p.log.Info(fmt.Sprintf("create write : %s succueed", msgWriter.String()))
@@ -112,13 +137,31 @@ func (p producer) Close() {
 
 func (p producer) SendMsg(k []byte, v []byte) {
 	for _, w := range p.msgWriters {
-		if err := Retry(RetryNum, time.Millisecond, func() error {
+		name := w.String()
+		if fw, ok := w.(filterMsgWriter); ok {
+			if !fw.filter.Match(k, v) {
+				p.metrics.MsgsFiltered.With("writer", name).Add(1)
+				continue
+			}
+			// the message matched, don't evaluate the filter again
+			w = fw.MsgWriter
+		}
+		start := time.Now()
+		attempts := 0
+		err := Retry(RetryNum, time.Millisecond, func() error {
+			if attempts++; attempts > 1 {
+				p.metrics.WriteRetries.With("writer", name).Add(1)
+			}
 			return w.WriteKV(k, v)
-		}); err != nil {
+		})
+		p.metrics.WriteLatency.With("writer", name).Observe(time.Since(start).Seconds())
+		if err != nil {
 			if p.log != nil {
-				p.log.Error(fmt.Sprintf("write msg to %s failed, err : %s\n", w.String(), err.Error()))
+				p.log.Error(fmt.Sprintf("write msg to %s failed, err : %s\n", name, err.Error()))
 			}
+			continue
 		}
+		p.metrics.MsgsWritten.With("key", string(k), "writer", name).Add(1)
 	}
 }
 
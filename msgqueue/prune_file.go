@@ -11,10 +11,16 @@ import (
 type FileDeleter struct {
 	doneHeightCh <-chan int64
 	dir          string
+	metrics      *Metrics
 }
 
 func NewFileDeleter(heightCh <-chan int64, dir string) *FileDeleter {
-	return &FileDeleter{doneHeightCh: heightCh, dir: dir}
+	return &FileDeleter{doneHeightCh: heightCh, dir: dir, metrics: NopMetrics()}
+}
+
+// SetMetrics sets the metrics which the deleter reports the spool depth of its dir to.
+func (p *FileDeleter) SetMetrics(m *Metrics) {
+	p.metrics = m
 }
 
 func (p *FileDeleter) Run() {
@@ -36,6 +42,7 @@ func (p *FileDeleter) removeFiles(doneHeight int64) {
 		if err := os.Remove(fileName); err != nil {
 			panic(fmt.Sprintf("Remove file from dir failed; dir[%s], file[%s]\n", p.dir, fileName))
 		}
+		p.metrics.updateSpoolDepth(p.dir)
 	}
 }
 
@@ -0,0 +1,341 @@
+// Package reader iterates the messages written by the dir: and prune: msgqueue
+// writers, following the rotation of the backup-* files. Compressed files are
+// decompressed and the segment footers are verified and skipped. A closed file
+// is read plain until the writer has compressed it in the background.
+package reader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+type Message struct {
+	Key   string
+	Value []byte
+	// Height of the last height_info message before this one, -1 if there is none
+	Height    int64
+	FileIndex int
+	Offset    int64
+}
+
+// TruncatedError is returned when a file ends with an incomplete message,
+// which happens when the node crashed during writing.
+type TruncatedError struct {
+	FileIndex int
+	Offset    int64
+}
+
+func (e *TruncatedError) Error() string {
+	return fmt.Sprintf("truncated message at offset %d of file %d", e.Offset, e.FileIndex)
+}
+
+type Reader struct {
+	dir       string
+	fileIndex int
+	file      io.ReadCloser
+	rd        *bufio.Reader
+	stats     *msgqueue.SegmentStats
+	offset    int64
+	height    int64
+	pending   *Message
+
+	follow   bool
+	interval time.Duration
+	done     <-chan struct{}
+}
+
+// NewReader returns a reader positioned at the first message of the oldest file in dir.
+func NewReader(dir string) (*Reader, error) {
+	indexes, err := msgqueue.GetFileIndexesInDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) == 0 {
+		return nil, fmt.Errorf("no msgqueue file in %s", dir)
+	}
+	r := &Reader{dir: dir, fileIndex: -1, height: -1}
+	if err := r.open(indexes[0]); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Follow makes Next wait for the messages which are not written yet instead
+// of returning io.EOF. The directory is polled every interval until done is closed.
+func (r *Reader) Follow(interval time.Duration, done <-chan struct{}) {
+	r.follow = true
+	r.interval = interval
+	r.done = done
+}
+
+// Height returns the height of the last height_info message read.
+func (r *Reader) Height() int64 {
+	return r.height
+}
+
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+// Next returns the next message, or io.EOF when all the files are read.
+// A *TruncatedError is returned for an incomplete message: the reader skips
+// it if a newer file exists, otherwise it's returned again until the
+// message is completed.
+func (r *Reader) Next() (Message, error) {
+	if r.pending != nil {
+		msg := *r.pending
+		r.pending = nil
+		return msg, nil
+	}
+	for {
+		line, err := r.rd.ReadBytes('\n')
+		if err == nil {
+			start := r.offset
+			r.offset += int64(len(line))
+			msg, err := r.parse(line, start)
+			if err != nil {
+				return Message{}, err
+			}
+			if msg.Key == msgqueue.SegmentFooterKey {
+				if err := r.verifyFooter(msg); err != nil {
+					return Message{}, err
+				}
+				continue
+			}
+			r.stats.Add(line, []byte(msg.Key), msg.Value)
+			return msg, nil
+		}
+		if err != io.EOF {
+			return Message{}, err
+		}
+
+		next, err := r.nextFileIndex()
+		if err != nil {
+			return Message{}, err
+		}
+		if next < 0 || len(line) != 0 {
+			// the writer may be appending to the file
+			if err := r.rewind(); err != nil {
+				return Message{}, err
+			}
+		}
+		if next >= 0 {
+			if len(line) != 0 {
+				if complete, err := r.hasCompleteLine(); err != nil || complete {
+					if err != nil {
+						return Message{}, err
+					}
+					continue
+				}
+				truncated := &TruncatedError{FileIndex: r.fileIndex, Offset: r.offset}
+				if err := r.open(next); err != nil {
+					return Message{}, err
+				}
+				return Message{}, truncated
+			}
+			if err := r.open(next); err != nil {
+				return Message{}, err
+			}
+			continue
+		}
+		if !r.follow {
+			if len(line) != 0 {
+				return Message{}, &TruncatedError{FileIndex: r.fileIndex, Offset: r.offset}
+			}
+			return Message{}, io.EOF
+		}
+		select {
+		case <-r.done:
+			return Message{}, io.EOF
+		case <-time.After(r.interval):
+		}
+	}
+}
+
+// SeekHeight moves the reader to the height_info message of the first block whose
+// height isn't less than height, it will be returned by the next call of Next.
+func (r *Reader) SeekHeight(height int64) error {
+	indexes, err := msgqueue.GetFileIndexesInDir(r.dir)
+	if err != nil {
+		return err
+	}
+	if len(indexes) == 0 {
+		return fmt.Errorf("no msgqueue file in %s", r.dir)
+	}
+	start := indexes[0]
+	for _, index := range indexes {
+		h, err := firstHeightInFile(r.dir, index)
+		if err != nil {
+			return err
+		}
+		if h < 0 || h > height {
+			break
+		}
+		start = index
+	}
+	if err := r.open(start); err != nil {
+		return err
+	}
+	r.height = -1
+	r.pending = nil
+	for {
+		fileIndex := r.fileIndex
+		msg, err := r.Next()
+		if _, ok := err.(*TruncatedError); ok && fileIndex != r.fileIndex {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Key == msgqueue.HeightInfoKey && msg.Height >= height {
+			r.pending = &msg
+			return nil
+		}
+	}
+}
+
+func (r *Reader) open(index int) error {
+	file, _, err := msgqueue.OpenSegment(r.dir, index)
+	if err != nil {
+		return err
+	}
+	if r.file != nil {
+		r.file.Close()
+	}
+	r.file = file
+	r.fileIndex = index
+	r.offset = 0
+	r.rd = bufio.NewReader(file)
+	r.stats = msgqueue.NewSegmentStats()
+	return nil
+}
+
+// rewind moves the underlying file back to the offset of the next message.
+func (r *Reader) rewind() error {
+	if file, ok := r.file.(*os.File); ok {
+		if _, err := file.Seek(r.offset, io.SeekStart); err != nil {
+			return err
+		}
+		r.rd.Reset(file)
+		return nil
+	}
+
+	// compressed files can't seek, decompress them again from the beginning
+	file, _, err := msgqueue.OpenSegment(r.dir, r.fileIndex)
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(ioutil.Discard, file, r.offset); err != nil {
+		file.Close()
+		return err
+	}
+	r.file.Close()
+	r.file = file
+	r.rd.Reset(file)
+	return nil
+}
+
+func (r *Reader) verifyFooter(msg Message) error {
+	var footer msgqueue.SegmentFooter
+	if err := json.Unmarshal(msg.Value, &footer); err != nil {
+		return err
+	}
+	if err := r.stats.Verify(footer); err != nil {
+		return fmt.Errorf("%s in file %d", err.Error(), r.fileIndex)
+	}
+	r.stats = msgqueue.NewSegmentStats()
+	return nil
+}
+
+// hasCompleteLine checks again the message at the current offset, which may
+// have been completed after the last read.
+func (r *Reader) hasCompleteLine() (bool, error) {
+	_, err := r.rd.ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	if err := r.rewind(); err != nil {
+		return false, err
+	}
+	return err == nil, nil
+}
+
+// nextFileIndex returns the smallest file index greater than the current one,
+// or -1 if the current file is the newest.
+func (r *Reader) nextFileIndex() (int, error) {
+	indexes, err := msgqueue.GetFileIndexesInDir(r.dir)
+	if err != nil {
+		return -1, err
+	}
+	for _, index := range indexes {
+		if index > r.fileIndex {
+			return index, nil
+		}
+	}
+	return -1, nil
+}
+
+func (r *Reader) parse(line []byte, offset int64) (Message, error) {
+	key, value, err := splitLine(line)
+	if err != nil {
+		return Message{}, fmt.Errorf("%s at offset %d of file %d", err.Error(), offset, r.fileIndex)
+	}
+	if key == msgqueue.HeightInfoKey {
+		var info msgqueue.NewHeightInfo
+		if err := json.Unmarshal(value, &info); err != nil {
+			return Message{}, err
+		}
+		r.height = info.Height
+	}
+	return Message{
+		Key:       key,
+		Value:     value,
+		Height:    r.height,
+		FileIndex: r.fileIndex,
+		Offset:    offset,
+	}, nil
+}
+
+func splitLine(line []byte) (string, []byte, error) {
+	line = bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+	sep := bytes.IndexByte(line, '#')
+	if sep < 0 {
+		return "", nil, fmt.Errorf("malformed message")
+	}
+	return string(line[:sep]), line[sep+1:], nil
+}
+
+// firstHeightInFile returns the height of the first height_info message in the file, or -1.
+func firstHeightInFile(dir string, index int) (int64, error) {
+	file, _, err := msgqueue.OpenSegment(dir, index)
+	if err != nil {
+		return -1, err
+	}
+	defer file.Close()
+	rd := bufio.NewReader(file)
+	for {
+		line, err := rd.ReadBytes('\n')
+		if err == io.EOF {
+			return -1, nil
+		} else if err != nil {
+			return -1, err
+		}
+		key, value, err := splitLine(line)
+		if err != nil || key != msgqueue.HeightInfoKey {
+			continue
+		}
+		var info msgqueue.NewHeightInfo
+		if err := json.Unmarshal(value, &info); err != nil {
+			return -1, err
+		}
+		return info.Height, nil
+	}
+}
@@ -0,0 +1,212 @@
+package reader
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coinexchain/cet-sdk/msgqueue"
+)
+
+const heightModel = `{"height":%d,"timestamp":36372364939,"last_block_hash":"7FB1B1AB4EEB748652423D72001841073EC00E811E964B1E6FAE9A2E2EC10E07"}`
+
+func writeBlocks(t *testing.T, w msgqueue.MsgWriter, heights ...int64) {
+	for _, h := range heights {
+		require.Nil(t, w.WriteKV([]byte(msgqueue.HeightInfoKey), []byte(fmt.Sprintf(heightModel, h))))
+		require.Nil(t, w.WriteKV([]byte("fill_order_info"), []byte(fmt.Sprintf(`{"height":%d}`, h))))
+	}
+}
+
+func readAll(t *testing.T, r *Reader) []Message {
+	var msgs []Message
+	for {
+		msg, err := r.Next()
+		if err == io.EOF {
+			return msgs
+		}
+		require.Nil(t, err)
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestReadAcrossFiles(t *testing.T) {
+	dir := "testr"
+	viper.Set("genesis_block_height", 0)
+	w, err := msgqueue.NewRegulateWriteDir(dir)
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeBlocks(t, w, 1, 2, 10000, 10001, 10002, 20001)
+	indexes, err := msgqueue.GetFileIndexesInDir(dir)
+	require.Nil(t, err)
+	require.Equal(t, []int{0, 1, 2}, indexes)
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	msgs := readAll(t, r)
+	require.Equal(t, 12, len(msgs))
+	require.Equal(t, msgqueue.HeightInfoKey, msgs[0].Key)
+	require.EqualValues(t, 1, msgs[0].Height)
+	require.Equal(t, "fill_order_info", msgs[7].Key)
+	require.Equal(t, `{"height":10001}`, string(msgs[7].Value))
+	require.EqualValues(t, 10001, msgs[7].Height)
+	require.Equal(t, 1, msgs[7].FileIndex)
+	require.Equal(t, 2, msgs[11].FileIndex)
+
+	// seek to an existing height and to a missing one
+	require.Nil(t, r.SeekHeight(10002))
+	msg, err := r.Next()
+	require.Nil(t, err)
+	require.Equal(t, msgqueue.HeightInfoKey, msg.Key)
+	require.EqualValues(t, 10002, msg.Height)
+	require.Equal(t, 1, msg.FileIndex)
+	require.EqualValues(t, 10002, r.Height())
+
+	require.Nil(t, r.SeekHeight(10003))
+	msg, err = r.Next()
+	require.Nil(t, err)
+	require.EqualValues(t, 20001, msg.Height)
+	require.Equal(t, 2, msg.FileIndex)
+
+	require.Equal(t, io.EOF, r.SeekHeight(30000))
+	require.Nil(t, w.Close())
+}
+
+func TestTruncatedTail(t *testing.T) {
+	dir := "testt"
+	viper.Set("genesis_block_height", 0)
+	w, err := msgqueue.NewRegulateWriteDir(dir)
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeBlocks(t, w, 1)
+	require.Nil(t, w.Close())
+
+	file, err := os.OpenFile(msgqueue.GetFileName(dir, 0), os.O_WRONLY|os.O_APPEND, 0666)
+	require.Nil(t, err)
+	_, err = file.Write([]byte(`fill_order_info#{"hei`))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	_, err = r.Next()
+	require.Nil(t, err)
+	msg, err := r.Next()
+	require.Nil(t, err)
+	tail := msg.Offset + int64(len(msg.Key)+len(msg.Value)+3)
+	_, err = r.Next()
+	require.Equal(t, &TruncatedError{FileIndex: 0, Offset: tail}, err)
+
+	// the truncated message is skipped once a newer file exists
+	next, err := os.Create(msgqueue.GetFileName(dir, 1))
+	require.Nil(t, err)
+	_, err = next.Write([]byte(fmt.Sprintf("%s#%s\r\n", msgqueue.HeightInfoKey, fmt.Sprintf(heightModel, 10001))))
+	require.Nil(t, err)
+	require.Nil(t, next.Close())
+	_, err = r.Next()
+	require.IsType(t, &TruncatedError{}, err)
+	msg, err = r.Next()
+	require.Nil(t, err)
+	require.EqualValues(t, 10001, msg.Height)
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestFollow(t *testing.T) {
+	dir := "testf"
+	viper.Set("genesis_block_height", 0)
+	w, err := msgqueue.NewRegulateWriteDir(dir)
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	writeBlocks(t, w, 1)
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	done := make(chan struct{})
+	r.Follow(time.Millisecond, done)
+	require.Equal(t, 2, len(readUntil(t, r, 2)))
+
+	written := make(chan struct{})
+	go func() {
+		for _, h := range []int64{2, 10001} {
+			_ = w.WriteKV([]byte(msgqueue.HeightInfoKey), []byte(fmt.Sprintf(heightModel, h)))
+		}
+		close(written)
+	}()
+	msgs := readUntil(t, r, 2)
+	require.EqualValues(t, 10001, msgs[1].Height)
+	require.Equal(t, 1, msgs[1].FileIndex)
+
+	close(done)
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+	<-written
+	require.Nil(t, w.Close())
+}
+
+func readUntil(t *testing.T, r *Reader, n int) []Message {
+	msgs := make([]Message, 0, n)
+	for len(msgs) < n {
+		msg, err := r.Next()
+		require.Nil(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func TestReadCompressedSegments(t *testing.T) {
+	dir := "testc"
+	viper.Set("genesis_block_height", 0)
+	w, err := msgqueue.NewRegulateWriteDir(dir)
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	dw := w.MsgWriter.(interface {
+		SetCompression(string) error
+		EnableFooter() error
+	})
+	require.Nil(t, dw.SetCompression(msgqueue.CompressZstd))
+	require.Nil(t, dw.EnableFooter())
+	writeBlocks(t, w, 1, 2, 10001, 10002, 20001)
+	require.Nil(t, w.Close())
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	msgs := readAll(t, r)
+	require.Equal(t, 10, len(msgs))
+	for _, msg := range msgs {
+		require.NotEqual(t, msgqueue.SegmentFooterKey, msg.Key)
+	}
+
+	require.Nil(t, r.SeekHeight(10002))
+	msg, err := r.Next()
+	require.Nil(t, err)
+	require.EqualValues(t, 10002, msg.Height)
+	require.Equal(t, 1, msg.FileIndex)
+}
+
+func TestFooterMismatch(t *testing.T) {
+	dir := "testm"
+	require.Nil(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+	file, err := os.Create(msgqueue.GetFileName(dir, 0))
+	require.Nil(t, err)
+	_, err = file.Write([]byte("foo#bar\r\n" + msgqueue.SegmentFooterKey + `#{"message_count":2}` + "\r\n"))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	r, err := NewReader(dir)
+	require.Nil(t, err)
+	defer r.Close()
+	_, err = r.Next()
+	require.Nil(t, err)
+	_, err = r.Next()
+	require.Error(t, err)
+}
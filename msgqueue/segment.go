@@ -0,0 +1,224 @@
+package msgqueue
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"github.com/DataDog/zstd"
+)
+
+// A segment is one of the backup-* files written by the dir writers. When a
+// segment is closed on rotation, a footer can be appended to it and it can be
+// compressed into backup-N.gz or backup-N.zst.
+
+const (
+	CompressGzip = "gzip"
+	CompressZstd = "zstd"
+
+	// SegmentFooterKey is the key of the last message of a closed segment
+	SegmentFooterKey = "segment_footer"
+)
+
+var compressExts = map[string]string{
+	CompressGzip: ".gz",
+	CompressZstd: ".zst",
+}
+
+type SegmentFooter struct {
+	FirstHeight  int64 `json:"first_height"`
+	LastHeight   int64 `json:"last_height"`
+	MessageCount int64 `json:"message_count"`
+	// hex encoded sha256 of all the lines before the footer
+	Checksum string `json:"checksum"`
+}
+
+// SegmentStats accumulates the footer of a segment while its lines are written or read.
+type SegmentStats struct {
+	hash   hash.Hash
+	footer SegmentFooter
+}
+
+func NewSegmentStats() *SegmentStats {
+	return &SegmentStats{
+		hash:   sha256.New(),
+		footer: SegmentFooter{FirstHeight: -1, LastHeight: -1},
+	}
+}
+
+// Add accounts a line of the segment, including its trailing "\r\n".
+func (s *SegmentStats) Add(line []byte, k, v []byte) {
+	s.hash.Write(line)
+	s.footer.MessageCount++
+	if string(k) != HeightInfoKey {
+		return
+	}
+	var info NewHeightInfo
+	if err := json.Unmarshal(v, &info); err != nil {
+		return
+	}
+	if s.footer.FirstHeight < 0 {
+		s.footer.FirstHeight = info.Height
+	}
+	s.footer.LastHeight = info.Height
+}
+
+func (s *SegmentStats) Footer() SegmentFooter {
+	footer := s.footer
+	footer.Checksum = hex.EncodeToString(s.hash.Sum(nil))
+	return footer
+}
+
+// Verify checks the footer read at the end of a segment against the lines read before it.
+func (s *SegmentStats) Verify(footer SegmentFooter) error {
+	if expected := s.Footer(); footer != expected {
+		return fmt.Errorf("segment footer mismatch, expected %+v, got %+v", expected, footer)
+	}
+	return nil
+}
+
+// FindSegmentFile returns the path of the segment with index in dir and its
+// compression, which is empty for a plain file. The plain file is returned
+// while both exist, until compressSegment removes it.
+func FindSegmentFile(dir string, index int) (filePath string, compression string, err error) {
+	filePath = GetFileName(dir, index)
+	if _, err = os.Stat(filePath); err == nil {
+		return filePath, "", nil
+	}
+	for c, ext := range compressExts {
+		if _, err := os.Stat(filePath + ext); err == nil {
+			return filePath + ext, c, nil
+		}
+	}
+	return "", "", fmt.Errorf("segment %d not found in %s", index, dir)
+}
+
+type segmentReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r segmentReader) Close() error {
+	var err error
+	for _, c := range r.closers {
+		if e := c.Close(); e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// OpenSegment opens the segment with index in dir for reading, decompressing it if needed.
+// A closed segment may still be plain while it's compressed in the background.
+func OpenSegment(dir string, index int) (rc io.ReadCloser, compressed bool, err error) {
+	filePath, compression, err := FindSegmentFile(dir, index)
+	if err != nil {
+		return nil, false, err
+	}
+	file, err := os.Open(filePath)
+	if os.IsNotExist(err) && len(compression) == 0 {
+		// the plain file was just replaced by its compressed version
+		if filePath, compression, err = FindSegmentFile(dir, index); err != nil {
+			return nil, false, err
+		}
+		file, err = os.Open(filePath)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	switch compression {
+	case CompressGzip:
+		gr, err := gzip.NewReader(bufio.NewReader(file))
+		if err != nil {
+			file.Close()
+			return nil, false, err
+		}
+		return segmentReader{Reader: gr, closers: []io.Closer{gr, file}}, true, nil
+	case CompressZstd:
+		zr := zstd.NewReader(bufio.NewReader(file))
+		return segmentReader{Reader: zr, closers: []io.Closer{zr, file}}, true, nil
+	}
+	return file, false, nil
+}
+
+// compressSegment replaces the plain file at filePath by its compressed version.
+func compressSegment(filePath string, compression string) error {
+	ext, ok := compressExts[compression]
+	if !ok {
+		return fmt.Errorf("unsupported compression: %s", compression)
+	}
+	in, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	tmpPath := filePath + ext + ".tmp"
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	var cw io.WriteCloser
+	if compression == CompressGzip {
+		cw = gzip.NewWriter(out)
+	} else {
+		cw = zstd.NewWriter(out)
+	}
+	if _, err := io.Copy(cw, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := cw.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filePath+ext); err != nil {
+		return err
+	}
+	return os.Remove(filePath)
+}
+
+// scanSegmentStats rebuilds the stats of a plain segment which is being appended.
+func scanSegmentStats(filePath string) (*SegmentStats, error) {
+	stats := NewSegmentStats()
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	rd := bufio.NewReader(file)
+	for {
+		line, err := rd.ReadBytes('\n')
+		if err == io.EOF {
+			return stats, nil
+		} else if err != nil {
+			return nil, err
+		}
+		k, v := splitMsgLine(line)
+		if string(k) == SegmentFooterKey {
+			stats = NewSegmentStats()
+			continue
+		}
+		stats.Add(line, k, v)
+	}
+}
+
+func splitMsgLine(line []byte) (k, v []byte) {
+	line = bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r"))
+	sep := bytes.IndexByte(line, '#')
+	if sep < 0 {
+		return nil, line
+	}
+	return line[:sep], line[sep+1:]
+}
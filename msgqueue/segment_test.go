@@ -0,0 +1,129 @@
+package msgqueue
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedSegments(t *testing.T) {
+	for _, compression := range []string{CompressGzip, CompressZstd} {
+		dir := "tests-" + compression
+		viper.Set("genesis_block_height", 0)
+		w, err := createMsgWriter(fmt.Sprintf("prune:%s;compress=%s;footer=true", dir, compression))
+		require.Nil(t, err)
+		defer os.RemoveAll(dir)
+
+		key := []byte(HeightInfoKey)
+		model := `{"height":%d,"timestamp":36372364939,"last_block_hash":"7FB1B1AB4EEB748652423D72001841073EC00E811E964B1E6FAE9A2E2EC10E07"}`
+		for _, h := range []int64{1, 2, 10000, 10001} {
+			require.Nil(t, w.WriteKV(key, []byte(fmt.Sprintf(model, h))))
+			require.Nil(t, w.WriteKV([]byte("foo"), []byte("bar")))
+		}
+		require.Nil(t, w.(*RegulateWriteDir).MsgWriter.(*dirMsgWriter).waitCompression())
+
+		files, err := getAllFilesFromDir(dir)
+		require.Nil(t, err)
+		require.Equal(t, []string{"backup-0" + compressExts[compression], "backup-1"}, files)
+		filePath, height, err := GetFileLeastHeightInDir(dir)
+		require.Nil(t, err)
+		require.Equal(t, dir+"/backup-0"+compressExts[compression], filePath)
+		require.EqualValues(t, 1, height)
+
+		in, compressed, err := OpenSegment(dir, 0)
+		require.Nil(t, err)
+		require.True(t, compressed)
+		stats := NewSegmentStats()
+		rd := bufio.NewReader(in)
+		var footer SegmentFooter
+		for {
+			line, err := rd.ReadBytes('\n')
+			require.Nil(t, err)
+			k, v := splitMsgLine(line)
+			if string(k) == SegmentFooterKey {
+				require.Nil(t, json.Unmarshal(v, &footer))
+				break
+			}
+			stats.Add(line, k, v)
+		}
+		require.Nil(t, in.Close())
+		require.Nil(t, stats.Verify(footer))
+		require.EqualValues(t, 1, footer.FirstHeight)
+		require.EqualValues(t, 10000, footer.LastHeight)
+		require.EqualValues(t, 6, footer.MessageCount)
+
+		// the writer never appends to a compressed segment
+		require.Nil(t, w.Close())
+		filePath, fileIndex, err := getFilePathAndIndex(dir, 0)
+		require.Nil(t, err)
+		require.Equal(t, 1, fileIndex)
+		require.Equal(t, dir+"/backup-1", filePath)
+	}
+}
+
+func TestSegmentBeingCompressed(t *testing.T) {
+	dir := "tmpz"
+	require.Nil(t, os.Mkdir(dir, os.ModePerm))
+	defer os.RemoveAll(dir)
+	filePath := GetFileName(dir, 0)
+	file, err := os.Create(filePath)
+	require.Nil(t, err)
+	_, err = file.Write([]byte("foo#bar\r\n"))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+	// compressSegment has renamed the compressed file but not removed the plain one yet
+	file, err = os.Create(filePath + compressExts[CompressGzip])
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	indexes, err := GetFileIndexesInDir(dir)
+	require.Nil(t, err)
+	require.Equal(t, []int{0}, indexes)
+	in, compressed, err := OpenSegment(dir, 0)
+	require.Nil(t, err)
+	require.False(t, compressed)
+	line, err := bufio.NewReader(in).ReadString('\n')
+	require.Nil(t, err)
+	require.Equal(t, "foo#bar\r\n", line)
+	require.Nil(t, in.Close())
+
+	require.Nil(t, os.Remove(filePath+compressExts[CompressGzip]))
+	require.Nil(t, compressSegment(filePath, CompressGzip))
+	in, compressed, err = OpenSegment(dir, 0)
+	require.Nil(t, err)
+	require.True(t, compressed)
+	require.Nil(t, in.Close())
+}
+
+func TestSegmentOptions(t *testing.T) {
+	_, err := createMsgWriter("nop;compress=gzip")
+	require.Error(t, err)
+	_, err = createMsgWriter("dir:tmpo;compress=lz4")
+	require.Error(t, err)
+	defer os.RemoveAll("tmpo")
+
+	w, err := createMsgWriter("dir:tmpo;footer=true")
+	require.Nil(t, err)
+	require.Nil(t, w.WriteKV([]byte("foo"), []byte("bar")))
+	require.Nil(t, w.Close())
+
+	// the stats of the segment are rebuilt when the writer restarts
+	w, err = createMsgWriter("dir:tmpo;footer=true")
+	require.Nil(t, err)
+	require.EqualValues(t, 1, w.(*dirMsgWriter).stats.Footer().MessageCount)
+	require.Nil(t, w.Close())
+}
+
+func TestSegmentStatsVerify(t *testing.T) {
+	stats := NewSegmentStats()
+	stats.Add([]byte("foo#bar\r\n"), []byte("foo"), []byte("bar"))
+	footer := stats.Footer()
+	require.Nil(t, stats.Verify(footer))
+	footer.MessageCount = 2
+	require.Error(t, stats.Verify(footer))
+}
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -34,6 +35,10 @@ func getFilePathAndIndex(dir string, height int) (filePath string, fileIndex int
 		return GetFileName(dir, 0), 0, nil
 	}
 	fileIndex = getMaxIndexFromFiles(fileNames)
+	if _, compression, err := FindSegmentFile(dir, fileIndex); err == nil && len(compression) != 0 {
+		// the newest segment was closed and compressed, never append to it
+		fileIndex++
+	}
 	return GetFileName(dir, fileIndex), fileIndex, nil
 }
 
@@ -58,16 +63,52 @@ func getAllFilesFromDir(dir string) ([]string, error) {
 	return fileNames, nil
 }
 
+// GetFileIndexesInDir returns the indexes of the backup files in dir in ascending order
+func GetFileIndexesInDir(dir string) ([]int, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]int, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if index, ok := getIndexFromFileName(file.Name()); ok {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Ints(indexes)
+	// a segment being compressed has both a plain and a compressed file
+	uniq := indexes[:0]
+	for i, index := range indexes {
+		if i == 0 || index != indexes[i-1] {
+			uniq = append(uniq, index)
+		}
+	}
+	return uniq, nil
+}
+
+func getIndexFromFileName(fileName string) (int, bool) {
+	if !strings.HasPrefix(fileName, filePrefix) {
+		return -1, false
+	}
+	fileName = strings.TrimPrefix(fileName, filePrefix)
+	for _, ext := range compressExts {
+		fileName = strings.TrimSuffix(fileName, ext)
+	}
+	index, err := strconv.Atoi(fileName)
+	if err != nil {
+		return -1, false
+	}
+	return index, true
+}
+
 func getMaxIndexFromFiles(fileNames []string) int {
 	fileIndex := 0
 	for _, fileName := range fileNames {
-		vals := strings.Split(fileName, "-")
-		if len(vals) == 2 {
-			if index, err := strconv.Atoi(vals[1]); err == nil {
-				if index > fileIndex {
-					fileIndex = index
-				}
-			}
+		if index, ok := getIndexFromFileName(fileName); ok && index > fileIndex {
+			fileIndex = index
 		}
 	}
 	return fileIndex
@@ -76,13 +117,8 @@ func getMaxIndexFromFiles(fileNames []string) int {
 func getMinIndexFromFiles(fileNames []string) int {
 	fileIndex := math.MaxInt64
 	for _, fileName := range fileNames {
-		vals := strings.Split(fileName, "-")
-		if len(vals) == 2 {
-			if index, err := strconv.Atoi(vals[1]); err == nil {
-				if index < fileIndex {
-					fileIndex = index
-				}
-			}
+		if index, ok := getIndexFromFileName(fileName); ok && index < fileIndex {
+			fileIndex = index
 		}
 	}
 	return fileIndex
@@ -107,7 +143,11 @@ func GetFileLeastHeightInDir(dir string) (string, int64, error) {
 		return "", -1, err
 	}
 	index := getMinIndexFromFiles(files)
-	in, err := openFile(GetFileName(dir, index))
+	filePath, _, err := FindSegmentFile(dir, index)
+	if err != nil {
+		return "", -1, err
+	}
+	in, _, err := OpenSegment(dir, index)
 	if err != nil {
 		return "", -1, err
 	}
@@ -117,7 +157,7 @@ func GetFileLeastHeightInDir(dir string) (string, int64, error) {
 	if err != nil {
 		return "", -1, err
 	}
-	return GetFileName(dir, index), getHeight(line), nil
+	return filePath, getHeight(line), nil
 }
 
 func getHeight(data string) int64 {
@@ -76,3 +76,19 @@ func TestOpenFile(t *testing.T) {
 	require.EqualValues(t, false, info.IsDir())
 
 }
+
+func TestGetFileIndexesInDir(t *testing.T) {
+	_, err := GetFileIndexesInDir("notexist")
+	require.Error(t, err)
+
+	dirPath := "tmpi"
+	require.Nil(t, os.Mkdir(dirPath, os.ModePerm))
+	defer os.RemoveAll(dirPath)
+	for _, name := range []string{"backup-10", "backup-2", "backup-x", "other-1"} {
+		_, err := os.Create(dirPath + "/" + name)
+		require.Nil(t, err)
+	}
+	indexes, err := GetFileIndexesInDir(dirPath)
+	require.Nil(t, err)
+	require.Equal(t, []int{2, 10}, indexes)
+}
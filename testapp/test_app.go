@@ -3,6 +3,7 @@ package testapp
 import (
 	"time"
 
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -38,9 +39,16 @@ import (
 	"github.com/coinexchain/cet-sdk/modules/stakingx"
 	"github.com/coinexchain/cet-sdk/modules/supplyx"
 	"github.com/coinexchain/cet-sdk/msgqueue"
+	"github.com/coinexchain/cet-sdk/msgqueue/msgcodec"
 	"github.com/coinexchain/cet-sdk/types"
 )
 
+const (
+	// the instrumentation config of tendermint, whose Prometheus server exposes the metrics of the app as well
+	FlagPrometheus          = "instrumentation.prometheus"
+	FlagPrometheusNamespace = "instrumentation.namespace"
+)
+
 var (
 	maccPerms = map[string][]string{
 		auth.FeeCollectorName:     nil,
@@ -50,6 +58,7 @@ var (
 		gov.ModuleName:            {supply.Burner},
 		authx.ModuleName:          nil,
 		asset.ModuleName:          {supply.Burner, supply.Minter},
+		bankx.ModuleName:          nil,
 	}
 )
 
@@ -75,6 +84,7 @@ type TestApp struct {
 	keyIncentive *sdk.KVStoreKey
 	keyAlias     *sdk.KVStoreKey
 	keyComment   *sdk.KVStoreKey
+	keyBankx     *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	AccountKeeper   auth.AccountKeeper
@@ -168,12 +178,22 @@ func newTestApp(Cdc *codec.Codec) *TestApp {
 		keyIncentive: sdk.NewKVStoreKey(incentive.StoreKey),
 		keyAlias:     sdk.NewKVStoreKey(alias.StoreKey),
 		keyComment:   sdk.NewKVStoreKey(comment.StoreKey),
+		keyBankx:     sdk.NewKVStoreKey(bankx.StoreKey),
 	}
 }
 
 func (app *TestApp) initKeepers(invCheckPeriod uint) {
 	app.ParamsKeeper = params.NewKeeper(app.Cdc, app.keyParams, app.tkeyParams, params.DefaultCodespace)
-	app.MsgQueProducer = msgqueue.NewProducer(nil)
+	schemas := msgcodec.NewRegistry()
+	authx.RegisterMsgQueueSchemas(schemas)
+	bankx.RegisterMsgQueueSchemas(schemas)
+	market.RegisterMsgQueueSchemas(schemas)
+	bancorlite.RegisterMsgQueueSchemas(schemas)
+	comment.RegisterMsgQueueSchemas(schemas)
+	asset.RegisterMsgQueueSchemas(schemas)
+	msgqueue.SetSchemaRegistry(schemas)
+	mqMetrics, marketMetrics := newMetrics()
+	app.MsgQueProducer = msgqueue.NewProducerWithMetrics(nil, mqMetrics)
 
 	// define the AccountKeeper
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -273,8 +293,9 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.Cdc, app.keyAsset,
 	)
 	app.BankxKeeper = bankx.NewKeeper(
+		app.Cdc, app.keyBankx,
 		app.ParamsKeeper.Subspace(bankx.DefaultParamspace),
-		app.AccountXKeeper, app.BankKeeper, app.AccountKeeper,
+		app.AccountXKeeper, app.BankKeeper, app.AccountKeeper, app.keyAccount,
 		app.TokenKeeper,
 		app.SupplyKeeper,
 		app.MsgQueProducer,
@@ -289,6 +310,8 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.ParamsKeeper.Subspace(asset.DefaultParamspace),
 		app.BankxKeeper,
 		app.SupplyKeeper,
+		supplyxKeeper,
+		app.MsgQueProducer,
 	)
 	app.StakingXKeeper = stakingx.NewKeeper(
 		app.keyStakingX,
@@ -321,6 +344,7 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 		app.AccountKeeper,
 		app.AccountXKeeper,
 	)
+	app.MarketKeeper.SetMetrics(marketMetrics)
 	// register the staking hooks
 	// NOTE: The StakingKeeper above is passed by reference, so that it can be
 	// modified like below:
@@ -347,6 +371,15 @@ func (app *TestApp) initKeepers(invCheckPeriod uint) {
 	)
 }
 
+// newMetrics returns the Prometheus metrics of msgqueue and market if the instrumentation is enabled
+func newMetrics() (*msgqueue.Metrics, *market.Metrics) {
+	if !viper.GetBool(FlagPrometheus) {
+		return msgqueue.NopMetrics(), market.NopMetrics()
+	}
+	namespace := viper.GetString(FlagPrometheusNamespace)
+	return msgqueue.PrometheusMetrics(namespace), market.PrometheusMetrics(namespace)
+}
+
 func (app *TestApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
@@ -375,6 +408,7 @@ func (app *TestApp) mountStores() {
 	cms.MountStoreWithDB(app.keyAlias, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyComment, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.keyStakingX, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(app.keyBankx, sdk.StoreTypeIAVL, db)
 	cms.MountStoreWithDB(app.tkeyStaking, sdk.StoreTypeTransient, db)
 	_ = cms.LoadLatestVersion()
 	app.Cms = cms
@@ -23,6 +23,18 @@ func ConcatKeys(keys ...[]byte) []byte {
 	return tmp
 }
 
+// DueQueueKeys returns the keys of a time ordered queue from prefix up to endKey, at most limit keys
+// if limit is positive. The keys are collected first because the queue must not be modified while iterating it.
+func DueQueueKeys(store sdk.KVStore, prefix, endKey []byte, limit int) [][]byte {
+	keys := make([][]byte, 0)
+	iter := store.Iterator(prefix, endKey)
+	defer iter.Close()
+	for ; iter.Valid() && (limit <= 0 || len(keys) < limit); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
 func ErrUnknownRequest(module string, msg sdk.Msg) sdk.Result {
 	//errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 	errMsg := fmt.Sprintf("Unrecognized %s Msg type: %s", module, msg.Type())
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 )
@@ -14,6 +16,20 @@ func TestConcatKeys(t *testing.T) {
 	require.Equal(t, []byte("foobar"), ConcatKeys([]byte("foo"), nil, []byte("bar")))
 }
 
+func TestDueQueueKeys(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	prefix := []byte{0x01}
+	for _, key := range []string{"a", "b", "c", "d"} {
+		store.Set(ConcatKeys(prefix, []byte(key)), []byte(key))
+	}
+	store.Set([]byte{0x02}, []byte("other"))
+
+	keys := DueQueueKeys(store, prefix, ConcatKeys(prefix, []byte("d")), 0)
+	require.Equal(t, [][]byte{[]byte("\x01a"), []byte("\x01b"), []byte("\x01c")}, keys)
+	keys = DueQueueKeys(store, prefix, sdk.PrefixEndBytes(prefix), 2)
+	require.Equal(t, [][]byte{[]byte("\x01a"), []byte("\x01b")}, keys)
+}
+
 func TestErrUnknownRequest(t *testing.T) {
 	result := ErrUnknownRequest("bank", bank.MsgSend{})
 	require.True(t, strings.Index(result.Log, "Unrecognized bank Msg type: send") > 0)